@@ -1,27 +1,177 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
 
 	"2025_gopher_StampRally/services/gopher-stamp-crud/cmd/wire_server"
-
-	"github.com/joho/godotenv"
 )
 
 func main() {
-	// Load .env file (ignore error if file doesn't exist)
-	if err := godotenv.Load(); err != nil {
-		log.Printf("Warning: .env file not found, using environment variables: %v", err)
+	// "server migrate ..." manages the database schema instead of serving requests
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(os.Args[2:]); err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+		return
+	}
+
+	// "server migrate-icons" moves base64 icons stored in the users table to the blob store
+	if len(os.Args) > 1 && os.Args[1] == "migrate-icons" {
+		if err := runMigrateIcons(); err != nil {
+			log.Fatalf("Icon migration failed: %v", err)
+		}
+		return
 	}
 
 	// Initialize server with Wire dependency injection
-	r, err := wire_server.InitializeServer()
+	server, err := wire_server.InitializeServer()
 	if err != nil {
 		log.Fatalf("Failed to initialize server: %v", err)
 	}
 
-	// Start server
-	if err := r.Run(":8080"); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	if err := serve(server); err != nil {
+		log.Fatalf("Server failed: %v", err)
+	}
+}
+
+// serve runs the server until it receives SIGINT or SIGTERM, then shuts it down: it stops accepting
+// connections and waits up to the shutdown timeout for requests in flight, ends the event streams,
+// stops the outbox relay and the webhook dispatcher, waiting for webhook requests already on their
+// way, and closes the database. Webhook deliveries not yet made are sent after the next start.
+func serve(server *wire_server.Server) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Publish the registrations and stamp acquisitions recorded in the outbox to the feed and webhooks
+	workersCtx, cancelWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+	workers.Add(2)
+	go func() {
+		defer workers.Done()
+		server.Relay.Run(workersCtx)
+	}()
+	// Send the webhook deliveries stored by the relay and by registrations
+	go func() {
+		defer workers.Done()
+		server.Webhooks.Run(workersCtx)
+	}()
+
+	// Event streams never finish on their own, so Shutdown would otherwise wait for them until it times out
+	server.HTTP.RegisterOnShutdown(server.FeedBus.Close)
+
+	serveErr := make(chan error, 1)
+	go func() {
+		log.Printf("Listening on %s", server.HTTP.Addr)
+		serveErr <- server.HTTP.ListenAndServe()
+	}()
+
+	var err error
+	select {
+	case err = <-serveErr:
+	case <-ctx.Done():
+		// A second signal stops the process right away
+		stop()
+		log.Print("Shutting down")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Duration(server.ShutdownTimeout))
+		err = server.HTTP.Shutdown(shutdownCtx)
+		cancel()
+	}
+	if errors.Is(err, http.ErrServerClosed) {
+		err = nil
+	}
+
+	cancelWorkers()
+	workers.Wait()
+
+	sqlDB, dbErr := server.DB.DB()
+	if dbErr == nil {
+		dbErr = sqlDB.Close()
+	}
+	return errors.Join(err, dbErr)
+}
+
+// runMigrate implements the migrate subcommand:
+//
+//	migrate [up]      apply all pending migrations
+//	migrate down [N]  revert the last N applied migrations (default 1)
+//	migrate version   print the current schema version, marked "(dirty)" if a migration failed part way
+func runMigrate(args []string) error {
+	migrator, err := wire_server.InitializeMigrator()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	cmd := "up"
+	if len(args) > 0 {
+		cmd = args[0]
+	}
+
+	switch cmd {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			log.Printf("Applied migration %03d_%s", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			log.Print("No pending migrations")
+		}
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps %q", args[1])
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		for _, m := range reverted {
+			log.Printf("Reverted migration %03d_%s", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+	case "version":
+		version, dirty, err := migrator.Version(ctx)
+		if err != nil {
+			return err
+		}
+		if dirty {
+			fmt.Printf("%d (dirty)\n", version)
+		} else {
+			fmt.Println(version)
+		}
+	default:
+		return fmt.Errorf("unknown migrate command %q (want up, down or version)", cmd)
+	}
+	return nil
+}
+
+// runMigrateIcons implements the migrate-icons subcommand. It can be run repeatedly;
+// icons that have already been moved are skipped.
+func runMigrateIcons() error {
+	iconUseCase, err := wire_server.InitializeIconUseCase()
+	if err != nil {
+		return err
+	}
+
+	migrated, failed, err := iconUseCase.MigrateInlineIcons(context.Background())
+	log.Printf("Migrated %d icons", migrated)
+	if failed > 0 {
+		log.Printf("%d icons could not be decoded and were left in the users table", failed)
 	}
+	return err
 }
@@ -3,13 +3,21 @@
 package wire_server
 
 import (
-	"os"
-	"strings"
+	"net/http"
+	"time"
 
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/config"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/stamptoken"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/infrastructure/feed"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/infrastructure/mysql"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/infrastructure/outbox"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/infrastructure/storage"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/infrastructure/webhook"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/interface/handler"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/interface/middleware"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/usecase"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/migrations"
 	openapi "2025_gopher_StampRally/services/gopher-stamp-crud/swagger"
 
 	"github.com/gin-contrib/cors"
@@ -20,21 +28,60 @@ import (
 
 // ProviderSet is the set of providers for dependency injection
 var ProviderSet = wire.NewSet(
+	ConfigSet,
+
 	// Infrastructure
 	mysql.NewMySQLClient,
 	NewUserRepository,
 	NewStampRepository,
 	NewUserStampRepository,
+	NewUserSessionRepository,
+	NewEventRepository,
+	NewRewardRuleRepository,
+	NewUserRewardRepository,
+	NewGoFeatureRepository,
+	NewWebhookRepository,
+	NewWebhookDeliveryRepository,
+	NewWebhookJobRepository,
+	NewOutboxRepository,
+	NewTxManager,
+	NewStampTokenSigner,
+	NewStampCodeRotator,
+	stamptoken.NewCodeLimiter,
+	BlobStoreSet,
+	NewFeedBus,
+	wire.Bind(new(repository.FeedBus), new(*feed.MemoryBus)),
+	NewWebhookDispatcher,
+	wire.Bind(new(repository.WebhookDispatcher), new(*webhook.Dispatcher)),
+	NewOutboxSinks,
+	NewOutboxRelay,
 
 	// Usecase
 	usecase.NewUserUsecase,
 	usecase.NewStampUseCase,
 	usecase.NewUserStampUseCase,
+	usecase.NewAuthUseCase,
+	usecase.NewEventUseCase,
+	usecase.NewRewardUseCase,
+	usecase.NewIconUseCase,
+	usecase.NewGoFeatureUseCase,
+	usecase.NewStatsUseCase,
+	usecase.NewFeedUseCase,
+	usecase.NewWebhookUseCase,
 
 	// Handler
+	handler.NewEventHandler,
 	handler.NewStampHandler,
 	handler.NewUserStampHandler,
+	handler.NewRewardHandler,
+	handler.NewIconHandler,
+	handler.NewGoFeatureHandler,
+	handler.NewStatsHandler,
+	handler.NewFeedHandler,
+	handler.NewWebhookHandler,
 	handler.NewUserHandler,
+	middleware.NewAuthMiddleware,
+	NewAdminMiddleware,
 )
 
 // NewUserRepository creates a UserRepository interface from mysql implementation
@@ -52,34 +99,218 @@ func NewUserStampRepository(db *gorm.DB) repository.UserStampRepository {
 	return mysql.NewUserStampRepository(db)
 }
 
-// InitializeServer initializes all dependencies and returns a gin.Engine
-func InitializeServer() (*gin.Engine, error) {
+// NewUserSessionRepository creates a UserSessionRepository interface from mysql implementation
+func NewUserSessionRepository(db *gorm.DB) repository.UserSessionRepository {
+	return mysql.NewUserSessionRepository(db)
+}
+
+// NewEventRepository creates an EventRepository interface from mysql implementation
+func NewEventRepository(db *gorm.DB) repository.EventRepository {
+	return mysql.NewEventRepository(db)
+}
+
+// NewRewardRuleRepository creates a RewardRuleRepository interface from mysql implementation
+func NewRewardRuleRepository(db *gorm.DB) repository.RewardRuleRepository {
+	return mysql.NewRewardRuleRepository(db)
+}
+
+// NewUserRewardRepository creates a UserRewardRepository interface from mysql implementation
+func NewUserRewardRepository(db *gorm.DB) repository.UserRewardRepository {
+	return mysql.NewUserRewardRepository(db)
+}
+
+// NewGoFeatureRepository creates a GoFeatureRepository interface from mysql implementation
+func NewGoFeatureRepository(db *gorm.DB) repository.GoFeatureRepository {
+	return mysql.NewGoFeatureRepository(db)
+}
+
+// NewWebhookRepository creates a WebhookRepository interface from mysql implementation
+func NewWebhookRepository(db *gorm.DB) repository.WebhookRepository {
+	return mysql.NewWebhookRepository(db)
+}
+
+// NewWebhookDeliveryRepository creates a WebhookDeliveryRepository interface from mysql implementation
+func NewWebhookDeliveryRepository(db *gorm.DB) repository.WebhookDeliveryRepository {
+	return mysql.NewWebhookDeliveryRepository(db)
+}
+
+// NewWebhookJobRepository creates a WebhookJobRepository interface from mysql implementation
+func NewWebhookJobRepository(db *gorm.DB) repository.WebhookJobRepository {
+	return mysql.NewWebhookJobRepository(db)
+}
+
+// NewWebhookDispatcher creates the dispatcher that delivers stamp rally events to organizers' webhooks
+func NewWebhookDispatcher(
+	webhookRepo repository.WebhookRepository,
+	jobRepo repository.WebhookJobRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+) *webhook.Dispatcher {
+	client := &http.Client{Timeout: webhook.DefaultTimeout}
+	return webhook.NewDispatcher(webhookRepo, jobRepo, deliveryRepo, client,
+		webhook.DefaultMaxAttempts, webhook.DefaultInitialBackoff, webhook.DefaultInterval, webhook.DefaultRetention)
+}
+
+// NewOutboxRepository creates an OutboxRepository interface from mysql implementation
+func NewOutboxRepository(db *gorm.DB) repository.OutboxRepository {
+	return mysql.NewOutboxRepository(db)
+}
+
+// NewOutboxSinks lists where the domain events recorded in the outbox are published
+func NewOutboxSinks(feedBus repository.FeedBus, webhooks repository.WebhookDispatcher) []repository.OutboxSink {
+	return []repository.OutboxSink{
+		usecase.NewFeedOutboxSink(feedBus),
+		usecase.NewWebhookOutboxSink(webhooks),
+	}
+}
+
+// NewOutboxRelay creates the background worker that publishes the outbox to the sinks
+func NewOutboxRelay(outboxRepo repository.OutboxRepository, sinks []repository.OutboxSink) *outbox.Relay {
+	return outbox.NewRelay(outboxRepo, sinks, outbox.DefaultInterval, outbox.DefaultBatchSize,
+		outbox.DefaultMaxAttempts, outbox.DefaultInitialBackoff, outbox.DefaultRetention)
+}
+
+// NewFeedBus creates the in-process bus that streams stamp rally events to connected clients
+func NewFeedBus() *feed.MemoryBus {
+	return feed.NewMemoryBus(feed.DefaultBufferSize)
+}
+
+// NewTxManager creates a TxManager interface from mysql implementation
+func NewTxManager(db *gorm.DB) repository.TxManager {
+	return mysql.NewTxManager(db)
+}
+
+// NewStampTokenSigner creates the signer for stamp acquisition tokens
+func NewStampTokenSigner(cfg config.StampToken) *stamptoken.Signer {
+	return stamptoken.NewSigner([]byte(cfg.Secret), cfg.TTL)
+}
+
+// NewStampCodeRotator creates the generator for rotating booth codes
+func NewStampCodeRotator(cfg config.StampToken) *stamptoken.Rotator {
+	return stamptoken.NewRotator(cfg.CodePeriod)
+}
+
+// BlobStoreSet provides the store for profile icons, served by NewGinEngine at blobRoutePath.
+var BlobStoreSet = wire.NewSet(
+	NewLocalBlobStore,
+	wire.Bind(new(repository.BlobStore), new(*storage.LocalBlobStore)),
+)
+
+// blobRoutePath is the path the files of the local blob store are served at.
+const blobRoutePath = "/blobs"
+
+// NewLocalBlobStore creates the blob store for profile icons.
+// The configured base URL is the public URL of blobRoutePath, which may point at a CDN or proxy in front of the server.
+func NewLocalBlobStore(cfg config.Blob) (*storage.LocalBlobStore, error) {
+	return storage.NewLocalBlobStore(cfg.Dir, cfg.BaseURL)
+}
+
+// NewAdminMiddleware creates the middleware guarding organizer-only operations
+func NewAdminMiddleware(cfg config.Admin) *middleware.AdminMiddleware {
+	return middleware.NewAdminMiddleware(cfg.APIKey)
+}
+
+// Server is the HTTP API together with the background workers and connections it depends on.
+// Shutting it down takes them in turn: HTTP first, so no new work arrives, then the workers, then the database.
+type Server struct {
+	HTTP            *http.Server
+	ShutdownTimeout ShutdownTimeout
+	// Relay must run for the feed and webhooks to hear of stamp acquisitions
+	Relay   *outbox.Relay
+	FeedBus *feed.MemoryBus
+	// Webhooks must run for the stored webhook deliveries to be sent
+	Webhooks *webhook.Dispatcher
+	DB       *gorm.DB
+}
+
+// InitializeServer initializes all dependencies and returns the Server
+func InitializeServer() (*Server, error) {
 	wire.Build(
 		ProviderSet,
 		NewGinEngine,
+		NewHTTPServer,
+		NewShutdownTimeout,
+		wire.Struct(new(Server), "*"),
+	)
+	return nil, nil
+}
+
+// NewHTTPServer creates the server for the API
+func NewHTTPServer(engine *gin.Engine, cfg config.HTTP) *http.Server {
+	return &http.Server{
+		Addr:              ":" + cfg.Port,
+		Handler:           engine,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		ReadTimeout:       cfg.ReadTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+	}
+}
+
+// ShutdownTimeout bounds how long shutdown waits for requests in flight to finish.
+type ShutdownTimeout time.Duration
+
+// NewShutdownTimeout provides the configured ShutdownTimeout
+func NewShutdownTimeout(cfg config.HTTP) ShutdownTimeout {
+	return ShutdownTimeout(cfg.ShutdownTimeout)
+}
+
+// ConfigSet loads the configuration and provides each of its sections.
+var ConfigSet = wire.NewSet(
+	config.Load,
+	wire.FieldsOf(new(*config.Config), "Database", "HTTP", "CORS", "StampToken", "Admin", "Blob"),
+)
+
+// InitializeMigrator initializes the schema migrator used by the migrate subcommand
+func InitializeMigrator() (*mysql.Migrator, error) {
+	wire.Build(
+		ConfigSet,
+		mysql.OpenMySQL,
+		NewMigrator,
 	)
 	return nil, nil
 }
 
+// InitializeIconUseCase initializes the icon usecase used by the migrate-icons subcommand
+func InitializeIconUseCase() (usecase.IconUseCase, error) {
+	wire.Build(
+		ConfigSet,
+		mysql.OpenMySQL,
+		NewUserRepository,
+		BlobStoreSet,
+		usecase.NewIconUseCase,
+	)
+	return nil, nil
+}
+
+// NewMigrator creates a Migrator for the SQL migrations embedded in the binary
+func NewMigrator(db *gorm.DB) (*mysql.Migrator, error) {
+	return mysql.NewMigrator(db, migrations.FS)
+}
+
 // NewGinEngine creates a new gin.Engine with handlers registered
-func NewGinEngine(h openapi.ServerInterface) *gin.Engine {
+func NewGinEngine(
+	h openapi.ServerInterface,
+	authMiddleware *middleware.AuthMiddleware,
+	adminMiddleware *middleware.AdminMiddleware,
+	blobStore *storage.LocalBlobStore,
+	corsConfig config.CORS,
+	httpConfig config.HTTP,
+) *gin.Engine {
 	r := gin.Default()
 
-	// CORS settings: allow frontend origin
-	// Get allowed origin from environment variable, default to production frontend URL
-	allowedOrigin := os.Getenv("CORS_ALLOWED_ORIGIN")
-	if allowedOrigin == "" {
-		allowedOrigin = "https://2025-gopher-stamp-rally.vercel.app"
-	}
-	corsConfig := cors.Config{
-		AllowOrigins:     []string{allowedOrigin},
+	// CORS settings: allow the frontend origins
+	r.Use(cors.New(cors.Config{
+		AllowOrigins:     corsConfig.AllowedOrigins,
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With", middleware.AdminAPIKeyHeader},
+		ExposeHeaders:    []string{"Content-Length", handler.TotalCountHeader},
 		AllowCredentials: true,
 		MaxAge:           12 * 60 * 60, // 12 hours
-	}
-	r.Use(cors.New(corsConfig))
+	}))
+
+	// Convert errors reported by handlers and middlewares via c.Error into openapi.Error responses.
+	// Registered before any route, since gin only applies middlewares to routes added after them
+	r.Use(middleware.ErrorHandler)
 
 	// Debug: log CORS configuration (remove in production if needed)
 	gin.SetMode(gin.ReleaseMode) // Set to release mode to reduce logs
@@ -93,15 +324,20 @@ func NewGinEngine(h openapi.ServerInterface) *gin.Engine {
 	r.GET("/health", healthHandler)
 	r.HEAD("/health", healthHandler)
 
-	// Get baseURL from environment variable, default to empty string if not set
-	// BaseURL should be a path prefix (e.g., "/api"), not a full URL
-	baseURL := os.Getenv("BASE_API_URL")
-	// If BASE_API_URL is a full URL (starts with http:// or https://), ignore it
-	if strings.HasPrefix(baseURL, "http://") || strings.HasPrefix(baseURL, "https://") {
-		baseURL = ""
-	}
+	// Uploaded icons. File names change with every upload, so the files never need revalidating
+	blobs := r.Group(blobRoutePath, func(c *gin.Context) {
+		c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	})
+	blobs.Static("/", blobStore.Dir())
+
 	options := openapi.GinServerOptions{
-		BaseURL: baseURL,
+		BaseURL:      httpConfig.BasePath,
+		ErrorHandler: middleware.OpenAPIErrorHandler,
+		// Enforce the security requirements declared per operation in the OpenAPI spec
+		Middlewares: []openapi.MiddlewareFunc{
+			authMiddleware.RequireOwner,
+			adminMiddleware.RequireAdmin,
+		},
 	}
 	openapi.RegisterHandlersWithOptions(r, h, options)
 	return r
@@ -7,46 +7,166 @@
 package wire_server
 
 import (
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/config"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/stamptoken"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/infrastructure/feed"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/infrastructure/mysql"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/infrastructure/outbox"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/infrastructure/storage"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/infrastructure/webhook"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/interface/handler"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/interface/middleware"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/usecase"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/migrations"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/swagger"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/google/wire"
 	"gorm.io/gorm"
-	"os"
-	"strings"
+	"net/http"
+	"time"
 )
 
 // Injectors from wire.go:
 
-// InitializeServer initializes all dependencies and returns a gin.Engine
-func InitializeServer() (*gin.Engine, error) {
-	db, err := mysql.NewMySQLClient()
+// InitializeServer initializes all dependencies and returns the Server
+func InitializeServer() (*Server, error) {
+	configConfig, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	database := configConfig.Database
+	db, err := mysql.NewMySQLClient(database)
 	if err != nil {
 		return nil, err
 	}
 	userRepository := NewUserRepository(db)
 	userStampRepository := NewUserStampRepository(db)
-	userUsecase := usecase.NewUserUsecase(userRepository, userStampRepository)
+	userSessionRepository := NewUserSessionRepository(db)
+	userRewardRepository := NewUserRewardRepository(db)
+	goFeatureRepository := NewGoFeatureRepository(db)
+	eventRepository := NewEventRepository(db)
+	outboxRepository := NewOutboxRepository(db)
+	webhookJobRepository := NewWebhookJobRepository(db)
+	webhookDeliveryRepository := NewWebhookDeliveryRepository(db)
+	blob := configConfig.Blob
+	localBlobStore, err := NewLocalBlobStore(blob)
+	if err != nil {
+		return nil, err
+	}
+	txManager := NewTxManager(db)
+	webhookRepository := NewWebhookRepository(db)
+	dispatcher := NewWebhookDispatcher(webhookRepository, webhookJobRepository, webhookDeliveryRepository)
+	userUsecase := usecase.NewUserUsecase(userRepository, userStampRepository, userSessionRepository, userRewardRepository, goFeatureRepository, eventRepository, outboxRepository, webhookJobRepository, webhookDeliveryRepository, localBlobStore, txManager, dispatcher)
 	stampRepository := NewStampRepository(db)
-	userStampUseCase := usecase.NewUserStampUseCase(userStampRepository, userRepository, stampRepository)
-	stampUseCase := usecase.NewStampUseCase(stampRepository)
+	rewardRuleRepository := NewRewardRuleRepository(db)
+	stampToken := configConfig.StampToken
+	signer := NewStampTokenSigner(stampToken)
+	rotator := NewStampCodeRotator(stampToken)
+	codeLimiter := stamptoken.NewCodeLimiter()
+	userStampUseCase := usecase.NewUserStampUseCase(userStampRepository, userRepository, stampRepository, eventRepository, rewardRuleRepository, userRewardRepository, txManager, signer, rotator, codeLimiter, outboxRepository)
+	eventUseCase := usecase.NewEventUseCase(eventRepository)
+	eventHandler := handler.NewEventHandler(eventUseCase)
+	stampUseCase := usecase.NewStampUseCase(stampRepository, eventRepository, signer, rotator)
 	stampHandler := handler.NewStampHandler(stampUseCase)
 	userStampHandler := handler.NewUserStampHandler(userStampUseCase)
-	serverInterface := handler.NewUserHandler(userUsecase, userStampUseCase, stampHandler, userStampHandler)
-	engine := NewGinEngine(serverInterface)
-	return engine, nil
+	rewardUseCase := usecase.NewRewardUseCase(rewardRuleRepository, userRewardRepository, userRepository, stampRepository, userStampRepository, eventRepository)
+	rewardHandler := handler.NewRewardHandler(rewardUseCase)
+	iconUseCase := usecase.NewIconUseCase(userRepository, localBlobStore)
+	iconHandler := handler.NewIconHandler(iconUseCase)
+	goFeatureUseCase := usecase.NewGoFeatureUseCase(goFeatureRepository, userRepository, eventRepository)
+	goFeatureHandler := handler.NewGoFeatureHandler(goFeatureUseCase)
+	statsUseCase := usecase.NewStatsUseCase(userStampRepository, userRepository, eventRepository)
+	statsHandler := handler.NewStatsHandler(statsUseCase)
+	memoryBus := NewFeedBus()
+	feedUseCase := usecase.NewFeedUseCase(memoryBus, eventRepository)
+	feedHandler := handler.NewFeedHandler(feedUseCase)
+	webhookUseCase := usecase.NewWebhookUseCase(webhookRepository, webhookDeliveryRepository, eventRepository)
+	webhookHandler := handler.NewWebhookHandler(webhookUseCase)
+	serverInterface := handler.NewUserHandler(userUsecase, userStampUseCase, eventHandler, stampHandler, userStampHandler, rewardHandler, iconHandler, goFeatureHandler, statsHandler, feedHandler, webhookHandler)
+	authUseCase := usecase.NewAuthUseCase(userSessionRepository)
+	authMiddleware := middleware.NewAuthMiddleware(authUseCase)
+	admin := configConfig.Admin
+	adminMiddleware := NewAdminMiddleware(admin)
+	cors := configConfig.CORS
+	http := configConfig.HTTP
+	engine := NewGinEngine(serverInterface, authMiddleware, adminMiddleware, localBlobStore, cors, http)
+	server := NewHTTPServer(engine, http)
+	shutdownTimeout := NewShutdownTimeout(http)
+	v := NewOutboxSinks(memoryBus, dispatcher)
+	relay := NewOutboxRelay(outboxRepository, v)
+	wire_serverServer := &Server{
+		HTTP:            server,
+		ShutdownTimeout: shutdownTimeout,
+		Relay:           relay,
+		FeedBus:         memoryBus,
+		Webhooks:        dispatcher,
+		DB:              db,
+	}
+	return wire_serverServer, nil
+}
+
+// InitializeMigrator initializes the schema migrator used by the migrate subcommand
+func InitializeMigrator() (*mysql.Migrator, error) {
+	configConfig, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	database := configConfig.Database
+	db, err := mysql.OpenMySQL(database)
+	if err != nil {
+		return nil, err
+	}
+	migrator, err := NewMigrator(db)
+	if err != nil {
+		return nil, err
+	}
+	return migrator, nil
+}
+
+// InitializeIconUseCase initializes the icon usecase used by the migrate-icons subcommand
+func InitializeIconUseCase() (usecase.IconUseCase, error) {
+	configConfig, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	database := configConfig.Database
+	db, err := mysql.OpenMySQL(database)
+	if err != nil {
+		return nil, err
+	}
+	userRepository := NewUserRepository(db)
+	blob := configConfig.Blob
+	localBlobStore, err := NewLocalBlobStore(blob)
+	if err != nil {
+		return nil, err
+	}
+	iconUseCase := usecase.NewIconUseCase(userRepository, localBlobStore)
+	return iconUseCase, nil
 }
 
 // wire.go:
 
 // ProviderSet is the set of providers for dependency injection
-var ProviderSet = wire.NewSet(mysql.NewMySQLClient, NewUserRepository,
+var ProviderSet = wire.NewSet(
+	ConfigSet, mysql.NewMySQLClient, NewUserRepository,
 	NewStampRepository,
-	NewUserStampRepository, usecase.NewUserUsecase, usecase.NewStampUseCase, usecase.NewUserStampUseCase, handler.NewStampHandler, handler.NewUserStampHandler, handler.NewUserHandler,
+	NewUserStampRepository,
+	NewUserSessionRepository,
+	NewEventRepository,
+	NewRewardRuleRepository,
+	NewUserRewardRepository,
+	NewGoFeatureRepository,
+	NewWebhookRepository,
+	NewWebhookDeliveryRepository,
+	NewWebhookJobRepository,
+	NewOutboxRepository,
+	NewTxManager,
+	NewStampTokenSigner,
+	NewStampCodeRotator, stamptoken.NewCodeLimiter, BlobStoreSet,
+	NewFeedBus, wire.Bind(new(repository.FeedBus), new(*feed.MemoryBus)), NewWebhookDispatcher, wire.Bind(new(repository.WebhookDispatcher), new(*webhook.Dispatcher)), NewOutboxSinks,
+	NewOutboxRelay, usecase.NewUserUsecase, usecase.NewStampUseCase, usecase.NewUserStampUseCase, usecase.NewAuthUseCase, usecase.NewEventUseCase, usecase.NewRewardUseCase, usecase.NewIconUseCase, usecase.NewGoFeatureUseCase, usecase.NewStatsUseCase, usecase.NewFeedUseCase, usecase.NewWebhookUseCase, handler.NewEventHandler, handler.NewStampHandler, handler.NewUserStampHandler, handler.NewRewardHandler, handler.NewIconHandler, handler.NewGoFeatureHandler, handler.NewStatsHandler, handler.NewFeedHandler, handler.NewWebhookHandler, handler.NewUserHandler, middleware.NewAuthMiddleware, NewAdminMiddleware,
 )
 
 // NewUserRepository creates a UserRepository interface from mysql implementation
@@ -64,23 +184,172 @@ func NewUserStampRepository(db *gorm.DB) repository.UserStampRepository {
 	return mysql.NewUserStampRepository(db)
 }
 
+// NewUserSessionRepository creates a UserSessionRepository interface from mysql implementation
+func NewUserSessionRepository(db *gorm.DB) repository.UserSessionRepository {
+	return mysql.NewUserSessionRepository(db)
+}
+
+// NewEventRepository creates an EventRepository interface from mysql implementation
+func NewEventRepository(db *gorm.DB) repository.EventRepository {
+	return mysql.NewEventRepository(db)
+}
+
+// NewRewardRuleRepository creates a RewardRuleRepository interface from mysql implementation
+func NewRewardRuleRepository(db *gorm.DB) repository.RewardRuleRepository {
+	return mysql.NewRewardRuleRepository(db)
+}
+
+// NewUserRewardRepository creates a UserRewardRepository interface from mysql implementation
+func NewUserRewardRepository(db *gorm.DB) repository.UserRewardRepository {
+	return mysql.NewUserRewardRepository(db)
+}
+
+// NewGoFeatureRepository creates a GoFeatureRepository interface from mysql implementation
+func NewGoFeatureRepository(db *gorm.DB) repository.GoFeatureRepository {
+	return mysql.NewGoFeatureRepository(db)
+}
+
+// NewWebhookRepository creates a WebhookRepository interface from mysql implementation
+func NewWebhookRepository(db *gorm.DB) repository.WebhookRepository {
+	return mysql.NewWebhookRepository(db)
+}
+
+// NewWebhookDeliveryRepository creates a WebhookDeliveryRepository interface from mysql implementation
+func NewWebhookDeliveryRepository(db *gorm.DB) repository.WebhookDeliveryRepository {
+	return mysql.NewWebhookDeliveryRepository(db)
+}
+
+// NewWebhookJobRepository creates a WebhookJobRepository interface from mysql implementation
+func NewWebhookJobRepository(db *gorm.DB) repository.WebhookJobRepository {
+	return mysql.NewWebhookJobRepository(db)
+}
+
+// NewWebhookDispatcher creates the dispatcher that delivers stamp rally events to organizers' webhooks
+func NewWebhookDispatcher(
+	webhookRepo repository.WebhookRepository,
+	jobRepo repository.WebhookJobRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+) *webhook.Dispatcher {
+	client := &http.Client{Timeout: webhook.DefaultTimeout}
+	return webhook.NewDispatcher(webhookRepo, jobRepo, deliveryRepo, client, webhook.DefaultMaxAttempts, webhook.DefaultInitialBackoff, webhook.DefaultInterval, webhook.DefaultRetention)
+}
+
+// NewOutboxRepository creates an OutboxRepository interface from mysql implementation
+func NewOutboxRepository(db *gorm.DB) repository.OutboxRepository {
+	return mysql.NewOutboxRepository(db)
+}
+
+// NewOutboxSinks lists where the domain events recorded in the outbox are published
+func NewOutboxSinks(feedBus repository.FeedBus, webhooks repository.WebhookDispatcher) []repository.OutboxSink {
+	return []repository.OutboxSink{usecase.NewFeedOutboxSink(feedBus), usecase.NewWebhookOutboxSink(webhooks)}
+}
+
+// NewOutboxRelay creates the background worker that publishes the outbox to the sinks
+func NewOutboxRelay(outboxRepo repository.OutboxRepository, sinks []repository.OutboxSink) *outbox.Relay {
+	return outbox.NewRelay(outboxRepo, sinks, outbox.DefaultInterval, outbox.DefaultBatchSize, outbox.DefaultMaxAttempts, outbox.DefaultInitialBackoff, outbox.DefaultRetention)
+}
+
+// NewFeedBus creates the in-process bus that streams stamp rally events to connected clients
+func NewFeedBus() *feed.MemoryBus {
+	return feed.NewMemoryBus(feed.DefaultBufferSize)
+}
+
+// NewTxManager creates a TxManager interface from mysql implementation
+func NewTxManager(db *gorm.DB) repository.TxManager {
+	return mysql.NewTxManager(db)
+}
+
+// NewStampTokenSigner creates the signer for stamp acquisition tokens
+func NewStampTokenSigner(cfg config.StampToken) *stamptoken.Signer {
+	return stamptoken.NewSigner([]byte(cfg.Secret), cfg.TTL)
+}
+
+// NewStampCodeRotator creates the generator for rotating booth codes
+func NewStampCodeRotator(cfg config.StampToken) *stamptoken.Rotator {
+	return stamptoken.NewRotator(cfg.CodePeriod)
+}
+
+// BlobStoreSet provides the store for profile icons, served by NewGinEngine at blobRoutePath.
+var BlobStoreSet = wire.NewSet(
+	NewLocalBlobStore, wire.Bind(new(repository.BlobStore), new(*storage.LocalBlobStore)),
+)
+
+// blobRoutePath is the path the files of the local blob store are served at.
+const blobRoutePath = "/blobs"
+
+// NewLocalBlobStore creates the blob store for profile icons.
+// The configured base URL is the public URL of blobRoutePath, which may point at a CDN or proxy in front of the server.
+func NewLocalBlobStore(cfg config.Blob) (*storage.LocalBlobStore, error) {
+	return storage.NewLocalBlobStore(cfg.Dir, cfg.BaseURL)
+}
+
+// NewAdminMiddleware creates the middleware guarding organizer-only operations
+func NewAdminMiddleware(cfg config.Admin) *middleware.AdminMiddleware {
+	return middleware.NewAdminMiddleware(cfg.APIKey)
+}
+
+// Server is the HTTP API together with the background workers and connections it depends on.
+// Shutting it down takes them in turn: HTTP first, so no new work arrives, then the workers, then the database.
+type Server struct {
+	HTTP            *http.Server
+	ShutdownTimeout ShutdownTimeout
+	// Relay must run for the feed and webhooks to hear of stamp acquisitions
+	Relay   *outbox.Relay
+	FeedBus *feed.MemoryBus
+	// Webhooks must run for the stored webhook deliveries to be sent
+	Webhooks *webhook.Dispatcher
+	DB       *gorm.DB
+}
+
+// NewHTTPServer creates the server for the API
+func NewHTTPServer(engine *gin.Engine, cfg config.HTTP) *http.Server {
+	return &http.Server{
+		Addr:              ":" + cfg.Port,
+		Handler:           engine,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		ReadTimeout:       cfg.ReadTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+	}
+}
+
+// ShutdownTimeout bounds how long shutdown waits for requests in flight to finish.
+type ShutdownTimeout time.Duration
+
+// NewShutdownTimeout provides the configured ShutdownTimeout
+func NewShutdownTimeout(cfg config.HTTP) ShutdownTimeout {
+	return ShutdownTimeout(cfg.ShutdownTimeout)
+}
+
+// ConfigSet loads the configuration and provides each of its sections.
+var ConfigSet = wire.NewSet(config.Load, wire.FieldsOf(new(*config.Config), "Database", "HTTP", "CORS", "StampToken", "Admin", "Blob"))
+
+// NewMigrator creates a Migrator for the SQL migrations embedded in the binary
+func NewMigrator(db *gorm.DB) (*mysql.Migrator, error) {
+	return mysql.NewMigrator(db, migrations.FS)
+}
+
 // NewGinEngine creates a new gin.Engine with handlers registered
-func NewGinEngine(h openapi.ServerInterface) *gin.Engine {
+func NewGinEngine(
+	h openapi.ServerInterface,
+	authMiddleware *middleware.AuthMiddleware,
+	adminMiddleware *middleware.AdminMiddleware,
+	blobStore *storage.LocalBlobStore,
+	corsConfig config.CORS,
+	httpConfig config.HTTP,
+) *gin.Engine {
 	r := gin.Default()
 
-	allowedOrigin := os.Getenv("CORS_ALLOWED_ORIGIN")
-	if allowedOrigin == "" {
-		allowedOrigin = "https://2025-gopher-stamp-rally.vercel.app"
-	}
-	corsConfig := cors.Config{
-		AllowOrigins:     []string{allowedOrigin},
+	r.Use(cors.New(cors.Config{
+		AllowOrigins:     corsConfig.AllowedOrigins,
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With", middleware.AdminAPIKeyHeader},
+		ExposeHeaders:    []string{"Content-Length", handler.TotalCountHeader},
 		AllowCredentials: true,
 		MaxAge:           12 * 60 * 60,
-	}
-	r.Use(cors.New(corsConfig))
+	}))
+
+	r.Use(middleware.ErrorHandler)
 	gin.SetMode(gin.ReleaseMode)
 
 	healthHandler := func(c *gin.Context) {
@@ -91,13 +360,19 @@ func NewGinEngine(h openapi.ServerInterface) *gin.Engine {
 	r.GET("/health", healthHandler)
 	r.HEAD("/health", healthHandler)
 
-	baseURL := os.Getenv("BASE_API_URL")
+	blobs := r.Group(blobRoutePath, func(c *gin.Context) {
+		c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	})
+	blobs.Static("/", blobStore.Dir())
 
-	if strings.HasPrefix(baseURL, "http://") || strings.HasPrefix(baseURL, "https://") {
-		baseURL = ""
-	}
 	options := openapi.GinServerOptions{
-		BaseURL: baseURL,
+		BaseURL:      httpConfig.BasePath,
+		ErrorHandler: middleware.OpenAPIErrorHandler,
+
+		Middlewares: []openapi.MiddlewareFunc{
+			authMiddleware.RequireOwner,
+			adminMiddleware.RequireAdmin,
+		},
 	}
 	openapi.RegisterHandlersWithOptions(r, h, options)
 	return r
@@ -0,0 +1,287 @@
+// Package config loads the server configuration from environment variables.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+// Config is the configuration of the server and its subcommands.
+type Config struct {
+	Database   Database
+	HTTP       HTTP
+	CORS       CORS
+	StampToken StampToken
+	Admin      Admin
+	Blob       Blob
+}
+
+// Database is the MySQL connection, DB_*, and the stamp master seed file.
+type Database struct {
+	Host     string
+	Port     string
+	User     string
+	Password string
+	Name     string
+	// StampSeedFile is upserted into the default event on every start when set (STAMP_SEED_FILE)
+	StampSeedFile string
+}
+
+// DSN returns the data source name for the MySQL driver.
+func (d Database) DSN() string {
+	return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+		d.User, d.Password, d.Host, d.Port, d.Name)
+}
+
+// HTTP is the API server. The write timeout does not apply to the event stream.
+type HTTP struct {
+	Port string
+	// BasePath is the path prefix of the API routes, e.g. "/api" (BASE_API_URL)
+	BasePath          string
+	ReadHeaderTimeout time.Duration
+	ReadTimeout       time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	// ShutdownTimeout bounds how long shutdown waits for requests in flight to finish
+	ShutdownTimeout time.Duration
+}
+
+// CORS lists the origins of the frontends allowed to call the API from a browser.
+type CORS struct {
+	AllowedOrigins []string
+}
+
+// StampToken signs the tokens printed in QR codes and rotates the codes shown on booth screens.
+type StampToken struct {
+	Secret     string
+	TTL        time.Duration
+	CodePeriod time.Duration
+}
+
+// Admin guards organizer-only operations.
+type Admin struct {
+	APIKey string
+}
+
+// Blob is the store for profile icons. BaseURL is the public URL the stored files are served at.
+type Blob struct {
+	Dir     string
+	BaseURL string
+}
+
+// Defaults for the optional settings.
+const (
+	defaultDBPort = "3306"
+
+	defaultHTTPPort          = "8080"
+	defaultReadHeaderTimeout = 10 * time.Second
+	// Icon uploads of up to 5MB must fit into the read timeout
+	defaultReadTimeout     = 30 * time.Second
+	defaultWriteTimeout    = 30 * time.Second
+	defaultIdleTimeout     = 120 * time.Second
+	defaultShutdownTimeout = 20 * time.Second
+
+	defaultCORSAllowedOrigin = "https://2025-gopher-stamp-rally.vercel.app"
+
+	// QR codes are printed once per event day, so tokens must outlive the event
+	defaultStampTokenTTL = 24 * time.Hour
+
+	defaultBlobDir = "data/blobs"
+)
+
+// Booth screens rotate their code at least every minute, but not so often that
+// participants cannot scan it in time.
+const (
+	defaultStampCodePeriod = 30 * time.Second
+	minStampCodePeriod     = 30 * time.Second
+	maxStampCodePeriod     = 60 * time.Second
+)
+
+// Load reads the configuration. A variable missing from the environment is looked up in the file
+// named by CONFIG_FILE and then in .env in the working directory; both use the dotenv format and
+// .env may be absent. Every missing or invalid value is reported in the returned error.
+func Load() (*Config, error) {
+	sources := []map[string]string{}
+	if path := os.Getenv("CONFIG_FILE"); path != "" {
+		values, err := godotenv.Read(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CONFIG_FILE: %w", err)
+		}
+		sources = append(sources, values)
+	}
+	values, err := godotenv.Read()
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read .env: %w", err)
+	}
+	sources = append(sources, values)
+
+	return load(func(name string) string {
+		if v := os.Getenv(name); v != "" {
+			return v
+		}
+		for _, values := range sources {
+			if v := values[name]; v != "" {
+				return v
+			}
+		}
+		return ""
+	})
+}
+
+// load builds the configuration from lookup, which returns an empty string for unset variables.
+func load(lookup func(name string) string) (*Config, error) {
+	r := &reader{lookup: lookup}
+
+	httpPort := r.port("PORT", defaultHTTPPort)
+	cfg := &Config{
+		Database: Database{
+			Host:          r.required("DB_HOST"),
+			Port:          r.port("DB_PORT", defaultDBPort),
+			User:          r.required("DB_USER"),
+			Password:      lookup("DB_PASSWORD"),
+			Name:          r.required("DB_NAME"),
+			StampSeedFile: lookup("STAMP_SEED_FILE"),
+		},
+		HTTP: HTTP{
+			Port:              httpPort,
+			BasePath:          r.basePath("BASE_API_URL"),
+			ReadHeaderTimeout: r.duration("HTTP_READ_HEADER_TIMEOUT", defaultReadHeaderTimeout),
+			ReadTimeout:       r.duration("HTTP_READ_TIMEOUT", defaultReadTimeout),
+			WriteTimeout:      r.duration("HTTP_WRITE_TIMEOUT", defaultWriteTimeout),
+			IdleTimeout:       r.duration("HTTP_IDLE_TIMEOUT", defaultIdleTimeout),
+			ShutdownTimeout:   r.duration("SHUTDOWN_TIMEOUT", defaultShutdownTimeout),
+		},
+		CORS: CORS{
+			AllowedOrigins: r.origins(),
+		},
+		StampToken: StampToken{
+			Secret:     r.required("STAMP_TOKEN_SECRET"),
+			TTL:        r.duration("STAMP_TOKEN_TTL", defaultStampTokenTTL),
+			CodePeriod: r.stampCodePeriod("STAMP_CODE_PERIOD"),
+		},
+		Admin: Admin{
+			// Required so that organizer-only routes are never left open by accident
+			APIKey: r.required("ADMIN_API_KEY"),
+		},
+		Blob: Blob{
+			Dir:     r.optional("BLOB_STORAGE_DIR", defaultBlobDir),
+			BaseURL: r.optional("BLOB_BASE_URL", "http://localhost:"+httpPort+"/blobs"),
+		},
+	}
+
+	if err := errors.Join(r.errs...); err != nil {
+		return nil, fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	return cfg, nil
+}
+
+// reader reads variables and collects the problems with them, so that all are reported at once.
+type reader struct {
+	lookup func(name string) string
+	errs   []error
+}
+
+func (r *reader) fail(format string, args ...any) {
+	r.errs = append(r.errs, fmt.Errorf(format, args...))
+}
+
+func (r *reader) required(name string) string {
+	v := r.lookup(name)
+	if v == "" {
+		r.fail("%s must be set", name)
+	}
+	return v
+}
+
+func (r *reader) optional(name, def string) string {
+	if v := r.lookup(name); v != "" {
+		return v
+	}
+	return def
+}
+
+func (r *reader) port(name, def string) string {
+	v := r.optional(name, def)
+	if n, err := strconv.Atoi(v); err != nil || n < 1 || n > 65535 {
+		r.fail("%s must be a port number, got %q", name, v)
+	}
+	return v
+}
+
+// duration reads a positive Go duration such as "30s".
+func (r *reader) duration(name string, def time.Duration) time.Duration {
+	v := r.lookup(name)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		r.fail("invalid %s: %w", name, err)
+		return def
+	}
+	if d <= 0 {
+		r.fail("%s must be positive, got %s", name, v)
+		return def
+	}
+	return d
+}
+
+func (r *reader) stampCodePeriod(name string) time.Duration {
+	d := r.duration(name, defaultStampCodePeriod)
+	if d < minStampCodePeriod || d > maxStampCodePeriod || d%time.Second != 0 {
+		r.fail("%s must be a whole number of seconds between %s and %s", name, minStampCodePeriod, maxStampCodePeriod)
+	}
+	return d
+}
+
+// basePath reads a path prefix such as "/api". A trailing slash is dropped, so "/" means no prefix.
+func (r *reader) basePath(name string) string {
+	v := r.lookup(name)
+	if v == "" {
+		return ""
+	}
+	if !strings.HasPrefix(v, "/") {
+		r.fail("%s must be a path prefix such as /api, got %q", name, v)
+		return ""
+	}
+	return strings.TrimRight(v, "/")
+}
+
+// origins reads the comma-separated CORS_ALLOWED_ORIGINS. CORS_ALLOWED_ORIGIN is read when it is
+// not set, for deployments configured before several origins were supported.
+func (r *reader) origins() []string {
+	name := "CORS_ALLOWED_ORIGINS"
+	v := r.lookup(name)
+	if v == "" {
+		name = "CORS_ALLOWED_ORIGIN"
+		v = r.optional(name, defaultCORSAllowedOrigin)
+	}
+
+	var origins []string
+	for origin := range strings.SplitSeq(v, ",") {
+		origin = strings.TrimSpace(origin)
+		if origin == "" {
+			continue
+		}
+		// Browsers send the Origin header without a path, so anything else would never match
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User != nil ||
+			(u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+			r.fail("%s: %q is not an origin such as https://example.com", name, origin)
+			continue
+		}
+		origins = append(origins, u.Scheme+"://"+u.Host)
+	}
+	if len(origins) == 0 {
+		r.fail("%s must list at least one origin", name)
+	}
+	return origins
+}
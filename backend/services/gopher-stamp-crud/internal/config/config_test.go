@@ -0,0 +1,164 @@
+package config
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// requiredEnv holds the variables that must be set.
+var requiredEnv = map[string]string{
+	"DB_HOST":            "localhost",
+	"DB_USER":            "gopher",
+	"DB_NAME":            "stamprally_db",
+	"STAMP_TOKEN_SECRET": "secret",
+	"ADMIN_API_KEY":      "admin-key",
+}
+
+// lookupIn returns a lookup over requiredEnv overridden by env.
+func lookupIn(env map[string]string) func(string) string {
+	values := maps.Clone(requiredEnv)
+	maps.Copy(values, env)
+	return func(name string) string { return values[name] }
+}
+
+func TestLoad_Defaults(t *testing.T) {
+	cfg, err := load(lookupIn(nil))
+	require.NoError(t, err)
+
+	assert.Equal(t, Database{Host: "localhost", Port: "3306", User: "gopher", Name: "stamprally_db"}, cfg.Database)
+	assert.Equal(t, "gopher:@tcp(localhost:3306)/stamprally_db?charset=utf8mb4&parseTime=True&loc=Local", cfg.Database.DSN())
+	assert.Equal(t, HTTP{
+		Port:              "8080",
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       120 * time.Second,
+		ShutdownTimeout:   20 * time.Second,
+	}, cfg.HTTP)
+	assert.Equal(t, []string{"https://2025-gopher-stamp-rally.vercel.app"}, cfg.CORS.AllowedOrigins)
+	assert.Equal(t, StampToken{Secret: "secret", TTL: 24 * time.Hour, CodePeriod: 30 * time.Second}, cfg.StampToken)
+	assert.Equal(t, "admin-key", cfg.Admin.APIKey)
+	assert.Equal(t, Blob{Dir: "data/blobs", BaseURL: "http://localhost:8080/blobs"}, cfg.Blob)
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		check   func(t *testing.T, cfg *Config)
+		wantErr []string // every message must appear in the error
+	}{
+		{
+			name: "http settings",
+			env:  map[string]string{"PORT": "9000", "BASE_API_URL": "/api/", "HTTP_WRITE_TIMEOUT": "1m", "SHUTDOWN_TIMEOUT": "5s"},
+			check: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, "9000", cfg.HTTP.Port)
+				assert.Equal(t, "/api", cfg.HTTP.BasePath)
+				assert.Equal(t, time.Minute, cfg.HTTP.WriteTimeout)
+				assert.Equal(t, 5*time.Second, cfg.HTTP.ShutdownTimeout)
+				// The default blob URL follows the port
+				assert.Equal(t, "http://localhost:9000/blobs", cfg.Blob.BaseURL)
+			},
+		},
+		{
+			name: "several cors origins",
+			env:  map[string]string{"CORS_ALLOWED_ORIGINS": "https://example.com, http://localhost:3000/,"},
+			check: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, []string{"https://example.com", "http://localhost:3000"}, cfg.CORS.AllowedOrigins)
+			},
+		},
+		{
+			name: "single cors origin",
+			env:  map[string]string{"CORS_ALLOWED_ORIGIN": "http://localhost:3000"},
+			check: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, []string{"http://localhost:3000"}, cfg.CORS.AllowedOrigins)
+			},
+		},
+		{
+			name: "missing values",
+			env:  map[string]string{"DB_HOST": "", "STAMP_TOKEN_SECRET": "", "ADMIN_API_KEY": ""},
+			wantErr: []string{
+				"DB_HOST must be set",
+				"STAMP_TOKEN_SECRET must be set",
+				"ADMIN_API_KEY must be set",
+			},
+		},
+		{
+			name: "invalid values",
+			env: map[string]string{
+				"PORT":              "http",
+				"HTTP_READ_TIMEOUT": "soon",
+				"HTTP_IDLE_TIMEOUT": "-1s",
+				"STAMP_CODE_PERIOD": "90s",
+			},
+			wantErr: []string{
+				`PORT must be a port number, got "http"`,
+				"invalid HTTP_READ_TIMEOUT",
+				"HTTP_IDLE_TIMEOUT must be positive",
+				"STAMP_CODE_PERIOD must be a whole number of seconds between 30s and 1m0s",
+			},
+		},
+		{
+			name:    "full url as base path",
+			env:     map[string]string{"BASE_API_URL": "https://api.example.com"},
+			wantErr: []string{`BASE_API_URL must be a path prefix such as /api, got "https://api.example.com"`},
+		},
+		{
+			name: "cors origin with path",
+			env:  map[string]string{"CORS_ALLOWED_ORIGINS": "https://example.com/app,example.com"},
+			wantErr: []string{
+				`CORS_ALLOWED_ORIGINS: "https://example.com/app" is not an origin`,
+				`CORS_ALLOWED_ORIGINS: "example.com" is not an origin`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := load(lookupIn(tt.env))
+			if len(tt.wantErr) > 0 {
+				require.Error(t, err)
+				assert.Nil(t, cfg)
+				for _, msg := range tt.wantErr {
+					assert.Contains(t, err.Error(), msg)
+				}
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, cfg)
+		})
+	}
+}
+
+func TestLoad_Files(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	require.NoError(t, os.WriteFile(".env", []byte("DB_HOST=dotenv\nDB_USER=dotenv\nDB_NAME=dotenv\nADMIN_API_KEY=dotenv\n"), 0o600))
+	configFile := filepath.Join(dir, "stamprally.env")
+	require.NoError(t, os.WriteFile(configFile, []byte("DB_USER=file\nDB_NAME=file\nSTAMP_TOKEN_SECRET=file\n"), 0o600))
+
+	for name := range requiredEnv {
+		t.Setenv(name, "")
+	}
+	t.Setenv("DB_NAME", "env")
+	t.Setenv("CONFIG_FILE", configFile)
+
+	// The environment comes first, then CONFIG_FILE, then .env
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "dotenv", cfg.Database.Host)
+	assert.Equal(t, "file", cfg.Database.User)
+	assert.Equal(t, "env", cfg.Database.Name)
+	assert.Equal(t, "file", cfg.StampToken.Secret)
+	assert.Equal(t, "dotenv", cfg.Admin.APIKey)
+
+	t.Setenv("CONFIG_FILE", filepath.Join(dir, "missing.env"))
+	_, err = Load()
+	assert.ErrorContains(t, err, "failed to read CONFIG_FILE")
+}
@@ -0,0 +1,83 @@
+// Package apperr defines the errors usecases return to describe expected failures.
+//
+// Each error carries a Code that the interface layer maps to an HTTP status and
+// the code field of openapi.Error. Any error that is not an *Error is treated as
+// an unexpected internal failure, so infrastructure errors such as a lost database
+// connection are never mistaken for client errors.
+package apperr
+
+// Code classifies an error. Its value is exposed to clients as openapi.Error.Code.
+type Code string
+
+const (
+	CodeInvalidRequest    Code = "INVALID_REQUEST"
+	CodeUnauthorized      Code = "UNAUTHORIZED"
+	CodeForbidden         Code = "FORBIDDEN"
+	CodeInvalidStampToken Code = "INVALID_STAMP_TOKEN"
+	CodeInvalidStampCode  Code = "INVALID_STAMP_CODE"
+	CodeStampNotActive    Code = "STAMP_NOT_ACTIVE"
+	CodeNotFound          Code = "NOT_FOUND"
+	CodeAlreadyExists     Code = "ALREADY_EXISTS"
+	CodeRewardNotEarned   Code = "REWARD_NOT_EARNED"
+	CodeAlreadyRedeemed   Code = "REWARD_ALREADY_REDEEMED"
+	CodeInvalidIcon       Code = "INVALID_ICON"
+	CodeTooManyRequests   Code = "TOO_MANY_REQUESTS"
+	CodeInternal          Code = "INTERNAL_ERROR"
+)
+
+var (
+	ErrUserNotFound          = New(CodeNotFound, "user not found")
+	ErrStampNotFound         = New(CodeNotFound, "stamp not found")
+	ErrEventNotFound         = New(CodeNotFound, "event not found")
+	ErrRewardRuleNotFound    = New(CodeNotFound, "reward not found")
+	ErrWebhookNotFound       = New(CodeNotFound, "webhook not found")
+	ErrStampAlreadyAcquired  = New(CodeAlreadyExists, "stamp already acquired")
+	ErrStampNotActive        = New(CodeStampNotActive, "stamp is not available at this time")
+	ErrRewardNotEarned       = New(CodeRewardNotEarned, "reward has not been earned")
+	ErrRewardAlreadyRedeemed = New(CodeAlreadyRedeemed, "reward already redeemed")
+	ErrStampNameRequired     = New(CodeInvalidRequest, "name must be provided")
+	ErrEventNameRequired     = New(CodeInvalidRequest, "event name must be provided")
+	ErrInvalidEventPeriod    = New(CodeInvalidRequest, "ends_at must be after starts_at")
+	ErrInvalidStampWindow    = New(CodeInvalidRequest, "available_until must be after available_from")
+	ErrInvalidRewardRule     = New(CodeInvalidRequest, "invalid reward rule")
+	ErrInvalidWebhook        = New(CodeInvalidRequest, "invalid webhook")
+	ErrInvalidUserSort       = New(CodeInvalidRequest, "sort must be one of created_at, name or stamp_count")
+	ErrInvalidSortOrder      = New(CodeInvalidRequest, "order must be asc or desc")
+	ErrInvalidUserProfile    = New(CodeInvalidRequest, "invalid user profile")
+	ErrInvalidIcon           = New(CodeInvalidIcon, "invalid icon")
+	ErrInvalidCredentials    = New(CodeUnauthorized, "invalid credentials")
+)
+
+// Error is an expected failure with a client-facing code and message.
+type Error struct {
+	Code    Code
+	Message string
+	// Details is optional additional information, such as the reason a request body was rejected.
+	Details string
+}
+
+// New creates an Error.
+func New(code Code, message string) *Error {
+	return &Error{
+		Code:    code,
+		Message: message,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is reports whether target is an *Error with the same code and message,
+// so errors derived with WithDetails still match their sentinel.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code && t.Message == e.Message
+}
+
+// WithDetails returns a copy of e carrying details.
+func (e *Error) WithDetails(details string) *Error {
+	cp := *e
+	cp.Details = details
+	return &cp
+}
@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+// DefaultEventID is the event created by migration 006 for the stamps and participants that existed
+// before multiple events were supported. Routes without an /events/{event_id} prefix operate on it.
+const DefaultEventID uint = 1
+
+// Event is a single stamp rally. Stamps and participants belong to exactly one event.
+// When set, StartsAt and EndsAt also bound when any of the event's stamps can be acquired.
+type Event struct {
+	ID        uint       `json:"id" gorm:"primaryKey"`
+	Name      string     `json:"name" gorm:"size:100;not null"`
+	StartsAt  *time.Time `json:"starts_at,omitempty"` // 開催期間。デフォルトイベントでは未設定
+	EndsAt    *time.Time `json:"ends_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// FeedEventType is the kind of a FeedEvent.
+type FeedEventType string
+
+const (
+	// FeedUserRegistered is published when a participant registers.
+	FeedUserRegistered FeedEventType = "user_registered"
+	// FeedStampAcquired is published when a participant acquires a stamp.
+	FeedStampAcquired FeedEventType = "stamp_acquired"
+	// FeedLeaderboardChanged is published with a participant's new stamp count after they acquire a stamp.
+	FeedLeaderboardChanged FeedEventType = "leaderboard"
+)
+
+// FeedEvent is something that happened in a stamp rally, pushed to the venue screen and the
+// participants page as it happens.
+type FeedEvent struct {
+	Type          FeedEventType
+	EventID       uint
+	UserID        uint
+	UserName      string
+	IconThumbnail *string
+	StampID       uint   // FeedStampAcquired
+	StampName     string // FeedStampAcquired
+	StampCount    int64  // FeedLeaderboardChanged
+	OccurredAt    time.Time
+}
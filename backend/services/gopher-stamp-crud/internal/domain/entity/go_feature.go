@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// GoFeature is an entry of the catalogue participants choose their favorite Go features from,
+// such as concurrency or interfaces.
+type GoFeature struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	Code      string    `json:"code" gorm:"size:50;not null;uniqueIndex"` // クライアントが送受信する識別子
+	Label     string    `json:"label" gorm:"size:100;not null"`
+	SortOrder int       `json:"sort_order" gorm:"not null;default:0"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// UserGoFeature records that a participant chose a Go feature as a favorite.
+type UserGoFeature struct {
+	UserID      uint `json:"user_id" gorm:"primaryKey"`
+	GoFeatureID uint `json:"go_feature_id" gorm:"primaryKey"`
+}
+
+// GoFeatureCount is the number of an event's participants who chose a Go feature.
+type GoFeatureCount struct {
+	GoFeature
+	UserCount int64 `json:"user_count"`
+}
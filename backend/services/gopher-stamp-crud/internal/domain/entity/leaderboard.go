@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// LeaderboardEntry is a participant's standing in their event.
+type LeaderboardEntry struct {
+	Rank           int        `json:"rank" gorm:"-"`
+	UserID         uint       `json:"user_id"`
+	Name           string     `json:"name"`
+	StampCount     int64      `json:"stamp_count"`
+	LastAcquiredAt *time.Time `json:"last_acquired_at,omitempty"` // 最後にスタンプを取得した日時。未取得ならnil
+}
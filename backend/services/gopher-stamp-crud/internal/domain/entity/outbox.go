@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// OutboxMessageType is the kind of an OutboxMessage and tells sinks how to decode its payload.
+type OutboxMessageType string
+
+const (
+	// OutboxUserRegistered carries a UserRegistration.
+	OutboxUserRegistered OutboxMessageType = "user.registered"
+	// OutboxStampAcquired carries a StampAcquisition.
+	OutboxStampAcquired OutboxMessageType = "stamp.acquired"
+)
+
+// OutboxMessage is a domain event written in the same transaction as the change it describes,
+// so that it is published even if the server stops right after the commit. The relay publishes
+// pending messages to the sinks and then sets DeliveredAt; a message may be published more than once.
+// A message that fails is retried at RetryAt, and after too many attempts FailedAt sets it aside.
+type OutboxMessage struct {
+	ID          uint              `json:"id" gorm:"primaryKey"`
+	Type        OutboxMessageType `json:"type" gorm:"size:50;not null"`
+	UserID      uint              `json:"user_id" gorm:"not null;index"` // 削除された参加者のメッセージを消すために使う
+	Payload     string            `json:"payload" gorm:"type:text;not null"`
+	Attempts    int               `json:"attempts" gorm:"not null;default:0"` // 失敗した配信の回数
+	LastError   *string           `json:"last_error,omitempty" gorm:"size:500"`
+	RetryAt     *time.Time        `json:"retry_at,omitempty"` // 再試行を待っていない場合はnil
+	CreatedAt   time.Time         `json:"created_at" gorm:"autoCreateTime"`
+	DeliveredAt *time.Time        `json:"delivered_at,omitempty"` // 未配信の場合はnil
+	FailedAt    *time.Time        `json:"failed_at,omitempty"`    // 配信をあきらめていない場合はnil
+}
+
+func (OutboxMessage) TableName() string {
+	return "outbox"
+}
+
+// NewOutboxMessage returns a message of the type about the participant carrying payload encoded as JSON.
+func NewOutboxMessage(messageType OutboxMessageType, userID uint, payload any) (*OutboxMessage, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &OutboxMessage{Type: messageType, UserID: userID, Payload: string(b)}, nil
+}
+
+// Decode unmarshals the payload into v.
+func (m *OutboxMessage) Decode(v any) error {
+	return json.Unmarshal([]byte(m.Payload), v)
+}
+
+// UserRegistration is the payload of OutboxUserRegistered.
+type UserRegistration struct {
+	EventID       uint      `json:"event_id"`
+	UserID        uint      `json:"user_id"`
+	UserName      string    `json:"user_name"`
+	IconThumbnail *string   `json:"icon_thumbnail,omitempty"`
+	RegisteredAt  time.Time `json:"registered_at"`
+}
+
+// StampAcquisition is the payload of OutboxStampAcquired.
+type StampAcquisition struct {
+	EventID        uint      `json:"event_id"`
+	UserID         uint      `json:"user_id"`
+	UserName       string    `json:"user_name"`
+	IconThumbnail  *string   `json:"icon_thumbnail,omitempty"`
+	StampID        uint      `json:"stamp_id"`
+	StampName      string    `json:"stamp_name"`
+	StampCount     int64     `json:"stamp_count"`     // 取得後の取得済みスタンプ数
+	CompletesRally bool      `json:"completes_rally"` // イベントの全スタンプがそろった場合はtrue
+	AcquiredAt     time.Time `json:"acquired_at"`
+}
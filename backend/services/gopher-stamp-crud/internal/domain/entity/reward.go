@@ -0,0 +1,60 @@
+package entity
+
+import "time"
+
+// RewardKind is how a reward rule decides that a participant has completed it.
+type RewardKind string
+
+const (
+	// RewardKindAll requires every stamp of the event.
+	RewardKindAll RewardKind = "all"
+	// RewardKindCount requires any RequiredCount stamps of the event.
+	RewardKindCount RewardKind = "count"
+	// RewardKindStamps requires every stamp listed in Stamps.
+	RewardKindStamps RewardKind = "stamps"
+)
+
+// RewardRule is a prize of an event and the condition for earning it,
+// such as "all stamps", "any 5 of 8" or "both workshops".
+type RewardRule struct {
+	ID            uint       `json:"id" gorm:"primaryKey"`
+	EventID       uint       `json:"event_id" gorm:"not null;index"`
+	Name          string     `json:"name" gorm:"size:100;not null"`
+	Kind          RewardKind `json:"kind" gorm:"size:20;not null"`
+	RequiredCount int        `json:"required_count,omitempty" gorm:"not null;default:0"`                               // RewardKindCountのみ
+	Stamps        []Stamp    `json:"stamps,omitempty" gorm:"many2many:reward_rule_stamps;constraint:OnDelete:CASCADE"` // RewardKindStampsのみ
+	CreatedAt     time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// IsSatisfied reports whether a participant holding the acquired stamps has earned the reward.
+// eventStampCount is the number of stamps in the event and is only consulted by RewardKindAll.
+func (r *RewardRule) IsSatisfied(acquired map[uint]bool, eventStampCount int64) bool {
+	switch r.Kind {
+	case RewardKindAll:
+		return eventStampCount > 0 && int64(len(acquired)) >= eventStampCount
+	case RewardKindCount:
+		return r.RequiredCount > 0 && len(acquired) >= r.RequiredCount
+	case RewardKindStamps:
+		if len(r.Stamps) == 0 {
+			return false
+		}
+		for _, s := range r.Stamps {
+			if !acquired[s.ID] {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// UserReward records that a participant earned a reward, and whether staff have handed it out.
+type UserReward struct {
+	UserID       uint       `json:"user_id" gorm:"primaryKey"`
+	RewardRuleID uint       `json:"reward_rule_id" gorm:"primaryKey"`
+	EarnedAt     time.Time  `json:"earned_at" gorm:"autoCreateTime"`
+	RedeemedAt   *time.Time `json:"redeemed_at,omitempty"` // 景品の受け渡し日時。未受け取りならnil
+	User         User       `json:"user" gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE"`
+	RewardRule   RewardRule `json:"reward_rule" gorm:"foreignKey:RewardRuleID;references:ID;constraint:OnDelete:CASCADE"`
+}
@@ -3,8 +3,41 @@ package entity
 import "time"
 
 type Stamp struct {
-	ID        uint      `json:"id" gorm:"primaryKey"`
-	Name      string    `json:"name" gorm:"size:100;not null"`
+	ID      uint    `json:"id" gorm:"primaryKey"`
+	EventID uint    `json:"event_id" gorm:"not null;uniqueIndex:idx_stamps_event_seed_key,priority:1"`
+	SeedKey *string `json:"-" gorm:"size:100;uniqueIndex:idx_stamps_event_seed_key,priority:2"` // シードファイル上のキー。APIで作成したスタンプはnil
+	Name    string  `json:"name" gorm:"size:100;not null"`
+	Secret  string  `json:"-" gorm:"size:64;not null;default:''"` // ブース画面のローテーションコード生成用の秘密鍵
+
+	// Acquisition window. Either bound may be nil for no limit on that side.
+	AvailableFrom  *time.Time `json:"available_from,omitempty"`
+	AvailableUntil *time.Time `json:"available_until,omitempty"`
+
 	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
 }
+
+// AcquisitionWindow returns the period in which the stamp can be acquired: its own window narrowed
+// by the window of the event it belongs to. A nil bound is unlimited.
+func (s *Stamp) AcquisitionWindow(event *Event) (from, until *time.Time) {
+	from, until = s.AvailableFrom, s.AvailableUntil
+	if event.StartsAt != nil && (from == nil || event.StartsAt.After(*from)) {
+		from = event.StartsAt
+	}
+	if event.EndsAt != nil && (until == nil || event.EndsAt.Before(*until)) {
+		until = event.EndsAt
+	}
+	return from, until
+}
+
+// CanBeAcquiredAt reports whether t lies in the stamp's acquisition window, [from, until).
+func (s *Stamp) CanBeAcquiredAt(event *Event, t time.Time) bool {
+	from, until := s.AcquisitionWindow(event)
+	if from != nil && t.Before(*from) {
+		return false
+	}
+	if until != nil && !t.Before(*until) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,37 @@
+package entity
+
+import "time"
+
+// EventStats summarizes how an event's stamp rally went, for its organizers.
+type EventStats struct {
+	EventID        uint                     `json:"event_id"`
+	Participants   int64                    `json:"participants"`
+	Completed      int64                    `json:"completed"`       // 全スタンプを取得した参加者数
+	CompletionRate float64                  `json:"completion_rate"` // Completed / Participants。参加者がいなければ0
+	Stamps         []StampAcquisitionCount  `json:"stamps"`
+	Hourly         []HourlyAcquisitionCount `json:"hourly_acquisitions"`
+	// MedianCompletion is the median time participants who completed the rally took from registering
+	// to acquiring their last stamp. Nil when nobody has completed it.
+	MedianCompletion *time.Duration `json:"median_completion,omitempty"`
+}
+
+// StampAcquisitionCount is the number of participants who acquired a stamp.
+type StampAcquisitionCount struct {
+	StampID      uint   `json:"stamp_id"`
+	Name         string `json:"name"`
+	Acquisitions int64  `json:"acquisitions"`
+}
+
+// HourlyAcquisitionCount is the number of stamps acquired in the hour starting at Hour.
+type HourlyAcquisitionCount struct {
+	Hour         time.Time `json:"hour"`
+	Acquisitions int64     `json:"acquisitions"`
+}
+
+// Completion is when a participant who acquired every stamp of their event registered and
+// acquired their last stamp.
+type Completion struct {
+	UserID       uint      `json:"user_id"`
+	RegisteredAt time.Time `json:"registered_at"`
+	CompletedAt  time.Time `json:"completed_at"`
+}
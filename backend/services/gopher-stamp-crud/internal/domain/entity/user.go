@@ -5,7 +5,8 @@ import "time"
 // User represents a participant of the stamp rally.
 // It corresponds to the OpenAPI User schema.
 type User struct {
-	ID uint `json:"id" gorm:"primaryKey"`
+	ID      uint `json:"id" gorm:"primaryKey"`
+	EventID uint `json:"event_id" gorm:"not null;index"`
 
 	// Basic profile
 	Name string `json:"name" gorm:"size:100;not null"` // ニックネームとして使用
@@ -13,7 +14,11 @@ type User struct {
 	// Optional metadata used by the frontend
 	TwitterID         *string `json:"twitter_id,omitempty" gorm:"size:50"`
 	FavoriteGoFeature *string `json:"favorite_go_feature,omitempty" gorm:"size:500"`
-	Icon              *string `json:"icon,omitempty" gorm:"type:longtext"` // base64エンコードされた画像を保存するためLONGTEXTを使用
+	Icon              *string `json:"icon,omitempty" gorm:"type:longtext"`       // アイコン画像のURL。移行前の登録データはbase64のためLONGTEXTを使用
+	IconThumbnail     *string `json:"icon_thumbnail,omitempty" gorm:"size:2048"` // 一覧表示用の縮小画像のURL
+
+	// Go features chosen as favorites. Only loaded; the choice is saved through GoFeatureRepository
+	GoFeatures []GoFeature `json:"go_features,omitempty" gorm:"many2many:user_go_features"`
 
 	// Timestamps
 	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
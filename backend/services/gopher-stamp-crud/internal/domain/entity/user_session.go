@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// UserSession is a bearer credential issued to a participant when they register.
+// Only the SHA-256 hash of the token is stored.
+type UserSession struct {
+	TokenHash string    `json:"-" gorm:"primaryKey;size:64"`
+	UserID    uint      `json:"user_id" gorm:"not null;index"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	User      User      `json:"-" gorm:"foreignKey:UserID;references:ID;constraint:OnDelete:CASCADE"`
+}
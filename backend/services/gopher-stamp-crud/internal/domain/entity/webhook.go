@@ -0,0 +1,86 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// WebhookEventType is the kind of a WebhookEvent. Its value is sent to receivers as the event type.
+type WebhookEventType string
+
+const (
+	// WebhookUserRegistered is sent when a participant registers.
+	WebhookUserRegistered WebhookEventType = "user.registered"
+	// WebhookStampAcquired is sent when a participant acquires a stamp.
+	WebhookStampAcquired WebhookEventType = "stamp.acquired"
+	// WebhookRallyCompleted is sent when a participant acquires the last stamp of their event.
+	WebhookRallyCompleted WebhookEventType = "rally.completed"
+)
+
+// WebhookEventTypes lists the event types a webhook can subscribe to.
+var WebhookEventTypes = []WebhookEventType{
+	WebhookUserRegistered,
+	WebhookStampAcquired,
+	WebhookRallyCompleted,
+}
+
+// Webhook is an organizer's subscription to the events of a stamp rally, such as a chat
+// integration or the venue display. Requests to URL are signed with Secret.
+type Webhook struct {
+	ID         uint               `json:"id" gorm:"primaryKey"`
+	EventID    uint               `json:"event_id" gorm:"not null;index"`
+	URL        string             `json:"url" gorm:"size:2048;not null"`
+	Secret     string             `json:"-" gorm:"size:128;not null"`
+	EventTypes []WebhookEventType `json:"event_types" gorm:"serializer:json;not null"`
+	CreatedAt  time.Time          `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time          `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// Subscribes reports whether the webhook receives events of the type.
+func (w *Webhook) Subscribes(eventType WebhookEventType) bool {
+	return slices.Contains(w.EventTypes, eventType)
+}
+
+// WebhookEvent is the payload sent to the webhooks of a stamp rally.
+type WebhookEvent struct {
+	// ID identifies the event across retries and webhooks, so receivers can ignore duplicates.
+	ID         string           `json:"id"`
+	Type       WebhookEventType `json:"type"`
+	EventID    uint             `json:"event_id"`
+	UserID     uint             `json:"user_id"`
+	UserName   string           `json:"user_name"`
+	StampID    uint             `json:"stamp_id,omitempty"`    // WebhookStampAcquired, WebhookRallyCompleted
+	StampName  string           `json:"stamp_name,omitempty"`  // WebhookStampAcquired, WebhookRallyCompleted
+	StampCount int64            `json:"stamp_count,omitempty"` // WebhookStampAcquired, WebhookRallyCompleted
+	OccurredAt time.Time        `json:"occurred_at"`
+}
+
+// WebhookJob is an event waiting to be delivered to one webhook. Jobs are stored when the event is
+// dispatched and removed once the delivery succeeds or is given up, so deliveries survive a restart.
+type WebhookJob struct {
+	ID            uint             `json:"id" gorm:"primaryKey"`
+	WebhookID     uint             `json:"webhook_id" gorm:"not null;uniqueIndex:uk_webhook_jobs_webhook_message"`
+	MessageID     string           `json:"message_id" gorm:"size:64;not null;uniqueIndex:uk_webhook_jobs_webhook_message"` // WebhookEvent.ID
+	EventType     WebhookEventType `json:"event_type" gorm:"size:50;not null"`
+	UserID        uint             `json:"user_id" gorm:"not null;index"` // 削除された参加者の通知を消すために使う
+	Payload       string           `json:"payload" gorm:"type:text;not null"`
+	Attempts      int              `json:"attempts" gorm:"not null;default:0"` // これまでの配信試行回数
+	NextAttemptAt time.Time        `json:"next_attempt_at" gorm:"not null;index"`
+	CreatedAt     time.Time        `json:"created_at" gorm:"autoCreateTime"`
+	Webhook       Webhook          `json:"-" gorm:"foreignKey:WebhookID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+// WebhookDelivery records one attempt to deliver an event to a webhook.
+type WebhookDelivery struct {
+	ID          uint             `json:"id" gorm:"primaryKey"`
+	WebhookID   uint             `json:"webhook_id" gorm:"not null;index"`
+	EventType   WebhookEventType `json:"event_type" gorm:"size:50;not null"`
+	UserID      uint             `json:"user_id" gorm:"not null;index"` // 削除された参加者の通知を消すために使う
+	Payload     string           `json:"payload" gorm:"type:text;not null"`
+	Attempt     int              `json:"attempt" gorm:"not null"`
+	StatusCode  *int             `json:"status_code,omitempty"` // 応答がなかった場合はnil
+	Error       *string          `json:"error,omitempty" gorm:"size:500"`
+	Succeeded   bool             `json:"succeeded" gorm:"not null"`
+	AttemptedAt time.Time        `json:"attempted_at" gorm:"not null"`
+	Webhook     Webhook          `json:"-" gorm:"foreignKey:WebhookID;references:ID;constraint:OnDelete:CASCADE"`
+}
@@ -0,0 +1,195 @@
+// Package icon turns uploaded profile images into the square icon and thumbnail
+// that are stored in the blob store and shown on the participants page.
+package icon
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"strings"
+
+	// Register the decoders of the accepted formats with image.Decode
+	_ "image/gif"
+
+	_ "golang.org/x/image/webp"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+)
+
+const (
+	// MaxBytes is the largest image accepted, before decoding.
+	MaxBytes = 5 << 20
+	// MaxPixels bounds the decoded size, so that a small but huge-dimensioned image cannot
+	// exhaust memory. 25 megapixels covers photos taken with current phone cameras.
+	MaxPixels = 25_000_000
+
+	// Size is the side of the stored icon. Smaller images are kept at their own size.
+	Size = 512
+	// ThumbnailSize is the side of the thumbnail used in participant lists.
+	ThumbnailSize = 128
+
+	jpegQuality = 85
+)
+
+// acceptedTypes are the content types that can be decoded, as sniffed by http.DetectContentType.
+var acceptedTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// Image is an encoded square image ready to be stored.
+type Image struct {
+	Data        []byte
+	ContentType string
+}
+
+// Extension returns the file extension matching the content type, including the dot.
+func (i Image) Extension() string {
+	if i.ContentType == "image/png" {
+		return ".png"
+	}
+	return ".jpg"
+}
+
+// DecodeDataURL decodes an image sent inline as a data URL ("data:image/png;base64,...")
+// or as bare base64, rejecting input that would decode to more than MaxBytes.
+func DecodeDataURL(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://") {
+		return nil, apperr.ErrInvalidIcon.WithDetails("icon must be the image itself as a data URL or base64, not a link")
+	}
+	if rest, ok := strings.CutPrefix(s, "data:"); ok {
+		_, payload, found := strings.Cut(rest, ";base64,")
+		if !found {
+			return nil, apperr.ErrInvalidIcon.WithDetails("icon must be a base64 data URL")
+		}
+		s = payload
+	}
+
+	s = strings.TrimRight(s, "=")
+	if len(s) > base64.RawStdEncoding.EncodedLen(MaxBytes) {
+		return nil, errTooLarge
+	}
+	data, err := base64.RawStdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, apperr.ErrInvalidIcon.WithDetails("icon is not valid base64")
+	}
+	return data, nil
+}
+
+var errTooLarge = apperr.ErrInvalidIcon.WithDetails(fmt.Sprintf("icon must be at most %d MB", MaxBytes>>20))
+
+// Normalize validates an uploaded image and renders it as a center-cropped square icon and
+// thumbnail. Opaque images are encoded as JPEG and images with transparency as PNG.
+// Re-encoding drops all metadata of the upload, such as the GPS position in a photo's EXIF,
+// after the EXIF orientation has been applied. Invalid input is reported as apperr.ErrInvalidIcon.
+func Normalize(data []byte) (icon, thumbnail Image, err error) {
+	if len(data) == 0 {
+		return Image{}, Image{}, apperr.ErrInvalidIcon.WithDetails("icon must not be empty")
+	}
+	if len(data) > MaxBytes {
+		return Image{}, Image{}, errTooLarge
+	}
+	contentType := http.DetectContentType(data)
+	if !acceptedTypes[contentType] {
+		return Image{}, Image{}, apperr.ErrInvalidIcon.WithDetails("icon must be a PNG, JPEG, WebP or GIF image")
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, Image{}, apperr.ErrInvalidIcon.WithDetails("icon could not be decoded")
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > MaxPixels {
+		return Image{}, Image{}, apperr.ErrInvalidIcon.WithDetails(fmt.Sprintf("icon must be at most %d pixels", MaxPixels))
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, Image{}, apperr.ErrInvalidIcon.WithDetails("icon could not be decoded")
+	}
+
+	square := cropSquare(src)
+	if contentType == "image/jpeg" {
+		square = orient(square, exifOrientation(data))
+	}
+	side := square.Bounds().Dx()
+	if icon, err = encode(scale(square, min(side, Size))); err != nil {
+		return Image{}, Image{}, err
+	}
+	if thumbnail, err = encode(scale(square, min(side, ThumbnailSize))); err != nil {
+		return Image{}, Image{}, err
+	}
+	return icon, thumbnail, nil
+}
+
+// cropSquare copies the largest centered square of src into an RGBA image.
+func cropSquare(src image.Image) *image.RGBA {
+	b := src.Bounds()
+	side := min(b.Dx(), b.Dy())
+	origin := image.Pt(b.Min.X+(b.Dx()-side)/2, b.Min.Y+(b.Dy()-side)/2)
+
+	dst := image.NewRGBA(image.Rect(0, 0, side, side))
+	draw.Draw(dst, dst.Bounds(), src, origin, draw.Src)
+	return dst
+}
+
+// scale resizes the square src to size×size. Each destination pixel is the average of the
+// source pixels it covers, which keeps downscaled photos smooth without an imaging library.
+func scale(src *image.RGBA, size int) *image.RGBA {
+	side := src.Bounds().Dx()
+	if side == size {
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	for dy := 0; dy < size; dy++ {
+		sy0 := dy * side / size
+		sy1 := max((dy+1)*side/size, sy0+1)
+		for dx := 0; dx < size; dx++ {
+			sx0 := dx * side / size
+			sx1 := max((dx+1)*side/size, sx0+1)
+
+			var r, g, b, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				i := src.PixOffset(sx0, sy)
+				for sx := sx0; sx < sx1; sx++ {
+					r += uint64(src.Pix[i])
+					g += uint64(src.Pix[i+1])
+					b += uint64(src.Pix[i+2])
+					a += uint64(src.Pix[i+3])
+					n++
+					i += 4
+				}
+			}
+
+			j := dst.PixOffset(dx, dy)
+			dst.Pix[j] = uint8(r / n)
+			dst.Pix[j+1] = uint8(g / n)
+			dst.Pix[j+2] = uint8(b / n)
+			dst.Pix[j+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
+
+func encode(img *image.RGBA) (Image, error) {
+	var buf bytes.Buffer
+	if img.Opaque() {
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return Image{}, fmt.Errorf("failed to encode icon: %w", err)
+		}
+		return Image{Data: buf.Bytes(), ContentType: "image/jpeg"}, nil
+	}
+
+	if err := png.Encode(&buf, img); err != nil {
+		return Image{}, fmt.Errorf("failed to encode icon: %w", err)
+	}
+	return Image{Data: buf.Bytes(), ContentType: "image/png"}, nil
+}
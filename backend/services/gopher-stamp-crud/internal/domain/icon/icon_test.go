@@ -0,0 +1,174 @@
+package icon
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"strings"
+	"testing"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func filled(w, h int, c color.Color) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func decode(t *testing.T, img Image) image.Image {
+	t.Helper()
+	decoded, format, err := image.Decode(bytes.NewReader(img.Data))
+	require.NoError(t, err)
+	assert.Equal(t, "image/"+format, img.ContentType)
+	return decoded
+}
+
+func TestNormalize(t *testing.T) {
+	t.Run("opaque photo is cropped, scaled and encoded as JPEG", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, jpeg.Encode(&buf, filled(1200, 800, color.White), nil))
+
+		icon, thumbnail, err := Normalize(buf.Bytes())
+		require.NoError(t, err)
+
+		assert.Equal(t, "image/jpeg", icon.ContentType)
+		assert.Equal(t, ".jpg", icon.Extension())
+		assert.Equal(t, image.Rect(0, 0, Size, Size), decode(t, icon).Bounds())
+		assert.Equal(t, image.Rect(0, 0, ThumbnailSize, ThumbnailSize), decode(t, thumbnail).Bounds())
+	})
+
+	t.Run("transparent image stays PNG", func(t *testing.T) {
+		icon, thumbnail, err := Normalize(encodePNG(t, filled(300, 300, color.NRGBA{R: 255, A: 0})))
+		require.NoError(t, err)
+
+		assert.Equal(t, ".png", icon.Extension())
+		assert.Equal(t, image.Rect(0, 0, 300, 300), decode(t, icon).Bounds())
+		assert.Equal(t, image.Rect(0, 0, ThumbnailSize, ThumbnailSize), decode(t, thumbnail).Bounds())
+	})
+
+	t.Run("small image is not upscaled", func(t *testing.T) {
+		icon, thumbnail, err := Normalize(encodePNG(t, filled(64, 100, color.Black)))
+		require.NoError(t, err)
+
+		assert.Equal(t, image.Rect(0, 0, 64, 64), decode(t, icon).Bounds())
+		assert.Equal(t, image.Rect(0, 0, 64, 64), decode(t, thumbnail).Bounds())
+	})
+
+	t.Run("gif", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, gif.Encode(&buf, filled(10, 10, color.Black), nil))
+
+		_, _, err := Normalize(buf.Bytes())
+		assert.NoError(t, err)
+	})
+
+	t.Run("webp", func(t *testing.T) {
+		data, err := os.ReadFile("testdata/gopher.webp")
+		require.NoError(t, err)
+
+		icon, _, err := Normalize(data)
+		require.NoError(t, err)
+		assert.Contains(t, []string{"image/jpeg", "image/png"}, icon.ContentType)
+	})
+
+	t.Run("exif is stripped after applying the orientation", func(t *testing.T) {
+		// Left half black, right half white, stored as needing a 90° clockwise rotation
+		src := filled(20, 20, color.White)
+		for y := 0; y < 20; y++ {
+			for x := 0; x < 10; x++ {
+				src.Set(x, y, color.Black)
+			}
+		}
+		var buf bytes.Buffer
+		require.NoError(t, jpeg.Encode(&buf, src, &jpeg.Options{Quality: 100}))
+		data := withExif(buf.Bytes(), exifOrientationSegment(6))
+
+		icon, _, err := Normalize(data)
+		require.NoError(t, err)
+		assert.NotContains(t, string(icon.Data), "Exif")
+
+		// Rotated clockwise, the black half ends up at the top
+		got := decode(t, icon)
+		top, _, _, _ := got.At(10, 2).RGBA()
+		bottom, _, _, _ := got.At(10, 17).RGBA()
+		assert.Less(t, top, uint32(0x4000))
+		assert.Greater(t, bottom, uint32(0xc000))
+	})
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "not an image", data: []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>")},
+		{name: "truncated png", data: encodePNG(t, filled(10, 10, color.Black))[:40]},
+		{name: "too large", data: append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, MaxBytes)...)},
+		{name: "too many pixels", data: encodePNG(t, image.NewGray(image.Rect(0, 0, 10000, 2501)))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := Normalize(tt.data)
+			assert.ErrorIs(t, err, apperr.ErrInvalidIcon)
+		})
+	}
+}
+
+func TestDecodeDataURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "data url", input: "data:image/png;base64,aGVsbG8=", want: "hello"},
+		{name: "bare base64", input: "aGVsbG8=", want: "hello"},
+		{name: "without padding", input: "aGVsbG8", want: "hello"},
+		{name: "surrounding whitespace", input: " aGVsbG8=\n", want: "hello"},
+		{name: "data url without base64", input: "data:image/svg+xml,<svg/>", wantErr: true},
+		{name: "invalid base64", input: "not base64!", wantErr: true},
+		{name: "link", input: "https://example.com/icon.png", wantErr: true},
+		{name: "too large", input: strings.Repeat("A", MaxBytes/3*4+8), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeDataURL(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, apperr.ErrInvalidIcon)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestScale(t *testing.T) {
+	// A 2x2 checkerboard averages to mid gray
+	src := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	src.Set(0, 0, color.White)
+	src.Set(1, 1, color.White)
+	src.Set(1, 0, color.Black)
+	src.Set(0, 1, color.Black)
+
+	got := scale(src, 1)
+	assert.Equal(t, color.RGBA{R: 127, G: 127, B: 127, A: 255}, got.RGBAAt(0, 0))
+}
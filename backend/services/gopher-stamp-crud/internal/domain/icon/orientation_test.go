@@ -0,0 +1,77 @@
+package icon
+
+import (
+	"encoding/binary"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// exifOrientationSegment builds an APP1 segment holding a big-endian EXIF block with only
+// the Orientation tag.
+func exifOrientationSegment(orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)      // number of entries
+	tiff = binary.BigEndian.AppendUint16(tiff, 0x0112) // Orientation
+	tiff = binary.BigEndian.AppendUint16(tiff, 3)      // SHORT
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)      // count
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0) // value padding and next IFD offset
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+// withExif inserts segment right after the SOI marker of a JPEG.
+func withExif(jpeg, segment []byte) []byte {
+	out := append([]byte{}, jpeg[:2]...)
+	out = append(out, segment...)
+	return append(out, jpeg[2:]...)
+}
+
+func TestExifOrientation(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{name: "orientation tag", data: withExif([]byte{0xFF, 0xD8, 0xFF, 0xD9}, exifOrientationSegment(6)), want: 6},
+		{name: "no exif", data: []byte{0xFF, 0xD8, 0xFF, 0xDA, 0x00, 0x02}, want: 1},
+		{name: "out of range", data: withExif([]byte{0xFF, 0xD8, 0xFF, 0xD9}, exifOrientationSegment(9)), want: 1},
+		{name: "truncated segment", data: withExif([]byte{0xFF, 0xD8}, exifOrientationSegment(6)[:12]), want: 1},
+		{name: "not a jpeg", data: []byte("\x89PNG\r\n\x1a\n"), want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, exifOrientation(tt.data))
+		})
+	}
+}
+
+func TestOrient(t *testing.T) {
+	// Only the top-left pixel is set; each orientation moves it to a different corner
+	src := image.NewRGBA(image.Rect(0, 0, 3, 3))
+	src.Set(0, 0, color.White)
+
+	tests := []struct {
+		orientation int
+		want        image.Point
+	}{
+		{orientation: 1, want: image.Pt(0, 0)},
+		{orientation: 2, want: image.Pt(2, 0)},
+		{orientation: 3, want: image.Pt(2, 2)},
+		{orientation: 4, want: image.Pt(0, 2)},
+		{orientation: 5, want: image.Pt(0, 0)},
+		{orientation: 6, want: image.Pt(2, 0)},
+		{orientation: 7, want: image.Pt(2, 2)},
+		{orientation: 8, want: image.Pt(0, 2)},
+	}
+	for _, tt := range tests {
+		got := orient(src, tt.orientation)
+		assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, got.RGBAAt(tt.want.X, tt.want.Y), "orientation %d", tt.orientation)
+	}
+}
@@ -36,18 +36,18 @@ func (m *MockStampRepository) EXPECT() *MockStampRepositoryMockRecorder {
 }
 
 // Count mocks base method.
-func (m *MockStampRepository) Count(ctx context.Context) (int64, error) {
+func (m *MockStampRepository) Count(ctx context.Context, eventID uint) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Count", ctx)
+	ret := m.ctrl.Call(m, "Count", ctx, eventID)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Count indicates an expected call of Count.
-func (mr *MockStampRepositoryMockRecorder) Count(ctx interface{}) *gomock.Call {
+func (mr *MockStampRepositoryMockRecorder) Count(ctx, eventID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockStampRepository)(nil).Count), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockStampRepository)(nil).Count), ctx, eventID)
 }
 
 // Create mocks base method.
@@ -79,18 +79,18 @@ func (mr *MockStampRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.C
 }
 
 // FindAll mocks base method.
-func (m *MockStampRepository) FindAll(ctx context.Context, limit, offset int) ([]entity.Stamp, error) {
+func (m *MockStampRepository) FindAll(ctx context.Context, eventID uint, limit, offset int) ([]entity.Stamp, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx, limit, offset)
+	ret := m.ctrl.Call(m, "FindAll", ctx, eventID, limit, offset)
 	ret0, _ := ret[0].([]entity.Stamp)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockStampRepositoryMockRecorder) FindAll(ctx, limit, offset interface{}) *gomock.Call {
+func (mr *MockStampRepositoryMockRecorder) FindAll(ctx, eventID, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockStampRepository)(nil).FindAll), ctx, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockStampRepository)(nil).FindAll), ctx, eventID, limit, offset)
 }
 
 // FindByID mocks base method.
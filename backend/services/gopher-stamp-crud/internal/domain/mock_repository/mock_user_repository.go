@@ -6,6 +6,7 @@ package mock_repository
 
 import (
 	entity "2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	repository "2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
 	context "context"
 	reflect "reflect"
 
@@ -35,6 +36,21 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockUserRepository) Count(ctx context.Context, filter repository.UserFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockUserRepositoryMockRecorder) Count(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockUserRepository)(nil).Count), ctx, filter)
+}
+
 // Create mocks base method.
 func (m *MockUserRepository) Create(ctx context.Context, user *entity.User) error {
 	m.ctrl.T.Helper()
@@ -64,18 +80,18 @@ func (mr *MockUserRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Ca
 }
 
 // FindAll mocks base method.
-func (m *MockUserRepository) FindAll(ctx context.Context) ([]*entity.User, error) {
+func (m *MockUserRepository) FindAll(ctx context.Context, filter repository.UserFilter, sort repository.UserSort, desc bool, limit, offset int) ([]*entity.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter, sort, desc, limit, offset)
 	ret0, _ := ret[0].([]*entity.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockUserRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) FindAll(ctx, filter, sort, desc, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockUserRepository)(nil).FindAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockUserRepository)(nil).FindAll), ctx, filter, sort, desc, limit, offset)
 }
 
 // FindByID mocks base method.
@@ -93,6 +109,21 @@ func (mr *MockUserRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockUserRepository)(nil).FindByID), ctx, id)
 }
 
+// FindWithInlineIcons mocks base method.
+func (m *MockUserRepository) FindWithInlineIcons(ctx context.Context, afterID uint, limit int) ([]*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWithInlineIcons", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWithInlineIcons indicates an expected call of FindWithInlineIcons.
+func (mr *MockUserRepositoryMockRecorder) FindWithInlineIcons(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWithInlineIcons", reflect.TypeOf((*MockUserRepository)(nil).FindWithInlineIcons), ctx, afterID, limit)
+}
+
 // Update mocks base method.
 func (m *MockUserRepository) Update(ctx context.Context, user *entity.User) error {
 	m.ctrl.T.Helper()
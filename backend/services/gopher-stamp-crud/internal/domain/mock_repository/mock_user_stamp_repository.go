@@ -35,6 +35,36 @@ func (m *MockUserStampRepository) EXPECT() *MockUserStampRepositoryMockRecorder
 	return m.recorder
 }
 
+// CountByHour mocks base method.
+func (m *MockUserStampRepository) CountByHour(ctx context.Context, eventID uint) ([]entity.HourlyAcquisitionCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByHour", ctx, eventID)
+	ret0, _ := ret[0].([]entity.HourlyAcquisitionCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByHour indicates an expected call of CountByHour.
+func (mr *MockUserStampRepositoryMockRecorder) CountByHour(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByHour", reflect.TypeOf((*MockUserStampRepository)(nil).CountByHour), ctx, eventID)
+}
+
+// CountByStamp mocks base method.
+func (m *MockUserStampRepository) CountByStamp(ctx context.Context, eventID uint) ([]entity.StampAcquisitionCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByStamp", ctx, eventID)
+	ret0, _ := ret[0].([]entity.StampAcquisitionCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByStamp indicates an expected call of CountByStamp.
+func (mr *MockUserStampRepositoryMockRecorder) CountByStamp(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByStamp", reflect.TypeOf((*MockUserStampRepository)(nil).CountByStamp), ctx, eventID)
+}
+
 // Create mocks base method.
 func (m *MockUserStampRepository) Create(ctx context.Context, userStamp *entity.UserStamp) error {
 	m.ctrl.T.Helper()
@@ -49,47 +79,76 @@ func (mr *MockUserStampRepositoryMockRecorder) Create(ctx, userStamp interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserStampRepository)(nil).Create), ctx, userStamp)
 }
 
-// ExistsByUserIDAndStampID mocks base method.
-func (m *MockUserStampRepository) ExistsByUserIDAndStampID(ctx context.Context, userID, stampID uint) (bool, error) {
+// DeleteByUserID mocks base method.
+func (m *MockUserStampRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserID", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserID indicates an expected call of DeleteByUserID.
+func (mr *MockUserStampRepositoryMockRecorder) DeleteByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserID", reflect.TypeOf((*MockUserStampRepository)(nil).DeleteByUserID), ctx, userID)
+}
+
+// FindByUserID mocks base method.
+func (m *MockUserStampRepository) FindByUserID(ctx context.Context, userID uint) ([]entity.UserStamp, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExistsByUserIDAndStampID", ctx, userID, stampID)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
+	ret0, _ := ret[0].([]entity.UserStamp)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ExistsByUserIDAndStampID indicates an expected call of ExistsByUserIDAndStampID.
-func (mr *MockUserStampRepositoryMockRecorder) ExistsByUserIDAndStampID(ctx, userID, stampID interface{}) *gomock.Call {
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockUserStampRepositoryMockRecorder) FindByUserID(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByUserIDAndStampID", reflect.TypeOf((*MockUserStampRepository)(nil).ExistsByUserIDAndStampID), ctx, userID, stampID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockUserStampRepository)(nil).FindByUserID), ctx, userID)
 }
 
-// FindAllUserStampIDs mocks base method.
-func (m *MockUserStampRepository) FindAllUserStampIDs(ctx context.Context) (map[uint][]uint, error) {
+// FindCompletions mocks base method.
+func (m *MockUserStampRepository) FindCompletions(ctx context.Context, eventID uint) ([]entity.Completion, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAllUserStampIDs", ctx)
-	ret0, _ := ret[0].(map[uint][]uint)
+	ret := m.ctrl.Call(m, "FindCompletions", ctx, eventID)
+	ret0, _ := ret[0].([]entity.Completion)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindAllUserStampIDs indicates an expected call of FindAllUserStampIDs.
-func (mr *MockUserStampRepositoryMockRecorder) FindAllUserStampIDs(ctx interface{}) *gomock.Call {
+// FindCompletions indicates an expected call of FindCompletions.
+func (mr *MockUserStampRepositoryMockRecorder) FindCompletions(ctx, eventID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllUserStampIDs", reflect.TypeOf((*MockUserStampRepository)(nil).FindAllUserStampIDs), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCompletions", reflect.TypeOf((*MockUserStampRepository)(nil).FindCompletions), ctx, eventID)
 }
 
-// FindByUserID mocks base method.
-func (m *MockUserStampRepository) FindByUserID(ctx context.Context, userID uint) ([]entity.UserStamp, error) {
+// FindLeaderboard mocks base method.
+func (m *MockUserStampRepository) FindLeaderboard(ctx context.Context, eventID uint, limit, offset int) ([]entity.LeaderboardEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUserID", ctx, userID)
-	ret0, _ := ret[0].([]entity.UserStamp)
+	ret := m.ctrl.Call(m, "FindLeaderboard", ctx, eventID, limit, offset)
+	ret0, _ := ret[0].([]entity.LeaderboardEntry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByUserID indicates an expected call of FindByUserID.
-func (mr *MockUserStampRepositoryMockRecorder) FindByUserID(ctx, userID interface{}) *gomock.Call {
+// FindLeaderboard indicates an expected call of FindLeaderboard.
+func (mr *MockUserStampRepositoryMockRecorder) FindLeaderboard(ctx, eventID, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockUserStampRepository)(nil).FindByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLeaderboard", reflect.TypeOf((*MockUserStampRepository)(nil).FindLeaderboard), ctx, eventID, limit, offset)
+}
+
+// FindStampIDsByUserIDs mocks base method.
+func (m *MockUserStampRepository) FindStampIDsByUserIDs(ctx context.Context, userIDs []uint) (map[uint][]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStampIDsByUserIDs", ctx, userIDs)
+	ret0, _ := ret[0].(map[uint][]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStampIDsByUserIDs indicates an expected call of FindStampIDsByUserIDs.
+func (mr *MockUserStampRepositoryMockRecorder) FindStampIDsByUserIDs(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStampIDsByUserIDs", reflect.TypeOf((*MockUserStampRepository)(nil).FindStampIDsByUserIDs), ctx, userIDs)
 }
@@ -0,0 +1,14 @@
+package repository
+
+import "context"
+
+// BlobStore keeps binary files such as profile icons outside the database and serves them by URL.
+// The local filesystem implementation is used today; an S3-compatible bucket can satisfy the same interface.
+type BlobStore interface {
+	// Put stores data under key, replacing any existing blob, and returns the URL it is served at.
+	Put(ctx context.Context, key, contentType string, data []byte) (string, error)
+	// Delete removes the blob stored under key. Deleting a missing blob is not an error.
+	Delete(ctx context.Context, key string) error
+	// KeyForURL returns the key of a URL returned by Put, or false if the URL is not served by this store.
+	KeyForURL(url string) (string, bool)
+}
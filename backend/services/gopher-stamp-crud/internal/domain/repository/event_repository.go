@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+)
+
+type EventRepository interface {
+	FindAll(ctx context.Context) ([]entity.Event, error)
+	FindByID(ctx context.Context, id uint) (*entity.Event, error)
+	Create(ctx context.Context, event *entity.Event) error
+}
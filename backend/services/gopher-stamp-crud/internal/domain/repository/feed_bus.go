@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+)
+
+// FeedBus fans feed events out to the clients streaming them. The in-process implementation
+// only reaches clients connected to the same server.
+type FeedBus interface {
+	// Publish delivers the event to the current subscribers of its event without blocking.
+	Publish(event entity.FeedEvent)
+	// Subscribe returns the events of the stamp rally eventID published from now on. The channel
+	// is closed once ctx is done, or earlier if the subscriber falls too far behind to keep up.
+	Subscribe(ctx context.Context, eventID uint) <-chan entity.FeedEvent
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+)
+
+type GoFeatureRepository interface {
+	// FindAll returns the catalogue in display order.
+	FindAll(ctx context.Context) ([]entity.GoFeature, error)
+	// CountByEventID returns every feature of the catalogue with the number of the event's
+	// participants who chose it, most chosen first and otherwise in display order.
+	CountByEventID(ctx context.Context, eventID uint) ([]entity.GoFeatureCount, error)
+	// ReplaceUserFeatures sets the features chosen by the user to featureIDs.
+	ReplaceUserFeatures(ctx context.Context, userID uint, featureIDs []uint) error
+	DeleteByUserID(ctx context.Context, userID uint) error
+}
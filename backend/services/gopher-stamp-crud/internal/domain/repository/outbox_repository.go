@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+)
+
+type OutboxRepository interface {
+	Create(ctx context.Context, message *entity.OutboxMessage) error
+	// FindPending returns up to limit messages that are neither delivered nor given up and are not
+	// waiting to be retried after now, oldest first.
+	FindPending(ctx context.Context, now time.Time, limit int) ([]entity.OutboxMessage, error)
+	MarkDelivered(ctx context.Context, id uint, deliveredAt time.Time) error
+	// RecordFailure saves the Attempts, LastError, RetryAt and FailedAt of the message.
+	RecordFailure(ctx context.Context, message *entity.OutboxMessage) error
+	// DeleteByUserID removes the messages about the user, delivered or not.
+	DeleteByUserID(ctx context.Context, userID uint) error
+	// DeleteFinishedBefore removes the messages delivered or given up before the time.
+	DeleteFinishedBefore(ctx context.Context, before time.Time) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+)
+
+// OutboxSink is where the outbox relay publishes messages, such as the feed or the webhooks.
+type OutboxSink interface {
+	// Publish handles the message, ignoring types the sink is not interested in. A message can be
+	// published again after an error or a restart, so sinks must tolerate duplicates.
+	// An error leaves the message pending to be retried.
+	Publish(ctx context.Context, message entity.OutboxMessage) error
+}
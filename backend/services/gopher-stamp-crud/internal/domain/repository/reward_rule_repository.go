@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+)
+
+type RewardRuleRepository interface {
+	// FindByEventID returns the rules of the event with their Stamps loaded.
+	FindByEventID(ctx context.Context, eventID uint) ([]entity.RewardRule, error)
+	FindByID(ctx context.Context, id uint) (*entity.RewardRule, error)
+	// Create stores the rule and links it to the existing stamps in rule.Stamps.
+	Create(ctx context.Context, rule *entity.RewardRule) error
+}
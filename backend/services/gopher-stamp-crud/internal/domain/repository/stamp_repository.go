@@ -7,10 +7,10 @@ import (
 )
 
 type StampRepository interface {
-	FindAll(ctx context.Context, limit, offset int) ([]entity.Stamp, error)
+	FindAll(ctx context.Context, eventID uint, limit, offset int) ([]entity.Stamp, error)
 	FindByID(ctx context.Context, id uint) (*entity.Stamp, error)
 	Create(ctx context.Context, stamp *entity.Stamp) error
 	Update(ctx context.Context, stamp *entity.Stamp) error
 	Delete(ctx context.Context, id uint) error
-	Count(ctx context.Context) (int64, error)
+	Count(ctx context.Context, eventID uint) (int64, error)
 }
@@ -0,0 +1,10 @@
+package repository
+
+import "context"
+
+// TxManager runs a unit of work in a single transaction.
+// Repository calls made with the context passed to fn take part in the transaction;
+// it is committed if fn returns nil and rolled back otherwise.
+type TxManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -6,10 +6,30 @@ import (
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
 )
 
+// UserSort is the key FindAll orders participants by. Ties are broken by user ID.
+type UserSort string
+
+const (
+	UserSortCreatedAt  UserSort = "created_at"
+	UserSortName       UserSort = "name"
+	UserSortStampCount UserSort = "stamp_count"
+)
+
+// UserFilter selects the participants of an event. A non-empty Search keeps only those
+// whose name or Twitter ID contains it.
+type UserFilter struct {
+	EventID uint
+	Search  string
+}
+
 type UserRepository interface {
 	Create(ctx context.Context, user *entity.User) error
 	FindByID(ctx context.Context, id uint) (*entity.User, error)
-	FindAll(ctx context.Context) ([]*entity.User, error)
+	FindAll(ctx context.Context, filter UserFilter, sort UserSort, desc bool, limit, offset int) ([]*entity.User, error)
+	Count(ctx context.Context, filter UserFilter) (int64, error)
+	// FindWithInlineIcons returns up to limit users with ID greater than afterID, in ID order,
+	// whose icon is still stored inline as base64 rather than as a URL.
+	FindWithInlineIcons(ctx context.Context, afterID uint, limit int) ([]*entity.User, error)
 	Update(ctx context.Context, user *entity.User) error
 	Delete(ctx context.Context, id uint) error
 }
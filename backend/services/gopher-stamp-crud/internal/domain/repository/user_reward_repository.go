@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+)
+
+type UserRewardRepository interface {
+	// FindByUserID returns the rewards the user has earned with their RewardRule loaded.
+	FindByUserID(ctx context.Context, userID uint) ([]entity.UserReward, error)
+	Create(ctx context.Context, userReward *entity.UserReward) error
+	// MarkRedeemed sets redeemed_at unless the reward has already been redeemed,
+	// and reports whether it did so.
+	MarkRedeemed(ctx context.Context, userID, ruleID uint, at time.Time) (bool, error)
+	DeleteByUserID(ctx context.Context, userID uint) error
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+)
+
+type UserSessionRepository interface {
+	Create(ctx context.Context, session *entity.UserSession) error
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entity.UserSession, error)
+	DeleteByUserID(ctx context.Context, userID uint) error
+}
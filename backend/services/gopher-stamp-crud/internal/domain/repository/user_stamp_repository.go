@@ -9,6 +9,17 @@ import (
 type UserStampRepository interface {
 	FindByUserID(ctx context.Context, userID uint) ([]entity.UserStamp, error)
 	Create(ctx context.Context, userStamp *entity.UserStamp) error
-	ExistsByUserIDAndStampID(ctx context.Context, userID, stampID uint) (bool, error)
-	FindAllUserStampIDs(ctx context.Context) (map[uint][]uint, error)
+	// FindStampIDsByUserIDs returns the IDs of the stamps each of the users has acquired.
+	FindStampIDsByUserIDs(ctx context.Context, userIDs []uint) (map[uint][]uint, error)
+	// FindLeaderboard ranks the participants of the event by stamp count, breaking ties by who
+	// acquired their last stamp first. Participants without stamps are ranked last.
+	FindLeaderboard(ctx context.Context, eventID uint, limit, offset int) ([]entity.LeaderboardEntry, error)
+	// CountByStamp returns how many participants acquired each of the event's stamps, in stamp ID order.
+	CountByStamp(ctx context.Context, eventID uint) ([]entity.StampAcquisitionCount, error)
+	// CountByHour returns how many of the event's stamps were acquired in each hour, in time order.
+	// Hours without acquisitions are left out.
+	CountByHour(ctx context.Context, eventID uint) ([]entity.HourlyAcquisitionCount, error)
+	// FindCompletions returns the participants of the event who acquired all of its stamps.
+	FindCompletions(ctx context.Context, eventID uint) ([]entity.Completion, error)
+	DeleteByUserID(ctx context.Context, userID uint) error
 }
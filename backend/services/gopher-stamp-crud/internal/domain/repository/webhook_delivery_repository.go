@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+)
+
+type WebhookDeliveryRepository interface {
+	Create(ctx context.Context, delivery *entity.WebhookDelivery) error
+	// FindByWebhookID returns the latest limit delivery attempts of the webhook, newest first.
+	FindByWebhookID(ctx context.Context, webhookID uint, limit int) ([]entity.WebhookDelivery, error)
+	// DeleteByUserID removes the delivery attempts of events about the user.
+	DeleteByUserID(ctx context.Context, userID uint) error
+	// DeleteBefore removes the delivery attempts made before the time.
+	DeleteBefore(ctx context.Context, before time.Time) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+)
+
+// WebhookDispatcher delivers stamp rally events to the webhooks subscribed to them.
+type WebhookDispatcher interface {
+	// Dispatch stores the event for delivery and returns without waiting for the receivers.
+	// Called in a transaction, the event is delivered only if the transaction commits.
+	// The dispatcher assigns the event's ID unless it is already set.
+	Dispatch(ctx context.Context, event entity.WebhookEvent) error
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+)
+
+// WebhookJobRepository stores the deliveries waiting to be made to webhooks.
+type WebhookJobRepository interface {
+	// Create saves the jobs, skipping those whose webhook already has a job for the same message.
+	Create(ctx context.Context, jobs []entity.WebhookJob) error
+	// ClaimDue returns up to limit jobs due at now with their Webhook, earliest first, and postpones them to leaseUntil
+	// so that no other dispatcher takes them meanwhile. A job left behind by a stopped dispatcher is due again at leaseUntil.
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]entity.WebhookJob, error)
+	// Reschedule records the attempts made so far and when to try again.
+	Reschedule(ctx context.Context, id uint, attempts int, nextAttemptAt time.Time) error
+	Delete(ctx context.Context, id uint) error
+	// DeleteByUserID removes the pending deliveries of events about the user.
+	DeleteByUserID(ctx context.Context, userID uint) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+)
+
+type WebhookRepository interface {
+	FindByEventID(ctx context.Context, eventID uint) ([]entity.Webhook, error)
+	FindByID(ctx context.Context, id uint) (*entity.Webhook, error)
+	Create(ctx context.Context, webhook *entity.Webhook) error
+	// Delete removes the webhook, its delivery log and its pending deliveries.
+	Delete(ctx context.Context, id uint) error
+}
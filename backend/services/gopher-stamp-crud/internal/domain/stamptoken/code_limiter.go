@@ -0,0 +1,84 @@
+package stamptoken
+
+import (
+	"sync"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+)
+
+// A participant may get a rotating code wrong a few times, e.g. by mistyping it, but must not be able to try
+// enough codes to guess one: each code is one of a million, and two are accepted at any time.
+const (
+	maxCodeFailures   = 5
+	codeFailureWindow = 5 * time.Minute
+)
+
+// ErrTooManyCodeFailures is returned when a participant has entered too many invalid codes for a stamp.
+var ErrTooManyCodeFailures = apperr.New(apperr.CodeTooManyRequests, "too many invalid stamp codes")
+
+// CodeLimiter counts invalid rotating codes per participant and stamp, and rejects further attempts
+// once maxCodeFailures have been made within codeFailureWindow. The counts are kept in memory,
+// so each server instance limits the requests it handles.
+type CodeLimiter struct {
+	mu       sync.Mutex
+	failures map[codeAttemptKey]*codeFailures
+	now      func() time.Time
+}
+
+type codeAttemptKey struct {
+	userID, stampID uint
+}
+
+// codeFailures are the failures counted in the window that started at since.
+type codeFailures struct {
+	count int
+	since time.Time
+}
+
+// NewCodeLimiter creates a CodeLimiter.
+func NewCodeLimiter() *CodeLimiter {
+	return &CodeLimiter{
+		failures: make(map[codeAttemptKey]*codeFailures),
+		now:      time.Now,
+	}
+}
+
+// Allow returns ErrTooManyCodeFailures, with the time attempts are accepted again as its details,
+// if the participant may not try another code for the stamp.
+func (l *CodeLimiter) Allow(userID, stampID uint) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, ok := l.failures[codeAttemptKey{userID, stampID}]
+	if !ok || f.count < maxCodeFailures || l.expired(f) {
+		return nil
+	}
+	retryAt := f.since.Add(codeFailureWindow)
+	return ErrTooManyCodeFailures.WithDetails("try again after " + retryAt.Format(time.RFC3339))
+}
+
+// Fail counts an invalid code the participant entered for the stamp.
+func (l *CodeLimiter) Fail(userID, stampID uint) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Forget the windows that have passed, so that the map only holds recent failures
+	for key, f := range l.failures {
+		if l.expired(f) {
+			delete(l.failures, key)
+		}
+	}
+
+	key := codeAttemptKey{userID, stampID}
+	f, ok := l.failures[key]
+	if !ok {
+		f = &codeFailures{since: l.now()}
+		l.failures[key] = f
+	}
+	f.count++
+}
+
+func (l *CodeLimiter) expired(f *codeFailures) bool {
+	return !l.now().Before(f.since.Add(codeFailureWindow))
+}
@@ -0,0 +1,39 @@
+package stamptoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodeLimiter(t *testing.T) {
+	start := time.Date(2025, 11, 22, 10, 0, 0, 0, time.UTC)
+	now := start
+	limiter := NewCodeLimiter()
+	limiter.now = func() time.Time { return now }
+
+	for range maxCodeFailures {
+		assert.NoError(t, limiter.Allow(1, 1))
+		limiter.Fail(1, 1)
+		now = now.Add(time.Second)
+	}
+
+	// The participant is locked out of this stamp only
+	err := limiter.Allow(1, 1)
+	assert.ErrorIs(t, err, ErrTooManyCodeFailures)
+	assert.ErrorContains(t, err, "too many invalid stamp codes")
+	assert.NoError(t, limiter.Allow(1, 2))
+	assert.NoError(t, limiter.Allow(2, 1))
+
+	// Until the window that started with the first failure has passed
+	now = start.Add(codeFailureWindow - time.Second)
+	assert.ErrorIs(t, limiter.Allow(1, 1), ErrTooManyCodeFailures)
+	now = start.Add(codeFailureWindow)
+	assert.NoError(t, limiter.Allow(1, 1))
+
+	// A failure after that starts a new window, and the old one is forgotten
+	limiter.Fail(1, 1)
+	assert.NoError(t, limiter.Allow(1, 1))
+	assert.Equal(t, 1, limiter.failures[codeAttemptKey{1, 1}].count)
+}
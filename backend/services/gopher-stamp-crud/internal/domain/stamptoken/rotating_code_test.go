@@ -0,0 +1,67 @@
+package stamptoken
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotator_Current(t *testing.T) {
+	// RFC 6238 Appendix B test vector (SHA1, T=59s), truncated to 6 digits
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	rotator := NewRotator(30 * time.Second)
+	rotator.now = func() time.Time { return time.Unix(59, 0) }
+
+	got, rotatesAt, err := rotator.Current(secret)
+	require.NoError(t, err)
+	assert.Equal(t, "287082", got)
+	assert.Equal(t, time.Unix(60, 0), rotatesAt)
+
+	_, _, err = rotator.Current("")
+	assert.Error(t, err)
+}
+
+func TestRotator_Verify(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+
+	issuedAt := time.Date(2025, 11, 22, 10, 0, 0, 0, time.UTC)
+	rotator := NewRotator(30 * time.Second)
+	rotator.now = func() time.Time { return issuedAt }
+	current, _, err := rotator.Current(secret)
+	require.NoError(t, err)
+
+	otherSecret, err := GenerateSecret()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		secret  string
+		code    string
+		at      time.Time
+		wantErr bool
+	}{
+		{name: "current window", secret: secret, code: current, at: issuedAt},
+		{name: "previous window", secret: secret, code: current, at: issuedAt.Add(30 * time.Second)},
+		{name: "two windows old", secret: secret, code: current, at: issuedAt.Add(60 * time.Second), wantErr: true},
+		{name: "another stamp's secret", secret: otherSecret, code: current, at: issuedAt, wantErr: true},
+		{name: "empty code", secret: secret, code: "", at: issuedAt, wantErr: true},
+		{name: "stamp without secret", secret: "", code: current, at: issuedAt, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at := tt.at
+			rotator.now = func() time.Time { return at }
+			err := rotator.Verify(tt.secret, tt.code)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidCode)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Package stamptoken issues and verifies the proofs a participant presents when acquiring a stamp:
+// signed tokens embedded in printed QR codes, and rotating codes shown on booth screens.
+//
+// A token has the form "<expires_unix>.<signature>", where the signature is the
+// base64url-encoded HMAC-SHA256 of the stamp ID and expiry. The stamp ID itself is
+// not part of the token: it is taken from the request and bound via the signature,
+// so a token issued for one stamp cannot be replayed against another.
+package stamptoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+)
+
+var (
+	// ErrInvalidToken is returned when a token is malformed or its signature does not match.
+	ErrInvalidToken = apperr.New(apperr.CodeInvalidStampToken, "invalid stamp token")
+	// ErrExpiredToken is returned when a token is well-formed but past its expiry.
+	ErrExpiredToken = apperr.New(apperr.CodeInvalidStampToken, "stamp token expired")
+)
+
+// Signer signs and verifies stamp tokens with a shared secret.
+type Signer struct {
+	secret []byte
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewSigner creates a Signer. ttl is the lifetime of tokens returned by Issue.
+func NewSigner(secret []byte, ttl time.Duration) *Signer {
+	return &Signer{
+		secret: secret,
+		ttl:    ttl,
+		now:    time.Now,
+	}
+}
+
+// Issue returns a token for stampID together with its expiry time.
+func (s *Signer) Issue(stampID uint) (string, time.Time) {
+	expiresAt := s.now().Add(s.ttl).Truncate(time.Second)
+	exp := strconv.FormatInt(expiresAt.Unix(), 10)
+	return exp + "." + s.sign(stampID, exp), expiresAt
+}
+
+// Verify checks that token was issued for stampID and has not expired.
+func (s *Signer) Verify(stampID uint, token string) error {
+	exp, sig, ok := strings.Cut(token, ".")
+	if !ok || exp == "" || sig == "" {
+		return ErrInvalidToken
+	}
+
+	expUnix, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	if !hmac.Equal([]byte(sig), []byte(s.sign(stampID, exp))) {
+		return ErrInvalidToken
+	}
+
+	if !s.now().Before(time.Unix(expUnix, 0)) {
+		return ErrExpiredToken
+	}
+
+	return nil
+}
+
+func (s *Signer) sign(stampID uint, exp string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(strconv.FormatUint(uint64(stampID), 10) + ":" + exp))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
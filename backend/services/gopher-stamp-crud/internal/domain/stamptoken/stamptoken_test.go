@@ -0,0 +1,87 @@
+package stamptoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigner_Verify(t *testing.T) {
+	now := time.Date(2025, 11, 22, 10, 0, 0, 0, time.UTC)
+	signer := NewSigner([]byte("test-secret"), time.Hour)
+	signer.now = func() time.Time { return now }
+
+	token, expiresAt := signer.Issue(1)
+	assert.Equal(t, now.Add(time.Hour), expiresAt)
+
+	tests := []struct {
+		name    string
+		signer  *Signer
+		stampID uint
+		token   string
+		at      time.Time
+		wantErr error
+	}{
+		{
+			name:    "valid token",
+			signer:  signer,
+			stampID: 1,
+			token:   token,
+			at:      now,
+		},
+		{
+			name:    "token for another stamp",
+			signer:  signer,
+			stampID: 2,
+			token:   token,
+			at:      now,
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "signed with another secret",
+			signer:  NewSigner([]byte("other-secret"), time.Hour),
+			stampID: 1,
+			token:   token,
+			at:      now,
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "expired",
+			signer:  signer,
+			stampID: 1,
+			token:   token,
+			at:      now.Add(time.Hour),
+			wantErr: ErrExpiredToken,
+		},
+		{
+			name:    "empty token",
+			signer:  signer,
+			stampID: 1,
+			token:   "",
+			at:      now,
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "malformed expiry",
+			signer:  signer,
+			stampID: 1,
+			token:   "abc.def",
+			at:      now,
+			wantErr: ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at := tt.at
+			tt.signer.now = func() time.Time { return at }
+			err := tt.signer.Verify(tt.stampID, tt.token)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package feed
+
+import (
+	"context"
+	"sync"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+)
+
+// DefaultBufferSize is how many events a subscriber may fall behind before it is dropped.
+const DefaultBufferSize = 64
+
+// MemoryBus is an in-process repository.FeedBus. Publishing never waits for subscribers: one that
+// lets its buffer fill up is dropped, and its channel is closed so the client can reconnect and
+// catch up from the API instead of slowing down stamp acquisition for everyone.
+type MemoryBus struct {
+	bufferSize int
+
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+	closed      bool
+}
+
+type subscriber struct {
+	eventID uint
+	ch      chan entity.FeedEvent
+}
+
+func NewMemoryBus(bufferSize int) *MemoryBus {
+	return &MemoryBus{
+		bufferSize:  bufferSize,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+func (b *MemoryBus) Publish(event entity.FeedEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subscribers {
+		if sub.eventID != event.EventID {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			b.remove(sub)
+		}
+	}
+}
+
+func (b *MemoryBus) Subscribe(ctx context.Context, eventID uint) <-chan entity.FeedEvent {
+	sub := &subscriber{
+		eventID: eventID,
+		ch:      make(chan entity.FeedEvent, b.bufferSize),
+	}
+
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		close(sub.ch)
+		return sub.ch
+	}
+	b.subscribers[sub] = struct{}{}
+	b.mu.Unlock()
+
+	context.AfterFunc(ctx, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.remove(sub)
+	})
+	return sub.ch
+}
+
+// Close drops every subscriber, as if they had fallen behind, so that clients reconnect to another
+// server, and makes later subscriptions end right away. The server calls it when shutting down
+// because streams never finish on their own.
+func (b *MemoryBus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for sub := range b.subscribers {
+		b.remove(sub)
+	}
+}
+
+// Subscribers returns the number of connected subscribers.
+func (b *MemoryBus) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subscribers)
+}
+
+// remove closes the subscriber's channel unless it was already dropped. b.mu must be held.
+func (b *MemoryBus) remove(sub *subscriber) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.ch)
+}
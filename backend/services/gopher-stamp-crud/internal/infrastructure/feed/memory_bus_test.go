@@ -0,0 +1,93 @@
+package feed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryBus_FanOut(t *testing.T) {
+	bus := NewMemoryBus(DefaultBufferSize)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first := bus.Subscribe(ctx, 1)
+	second := bus.Subscribe(ctx, 1)
+	otherEvent := bus.Subscribe(ctx, 2)
+
+	event := entity.FeedEvent{Type: entity.FeedUserRegistered, EventID: 1, UserID: 7}
+	bus.Publish(event)
+
+	assert.Equal(t, event, <-first)
+	assert.Equal(t, event, <-second)
+	assert.Empty(t, otherEvent, "events are only delivered to subscribers of their stamp rally")
+}
+
+func TestMemoryBus_Unsubscribe(t *testing.T) {
+	bus := NewMemoryBus(DefaultBufferSize)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ch := bus.Subscribe(ctx, 1)
+	require.Equal(t, 1, bus.Subscribers())
+
+	cancel()
+	_, ok := <-ch
+	assert.False(t, ok, "the channel is closed once the subscriber's context is done")
+	assert.Equal(t, 0, bus.Subscribers())
+
+	// Publishing after the subscriber left must not panic on the closed channel
+	bus.Publish(entity.FeedEvent{EventID: 1})
+}
+
+func TestMemoryBus_DropsSlowSubscribers(t *testing.T) {
+	bus := NewMemoryBus(2)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	slow := bus.Subscribe(ctx, 1)
+	fast := bus.Subscribe(ctx, 1)
+
+	for i := range 3 {
+		bus.Publish(entity.FeedEvent{EventID: 1, UserID: uint(i)})
+		if i < 2 {
+			<-fast
+		}
+	}
+
+	// The slow subscriber keeps the events it had room for, then its channel is closed
+	assert.Equal(t, uint(0), (<-slow).UserID)
+	assert.Equal(t, uint(1), (<-slow).UserID)
+	_, ok := <-slow
+	assert.False(t, ok)
+
+	assert.Equal(t, uint(2), (<-fast).UserID)
+	assert.Equal(t, 1, bus.Subscribers())
+
+	// Cancelling a dropped subscriber is harmless
+	cancel()
+	assert.Eventually(t, func() bool { return bus.Subscribers() == 0 }, time.Second, time.Millisecond)
+}
+
+func TestMemoryBus_Close(t *testing.T) {
+	bus := NewMemoryBus(DefaultBufferSize)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch := bus.Subscribe(ctx, 1)
+	bus.Close()
+
+	_, ok := <-ch
+	assert.False(t, ok, "subscribers are dropped on close")
+	assert.Equal(t, 0, bus.Subscribers())
+
+	// Subscribing after close ends right away, and publishing reaches no one
+	_, ok = <-bus.Subscribe(ctx, 1)
+	assert.False(t, ok)
+	bus.Publish(entity.FeedEvent{EventID: 1})
+	assert.Equal(t, 0, bus.Subscribers())
+}
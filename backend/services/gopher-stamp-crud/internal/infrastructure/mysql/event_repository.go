@@ -0,0 +1,37 @@
+package mysql
+
+import (
+	"context"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+type eventRepository struct {
+	db *gorm.DB
+}
+
+func NewEventRepository(db *gorm.DB) repository.EventRepository {
+	return &eventRepository{db: db}
+}
+
+func (r *eventRepository) FindAll(ctx context.Context) ([]entity.Event, error) {
+	var events []entity.Event
+	err := conn(ctx, r.db).Order("id").Find(&events).Error
+	return events, err
+}
+
+func (r *eventRepository) FindByID(ctx context.Context, id uint) (*entity.Event, error) {
+	var event entity.Event
+	err := conn(ctx, r.db).First(&event, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (r *eventRepository) Create(ctx context.Context, event *entity.Event) error {
+	return conn(ctx, r.db).Create(event).Error
+}
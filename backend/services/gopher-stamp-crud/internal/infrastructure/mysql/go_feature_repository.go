@@ -0,0 +1,58 @@
+package mysql
+
+import (
+	"context"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+type goFeatureRepository struct {
+	db *gorm.DB
+}
+
+func NewGoFeatureRepository(db *gorm.DB) repository.GoFeatureRepository {
+	return &goFeatureRepository{db: db}
+}
+
+func (r *goFeatureRepository) FindAll(ctx context.Context) ([]entity.GoFeature, error) {
+	var features []entity.GoFeature
+	err := conn(ctx, r.db).Order("sort_order ASC, id ASC").Find(&features).Error
+	return features, err
+}
+
+func (r *goFeatureRepository) CountByEventID(ctx context.Context, eventID uint) ([]entity.GoFeatureCount, error) {
+	var counts []entity.GoFeatureCount
+	err := conn(ctx, r.db).
+		Table("go_features").
+		Select("go_features.*, COUNT(users.id) AS user_count").
+		Joins("LEFT JOIN user_go_features ON user_go_features.go_feature_id = go_features.id").
+		// Joining users with the event condition keeps features nobody in the event chose, with a count of 0
+		Joins("LEFT JOIN users ON users.id = user_go_features.user_id AND users.event_id = ?", eventID).
+		Group("go_features.id").
+		Order("user_count DESC, go_features.sort_order ASC, go_features.id ASC").
+		Scan(&counts).Error
+	return counts, err
+}
+
+func (r *goFeatureRepository) ReplaceUserFeatures(ctx context.Context, userID uint, featureIDs []uint) error {
+	db := conn(ctx, r.db)
+	if err := db.Where("user_id = ?", userID).Delete(&entity.UserGoFeature{}).Error; err != nil {
+		return err
+	}
+	if len(featureIDs) == 0 {
+		return nil
+	}
+
+	links := make([]entity.UserGoFeature, len(featureIDs))
+	for i, id := range featureIDs {
+		links[i] = entity.UserGoFeature{UserID: userID, GoFeatureID: id}
+	}
+	return db.Create(&links).Error
+}
+
+func (r *goFeatureRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	return conn(ctx, r.db).Where("user_id = ?", userID).Delete(&entity.UserGoFeature{}).Error
+}
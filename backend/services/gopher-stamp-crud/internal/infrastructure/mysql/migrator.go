@@ -0,0 +1,319 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Migration is one versioned schema change loaded from a pair of up/down SQL files.
+type Migration struct {
+	Version uint
+	Name    string
+	Up      string
+	Down    string
+}
+
+const (
+	// lockName is the MySQL user lock held while migrating, so that migrate commands run at the
+	// same time, such as by two containers starting together, apply each migration only once.
+	lockName = "schema_migrations"
+	// lockTimeout is how many seconds to wait for another migrate command to finish.
+	lockTimeout = 60
+)
+
+// Migrator applies versioned SQL migrations and records them in the schema_migrations table.
+//
+// A migration is recorded as dirty before its script runs and marked clean once it has finished,
+// so one that failed part way is not mistaken for one never run or fully applied. MySQL commits
+// DDL implicitly, so a dirty version has to be repaired by hand; Up and Down refuse to run until
+// it is.
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator loads the migrations in the root of files.
+// See package migrations for the file naming convention.
+func NewMigrator(db *gorm.DB, files fs.FS) (*Migrator, error) {
+	migrations, err := loadMigrations(files)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+// Up applies all pending migrations in ascending version order and returns the ones it applied.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkClean(applied); err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, mig := range m.migrations {
+		if _, ok := applied[mig.Version]; ok {
+			continue
+		}
+		if err := m.db.WithContext(ctx).Exec(
+			"INSERT INTO schema_migrations (version, name, dirty) VALUES (?, ?, TRUE)", mig.Version, mig.Name,
+		).Error; err != nil {
+			return done, fmt.Errorf("failed to record migration %03d: %w", mig.Version, err)
+		}
+		if err := m.exec(ctx, mig.Up); err != nil {
+			return done, fmt.Errorf("migration %03d_%s up: %w (the version is left dirty)", mig.Version, mig.Name, err)
+		}
+		if err := m.markClean(ctx, mig.Version); err != nil {
+			return done, err
+		}
+		done = append(done, mig)
+	}
+	return done, nil
+}
+
+// Down reverts the most recently applied steps migrations and returns the ones it reverted.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkClean(applied); err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+		mig := m.migrations[i]
+		if _, ok := applied[mig.Version]; !ok {
+			continue
+		}
+		if err := m.db.WithContext(ctx).Exec(
+			"UPDATE schema_migrations SET dirty = TRUE WHERE version = ?", mig.Version,
+		).Error; err != nil {
+			return done, fmt.Errorf("failed to mark migration %03d dirty: %w", mig.Version, err)
+		}
+		if err := m.exec(ctx, mig.Down); err != nil {
+			return done, fmt.Errorf("migration %03d_%s down: %w (the version is left dirty)", mig.Version, mig.Name, err)
+		}
+		if err := m.db.WithContext(ctx).Exec(
+			"DELETE FROM schema_migrations WHERE version = ?", mig.Version,
+		).Error; err != nil {
+			return done, fmt.Errorf("failed to unrecord migration %03d: %w", mig.Version, err)
+		}
+		done = append(done, mig)
+	}
+	return done, nil
+}
+
+// Version returns the highest applied migration version, or 0 if none has been applied, and
+// whether any applied version is dirty.
+func (m *Migrator) Version(ctx context.Context) (version uint, dirty bool, err error) {
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	for v, d := range applied {
+		version = max(version, v)
+		dirty = dirty || d
+	}
+	return version, dirty, nil
+}
+
+// lock waits for the migration lock and returns a function that releases it. The lock belongs
+// to a connection of its own, held until the release, since MySQL frees it when the connection ends.
+func (m *Migrator) lock(ctx context.Context) (unlock func(), err error) {
+	sqlDB, err := m.db.DB()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect for the migration lock: %w", err)
+	}
+
+	var locked sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", lockName, lockTimeout).Scan(&locked); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to take the migration lock: %w", err)
+	}
+	if locked.Int64 != 1 {
+		conn.Close()
+		return nil, fmt.Errorf("another migration is still running after %ds", lockTimeout)
+	}
+
+	return func() {
+		// Closing the connection releases the lock too, so a failure here needs no handling
+		var released sql.NullInt64
+		_ = conn.QueryRowContext(context.WithoutCancel(ctx), "SELECT RELEASE_LOCK(?)", lockName).Scan(&released)
+		conn.Close()
+	}, nil
+}
+
+// appliedVersions returns the recorded versions, each mapped to whether it is dirty.
+func (m *Migrator) appliedVersions(ctx context.Context) (map[uint]bool, error) {
+	db := m.db.WithContext(ctx)
+	if err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+    version BIGINT UNSIGNED NOT NULL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    dirty BOOLEAN NOT NULL DEFAULT FALSE,
+    applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci`).Error; err != nil {
+		return nil, fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+
+	var rows []struct {
+		Version uint
+		Dirty   bool
+	}
+	if err := db.Raw("SELECT version, dirty FROM schema_migrations").Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+
+	applied := make(map[uint]bool, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row.Dirty
+	}
+	return applied, nil
+}
+
+func (m *Migrator) markClean(ctx context.Context, version uint) error {
+	if err := m.db.WithContext(ctx).Exec(
+		"UPDATE schema_migrations SET dirty = FALSE WHERE version = ?", version,
+	).Error; err != nil {
+		return fmt.Errorf("failed to mark migration %03d clean: %w", version, err)
+	}
+	return nil
+}
+
+// checkClean returns an error naming the lowest dirty version, if any.
+func checkClean(applied map[uint]bool) error {
+	var dirty []uint
+	for v, d := range applied {
+		if d {
+			dirty = append(dirty, v)
+		}
+	}
+	if len(dirty) == 0 {
+		return nil
+	}
+	slices.Sort(dirty)
+	return fmt.Errorf("migration %03d is dirty: it failed part way, so repair the schema by hand, then delete its "+
+		"schema_migrations row to run it again or set dirty = FALSE if it is complete", dirty[0])
+}
+
+// exec runs each statement of script in turn. MySQL commits DDL implicitly,
+// so a migration is not atomic; keep each one small and idempotent where possible.
+func (m *Migrator) exec(ctx context.Context, script string) error {
+	for _, stmt := range splitStatements(script) {
+		if err := m.db.WithContext(ctx).Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func loadMigrations(files fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[uint]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		base := strings.TrimSuffix(entry.Name(), ".sql")
+		direction := path.Ext(base) // ".up" or ".down"
+		base = strings.TrimSuffix(base, direction)
+		rawVersion, name, ok := strings.Cut(base, "_")
+		version, err := strconv.ParseUint(rawVersion, 10, 32)
+		if !ok || err != nil || version == 0 || (direction != ".up" && direction != ".down") {
+			return nil, fmt.Errorf("invalid migration file name %q: want <version>_<name>.up.sql or .down.sql", entry.Name())
+		}
+
+		content, err := fs.ReadFile(files, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		mig, ok := byVersion[uint(version)]
+		if !ok {
+			mig = &Migration{Version: uint(version), Name: name}
+			byVersion[uint(version)] = mig
+		}
+		if mig.Name != name {
+			return nil, fmt.Errorf("migration %03d has conflicting names %q and %q", version, mig.Name, name)
+		}
+		if direction == ".up" {
+			mig.Up = string(content)
+		} else {
+			mig.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" || mig.Down == "" {
+			return nil, fmt.Errorf("migration %03d_%s must have both up and down files", mig.Version, mig.Name)
+		}
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// splitStatements splits a SQL script into statements terminated by a semicolon at the end of a line.
+// Lines starting with "--" are dropped so comments may contain semicolons.
+func splitStatements(script string) []string {
+	var (
+		stmts []string
+		buf   strings.Builder
+	)
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		buf.WriteString(line)
+		buf.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSuffix(strings.TrimSpace(buf.String()), ";"))
+			buf.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(buf.String()); rest != "" {
+		stmts = append(stmts, rest)
+	}
+	return stmts
+}
@@ -0,0 +1,218 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/migrations"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    fstest.MapFS
+		want     []Migration
+		wantErr  bool
+		errMatch string
+	}{
+		{
+			name: "sorted by version",
+			files: fstest.MapFS{
+				"010_add_index.up.sql":       {Data: []byte("up10")},
+				"010_add_index.down.sql":     {Data: []byte("down10")},
+				"002_add_column.up.sql":      {Data: []byte("up2")},
+				"002_add_column.down.sql":    {Data: []byte("down2")},
+				"001_create_tables.up.sql":   {Data: []byte("up1")},
+				"001_create_tables.down.sql": {Data: []byte("down1")},
+				"migrations.go":              {Data: []byte("package migrations")},
+			},
+			want: []Migration{
+				{Version: 1, Name: "create_tables", Up: "up1", Down: "down1"},
+				{Version: 2, Name: "add_column", Up: "up2", Down: "down2"},
+				{Version: 10, Name: "add_index", Up: "up10", Down: "down10"},
+			},
+		},
+		{
+			name: "missing down",
+			files: fstest.MapFS{
+				"001_create_tables.up.sql": {Data: []byte("up1")},
+			},
+			wantErr:  true,
+			errMatch: "must have both up and down files",
+		},
+		{
+			name: "invalid name",
+			files: fstest.MapFS{
+				"create_tables.up.sql": {Data: []byte("up1")},
+			},
+			wantErr:  true,
+			errMatch: "invalid migration file name",
+		},
+		{
+			name: "invalid direction",
+			files: fstest.MapFS{
+				"001_create_tables.sql": {Data: []byte("up1")},
+			},
+			wantErr:  true,
+			errMatch: "invalid migration file name",
+		},
+		{
+			name: "conflicting names",
+			files: fstest.MapFS{
+				"001_create_tables.up.sql":  {Data: []byte("up1")},
+				"001_create_users.down.sql": {Data: []byte("down1")},
+			},
+			wantErr:  true,
+			errMatch: "conflicting names",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := loadMigrations(tt.files)
+			if tt.wantErr {
+				assert.ErrorContains(t, err, tt.errMatch)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLoadMigrations_Embedded(t *testing.T) {
+	got, err := loadMigrations(migrations.FS)
+	require.NoError(t, err)
+	require.NotEmpty(t, got)
+
+	for i, m := range got {
+		assert.Equal(t, uint(i+1), m.Version, "migration versions must be contiguous")
+		assert.NotEmpty(t, splitStatements(m.Up))
+		assert.NotEmpty(t, splitStatements(m.Down))
+	}
+}
+
+// The tables as the gorm AutoMigrate at server startup created them before migrations existed.
+type (
+	autoMigratedUser struct {
+		ID                uint    `gorm:"primaryKey"`
+		Name              string  `gorm:"size:100;not null"`
+		TwitterID         *string `gorm:"size:50"`
+		FavoriteGoFeature *string `gorm:"size:500"`
+		Icon              *string `gorm:"type:longtext"`
+		CreatedAt         time.Time
+		UpdatedAt         time.Time
+	}
+	autoMigratedStamp struct {
+		ID        uint   `gorm:"primaryKey"`
+		Name      string `gorm:"size:100;not null"`
+		CreatedAt time.Time
+		UpdatedAt time.Time
+	}
+	autoMigratedUserStamp struct {
+		UserID     uint              `gorm:"primaryKey"`
+		StampID    uint              `gorm:"primaryKey"`
+		AcquiredAt time.Time         `gorm:"autoCreateTime"`
+		User       autoMigratedUser  `gorm:"foreignKey:UserID"`
+		Stamp      autoMigratedStamp `gorm:"foreignKey:StampID"`
+	}
+)
+
+func (autoMigratedUser) TableName() string      { return "users" }
+func (autoMigratedStamp) TableName() string     { return "stamps" }
+func (autoMigratedUserStamp) TableName() string { return "user_stamps" }
+
+func TestMigrator(t *testing.T) {
+	tests := []struct {
+		name string
+		// setup prepares the database before the first migrate up
+		setup func(t *testing.T, db *gorm.DB)
+	}{
+		{
+			name:  "empty database",
+			setup: func(t *testing.T, db *gorm.DB) {},
+		},
+		{
+			name: "database created by AutoMigrate",
+			setup: func(t *testing.T, db *gorm.DB) {
+				require.NoError(t, db.AutoMigrate(&autoMigratedUser{}, &autoMigratedStamp{}, &autoMigratedUserStamp{}))
+				require.NoError(t, db.Exec("INSERT INTO users (id, name) VALUES (1, 'gopher')").Error)
+				require.NoError(t, db.Exec("INSERT INTO stamps (id, name) VALUES (1, 'Gopher Wall1')").Error)
+				require.NoError(t, db.Exec("INSERT INTO user_stamps (user_id, stamp_id) VALUES (1, 1)").Error)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			db := openTestDB(t)
+			tt.setup(t, db)
+
+			m, err := NewMigrator(db, migrations.FS)
+			require.NoError(t, err)
+			latest := m.migrations[len(m.migrations)-1].Version
+
+			done, err := m.Up(ctx)
+			require.NoError(t, err)
+			assert.Len(t, done, len(m.migrations))
+			version, dirty, err := m.Version(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, latest, version)
+			assert.False(t, dirty)
+
+			// Existing stamps got a secret and the default event, and acquisitions go with their participant
+			var stamps []struct {
+				Secret  string
+				EventID uint
+			}
+			require.NoError(t, db.Raw("SELECT secret, event_id FROM stamps").Scan(&stamps).Error)
+			for _, s := range stamps {
+				assert.Len(t, s.Secret, 32)
+				assert.Equal(t, uint(1), s.EventID)
+			}
+			require.NoError(t, db.Exec("DELETE FROM users").Error)
+			var acquisitions int64
+			require.NoError(t, db.Raw("SELECT COUNT(*) FROM user_stamps").Scan(&acquisitions).Error)
+			assert.Zero(t, acquisitions)
+
+			// Every down reverses its up
+			done, err = m.Down(ctx, len(m.migrations))
+			require.NoError(t, err)
+			assert.Len(t, done, len(m.migrations))
+			done, err = m.Up(ctx)
+			require.NoError(t, err)
+			assert.Len(t, done, len(m.migrations))
+		})
+	}
+}
+
+func TestCheckClean(t *testing.T) {
+	assert.NoError(t, checkClean(nil))
+	assert.NoError(t, checkClean(map[uint]bool{1: false, 2: false}))
+	assert.ErrorContains(t, checkClean(map[uint]bool{1: false, 3: true, 2: true}), "migration 002 is dirty")
+}
+
+func TestSplitStatements(t *testing.T) {
+	script := `-- Create users table; with a semicolon in the comment
+CREATE TABLE users (
+    id BIGINT,
+    -- ニックネーム
+    name VARCHAR(100)
+);
+
+CREATE INDEX idx_users_name ON users(name);
+DROP TABLE legacy`
+
+	assert.Equal(t, []string{
+		"CREATE TABLE users (\n    id BIGINT,\n    name VARCHAR(100)\n)",
+		"CREATE INDEX idx_users_name ON users(name)",
+		"DROP TABLE legacy",
+	}, splitStatements(script))
+}
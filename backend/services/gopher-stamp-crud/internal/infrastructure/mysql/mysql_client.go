@@ -1,19 +1,34 @@
 package mysql
 
 import (
+	"context"
 	"fmt"
-	"os"
 
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/config"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/seeds"
 
 	mysqlDriver "gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
 
-// initializeStampData inserts initial stamp data if the stamps table is empty
-func initializeStampData(db *gorm.DB) error {
+// initializeStampData seeds the stamp master data.
+// When a seed file is configured, the file is upserted on every start so that edits to it take effect on the next deploy.
+// Otherwise the embedded default seed is applied only if the default event has no stamps.
+// Seeds always target the default event; stamps of other events are managed through the API.
+func initializeStampData(db *gorm.DB, path string) error {
+	ctx := context.Background()
+
+	if path != "" {
+		seed, err := LoadStampSeedFile(path)
+		if err != nil {
+			return err
+		}
+		return ApplyStampSeed(ctx, db, seed)
+	}
+
 	var count int64
-	if err := db.Model(&entity.Stamp{}).Count(&count).Error; err != nil {
+	if err := db.Model(&entity.Stamp{}).Where("event_id = ?", entity.DefaultEventID).Count(&count).Error; err != nil {
 		return fmt.Errorf("failed to count stamps: %w", err)
 	}
 
@@ -22,53 +37,38 @@ func initializeStampData(db *gorm.DB) error {
 		return nil
 	}
 
-	// Initial stamp master data
-	initialStamps := []entity.Stamp{
-		{Name: "午前ワークショップ"},
-		{Name: "午後ワークショップ"},
-		{Name: "Go製のゲーム展示"},
-		{Name: "ジェスチャーゲーム"},
-		{Name: "シャッフルランチ || 個人展示"},
-		{Name: "Gopher Wall1 "},
-		{Name: "Gopher Wall2"},
-		{Name: "Gopher Wall3"},
-	}
-
-	if err := db.Create(&initialStamps).Error; err != nil {
-		return fmt.Errorf("failed to insert initial stamps: %w", err)
+	seed, err := ParseStampSeed(seeds.Stamps, "yaml")
+	if err != nil {
+		return err
 	}
-
-	return nil
+	return ApplyStampSeed(ctx, db, seed)
 }
 
-func NewMySQLClient() (*gorm.DB, error) {
-	dbUser := os.Getenv("DB_USER")
-	dbPassword := os.Getenv("DB_PASSWORD")
-	dbHost := os.Getenv("DB_HOST")
-	dbPort := os.Getenv("DB_PORT")
-	dbName := os.Getenv("DB_NAME")
-
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
-		dbUser, dbPassword, dbHost, dbPort, dbName)
-
-	db, err := gorm.Open(mysqlDriver.Open(dsn), &gorm.Config{})
+// NewMySQLClient connects to the database and seeds the stamp master data.
+// The schema must already be up to date; run the migrate subcommand first.
+func NewMySQLClient(cfg config.Database) (*gorm.DB, error) {
+	db, err := OpenMySQL(cfg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to database: %w", err)
-	}
-
-	// Auto-migrate database schema
-	if err := db.AutoMigrate(
-		&entity.User{},
-		&entity.Stamp{},
-		&entity.UserStamp{},
-	); err != nil {
-		return nil, fmt.Errorf("failed to auto-migrate database: %w", err)
+		return nil, err
 	}
 
 	// Initialize stamp master data if not exists
-	if err := initializeStampData(db); err != nil {
+	if err := initializeStampData(db, cfg.StampSeedFile); err != nil {
 		return nil, fmt.Errorf("failed to initialize stamp data: %w", err)
 	}
 
 	return db, nil
 }
+
+// OpenMySQL connects to the configured database.
+func OpenMySQL(cfg config.Database) (*gorm.DB, error) {
+	db, err := gorm.Open(mysqlDriver.Open(cfg.DSN()), &gorm.Config{
+		// Report unique constraint violations as gorm.ErrDuplicatedKey
+		TranslateError: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	return db, nil
+}
@@ -0,0 +1,63 @@
+package mysql
+
+import (
+	"context"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) repository.OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+func (r *outboxRepository) Create(ctx context.Context, message *entity.OutboxMessage) error {
+	return conn(ctx, r.db).Create(message).Error
+}
+
+func (r *outboxRepository) FindPending(ctx context.Context, now time.Time, limit int) ([]entity.OutboxMessage, error) {
+	var messages []entity.OutboxMessage
+	err := conn(ctx, r.db).
+		Where("delivered_at IS NULL AND failed_at IS NULL").
+		Where("retry_at IS NULL OR retry_at <= ?", now).
+		Order("id").
+		Limit(limit).
+		Find(&messages).Error
+	return messages, err
+}
+
+func (r *outboxRepository) MarkDelivered(ctx context.Context, id uint, deliveredAt time.Time) error {
+	return conn(ctx, r.db).
+		Model(&entity.OutboxMessage{}).
+		Where("id = ?", id).
+		Update("delivered_at", deliveredAt).Error
+}
+
+func (r *outboxRepository) RecordFailure(ctx context.Context, message *entity.OutboxMessage) error {
+	return conn(ctx, r.db).
+		Model(&entity.OutboxMessage{}).
+		Where("id = ?", message.ID).
+		Updates(map[string]any{
+			"attempts":   message.Attempts,
+			"last_error": message.LastError,
+			"retry_at":   message.RetryAt,
+			"failed_at":  message.FailedAt,
+		}).Error
+}
+
+func (r *outboxRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	return conn(ctx, r.db).Where("user_id = ?", userID).Delete(&entity.OutboxMessage{}).Error
+}
+
+func (r *outboxRepository) DeleteFinishedBefore(ctx context.Context, before time.Time) error {
+	return conn(ctx, r.db).
+		Where("delivered_at < ? OR failed_at < ?", before, before).
+		Delete(&entity.OutboxMessage{}).Error
+}
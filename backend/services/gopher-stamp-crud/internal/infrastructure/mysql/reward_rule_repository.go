@@ -0,0 +1,42 @@
+package mysql
+
+import (
+	"context"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+type rewardRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewRewardRuleRepository(db *gorm.DB) repository.RewardRuleRepository {
+	return &rewardRuleRepository{db: db}
+}
+
+func (r *rewardRuleRepository) FindByEventID(ctx context.Context, eventID uint) ([]entity.RewardRule, error) {
+	var rules []entity.RewardRule
+	err := conn(ctx, r.db).
+		Preload("Stamps").
+		Where("event_id = ?", eventID).
+		Order("id").
+		Find(&rules).Error
+	return rules, err
+}
+
+func (r *rewardRuleRepository) FindByID(ctx context.Context, id uint) (*entity.RewardRule, error) {
+	var rule entity.RewardRule
+	err := conn(ctx, r.db).Preload("Stamps").First(&rule, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r *rewardRuleRepository) Create(ctx context.Context, rule *entity.RewardRule) error {
+	// Only insert the reward_rule_stamps links; the stamps themselves already exist
+	return conn(ctx, r.db).Omit("Stamps.*").Create(rule).Error
+}
@@ -17,15 +17,15 @@ func NewStampRepository(db *gorm.DB) repository.StampRepository {
 	return &stampRepository{db: db}
 }
 
-func (r *stampRepository) FindAll(ctx context.Context, limit, offset int) ([]entity.Stamp, error) {
+func (r *stampRepository) FindAll(ctx context.Context, eventID uint, limit, offset int) ([]entity.Stamp, error) {
 	var stamps []entity.Stamp
-	err := r.db.WithContext(ctx).Limit(limit).Offset(offset).Find(&stamps).Error
+	err := conn(ctx, r.db).Where("event_id = ?", eventID).Limit(limit).Offset(offset).Find(&stamps).Error
 	return stamps, err
 }
 
 func (r *stampRepository) FindByID(ctx context.Context, id uint) (*entity.Stamp, error) {
 	var stamp entity.Stamp
-	err := r.db.WithContext(ctx).First(&stamp, id).Error
+	err := conn(ctx, r.db).First(&stamp, id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -33,19 +33,19 @@ func (r *stampRepository) FindByID(ctx context.Context, id uint) (*entity.Stamp,
 }
 
 func (r *stampRepository) Create(ctx context.Context, stamp *entity.Stamp) error {
-	return r.db.WithContext(ctx).Create(stamp).Error
+	return conn(ctx, r.db).Create(stamp).Error
 }
 
 func (r *stampRepository) Update(ctx context.Context, stamp *entity.Stamp) error {
-	return r.db.WithContext(ctx).Save(stamp).Error
+	return conn(ctx, r.db).Save(stamp).Error
 }
 
 func (r *stampRepository) Delete(ctx context.Context, id uint) error {
-	return r.db.WithContext(ctx).Delete(&entity.Stamp{}, id).Error
+	return conn(ctx, r.db).Delete(&entity.Stamp{}, id).Error
 }
 
-func (r *stampRepository) Count(ctx context.Context) (int64, error) {
+func (r *stampRepository) Count(ctx context.Context, eventID uint) (int64, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&entity.Stamp{}).Count(&count).Error
+	err := conn(ctx, r.db).Model(&entity.Stamp{}).Where("event_id = ?", eventID).Count(&count).Error
 	return count, err
 }
@@ -0,0 +1,159 @@
+package mysql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/stamptoken"
+
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StampSeed is the stamp master data read from a seed file:
+//
+//	stamps:
+//	  - key: morning-workshop
+//	    name: 午前ワークショップ
+//	    available_from: 2025-11-22T10:00:00+09:00  # optional
+//	    available_until: 2025-11-22T12:00:00+09:00 # optional
+//
+// JSON files use the same structure.
+type StampSeed struct {
+	Stamps []StampSeedEntry `json:"stamps" yaml:"stamps"`
+}
+
+// StampSeedEntry is a single stamp. Key identifies the stamp across re-applications of the seed.
+// The acquisition window is optional; a bound that is left out is unlimited.
+type StampSeedEntry struct {
+	Key            string     `json:"key" yaml:"key"`
+	Name           string     `json:"name" yaml:"name"`
+	AvailableFrom  *time.Time `json:"available_from,omitempty" yaml:"available_from,omitempty"`
+	AvailableUntil *time.Time `json:"available_until,omitempty" yaml:"available_until,omitempty"`
+}
+
+// LoadStampSeedFile reads a seed file. Files ending in .json are parsed as JSON, anything else as YAML.
+func LoadStampSeedFile(path string) (*StampSeed, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stamp seed: %w", err)
+	}
+
+	format := "yaml"
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		format = "json"
+	}
+	return ParseStampSeed(data, format)
+}
+
+// ParseStampSeed parses and validates a seed in the given format ("yaml" or "json").
+func ParseStampSeed(data []byte, format string) (*StampSeed, error) {
+	var seed StampSeed
+	switch format {
+	case "json":
+		if err := json.Unmarshal(data, &seed); err != nil {
+			return nil, fmt.Errorf("invalid stamp seed JSON: %w", err)
+		}
+	case "yaml":
+		if err := yaml.Unmarshal(data, &seed); err != nil {
+			return nil, fmt.Errorf("invalid stamp seed YAML: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported stamp seed format %q", format)
+	}
+
+	if err := seed.validate(); err != nil {
+		return nil, err
+	}
+	return &seed, nil
+}
+
+func (s *StampSeed) validate() error {
+	if len(s.Stamps) == 0 {
+		return errors.New("stamp seed has no stamps")
+	}
+
+	seen := make(map[string]bool, len(s.Stamps))
+	for i := range s.Stamps {
+		e := &s.Stamps[i]
+		e.Key = strings.TrimSpace(e.Key)
+		e.Name = strings.TrimSpace(e.Name)
+
+		switch {
+		case e.Key == "":
+			return fmt.Errorf("stamp seed entry %d: key is required", i)
+		case utf8.RuneCountInString(e.Key) > 100:
+			return fmt.Errorf("stamp seed entry %d: key must be at most 100 characters", i)
+		case seen[e.Key]:
+			return fmt.Errorf("stamp seed entry %d: duplicate key %q", i, e.Key)
+		case e.Name == "":
+			return fmt.Errorf("stamp seed entry %d (%s): name is required", i, e.Key)
+		case utf8.RuneCountInString(e.Name) > 100:
+			return fmt.Errorf("stamp seed entry %d (%s): name must be at most 100 characters", i, e.Key)
+		case e.AvailableFrom != nil && e.AvailableUntil != nil && !e.AvailableUntil.After(*e.AvailableFrom):
+			return fmt.Errorf("stamp seed entry %d (%s): available_until must be after available_from", i, e.Key)
+		}
+		seen[e.Key] = true
+	}
+	return nil
+}
+
+// ApplyStampSeed upserts the seeded stamps of the default event by key in a single transaction:
+// existing stamps get the seeded name and window, new keys are inserted and stamps missing from the seed are left alone.
+// A stamp created before seed keys existed is adopted when its name matches, instead of being duplicated.
+// New stamps, and existing ones still without one, get a secret for their rotating codes.
+func ApplyStampSeed(ctx context.Context, db *gorm.DB, seed *StampSeed) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, e := range seed.Stamps {
+			var keyed int64
+			if err := tx.Model(&entity.Stamp{}).Where("event_id = ? AND seed_key = ?", entity.DefaultEventID, e.Key).Count(&keyed).Error; err != nil {
+				return fmt.Errorf("failed to look up stamp %s: %w", e.Key, err)
+			}
+
+			// Adopt an unkeyed stamp with the same name, e.g. one inserted by an older release
+			if keyed == 0 {
+				if err := tx.Model(&entity.Stamp{}).
+					Where("event_id = ? AND seed_key IS NULL AND TRIM(name) = ?", entity.DefaultEventID, e.Name).
+					Limit(1).
+					Update("seed_key", e.Key).Error; err != nil {
+					return fmt.Errorf("failed to adopt stamp %s: %w", e.Key, err)
+				}
+			}
+
+			secret, err := stamptoken.GenerateSecret()
+			if err != nil {
+				return err
+			}
+			key := e.Key
+			stamp := entity.Stamp{
+				EventID:        entity.DefaultEventID,
+				SeedKey:        &key,
+				Name:           e.Name,
+				Secret:         secret,
+				AvailableFrom:  e.AvailableFrom,
+				AvailableUntil: e.AvailableUntil,
+			}
+			// A stamp's secret is kept once set, since changing it would invalidate the booth screen
+			updates := append(
+				clause.AssignmentColumns([]string{"name", "available_from", "available_until", "updated_at"}),
+				clause.Assignment{Column: clause.Column{Name: "secret"}, Value: gorm.Expr("IF(secret = '', VALUES(secret), secret)")},
+			)
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "event_id"}, {Name: "seed_key"}},
+				DoUpdates: updates,
+			}).Create(&stamp).Error; err != nil {
+				return fmt.Errorf("failed to upsert stamp %s: %w", e.Key, err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,138 @@
+package mysql
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/seeds"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStampSeed(t *testing.T) {
+	jst := time.FixedZone("", 9*60*60)
+	opensAt := time.Date(2025, 11, 22, 13, 0, 0, 0, jst)
+	closesAt := time.Date(2025, 11, 22, 17, 0, 0, 0, jst)
+
+	tests := []struct {
+		name     string
+		data     string
+		format   string
+		want     []StampSeedEntry
+		errMatch string
+	}{
+		{
+			name:   "yaml",
+			format: "yaml",
+			data: `
+stamps:
+  - key: morning-workshop
+    name: " 午前ワークショップ "
+  - key: gopher-wall-1
+    name: Gopher Wall1
+`,
+			want: []StampSeedEntry{
+				{Key: "morning-workshop", Name: "午前ワークショップ"},
+				{Key: "gopher-wall-1", Name: "Gopher Wall1"},
+			},
+		},
+		{
+			name:   "json",
+			format: "json",
+			data:   `{"stamps": [{"key": "keynote", "name": "Keynote"}]}`,
+			want: []StampSeedEntry{
+				{Key: "keynote", Name: "Keynote"},
+			},
+		},
+		{
+			name:   "window",
+			format: "yaml",
+			data: `
+stamps:
+  - key: afternoon-workshop
+    name: 午後ワークショップ
+    available_from: 2025-11-22T13:00:00+09:00
+    available_until: 2025-11-22T17:00:00+09:00
+`,
+			want: []StampSeedEntry{
+				{Key: "afternoon-workshop", Name: "午後ワークショップ", AvailableFrom: &opensAt, AvailableUntil: &closesAt},
+			},
+		},
+		{
+			name:     "window ends before it opens",
+			format:   "json",
+			data:     `{"stamps": [{"key": "a", "name": "A", "available_from": "2025-11-22T17:00:00+09:00", "available_until": "2025-11-22T13:00:00+09:00"}]}`,
+			errMatch: "available_until must be after available_from",
+		},
+		{
+			name:     "no stamps",
+			format:   "yaml",
+			data:     "stamps: []",
+			errMatch: "has no stamps",
+		},
+		{
+			name:     "missing key",
+			format:   "yaml",
+			data:     "stamps:\n  - name: Keynote",
+			errMatch: "key is required",
+		},
+		{
+			name:     "duplicate key",
+			format:   "yaml",
+			data:     "stamps:\n  - {key: a, name: A}\n  - {key: a, name: B}",
+			errMatch: `duplicate key "a"`,
+		},
+		{
+			name:     "missing name",
+			format:   "json",
+			data:     `{"stamps": [{"key": "a", "name": "  "}]}`,
+			errMatch: "name is required",
+		},
+		{
+			name:     "malformed",
+			format:   "json",
+			data:     `{"stamps": [`,
+			errMatch: "invalid stamp seed JSON",
+		},
+		{
+			name:     "unsupported format",
+			format:   "toml",
+			data:     "",
+			errMatch: "unsupported stamp seed format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseStampSeed([]byte(tt.data), tt.format)
+			if tt.errMatch != "" {
+				assert.ErrorContains(t, err, tt.errMatch)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.Stamps)
+		})
+	}
+}
+
+func TestLoadStampSeedFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "stamps.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"stamps": [{"key": "keynote", "name": "Keynote"}]}`), 0o600))
+
+	seed, err := LoadStampSeedFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, []StampSeedEntry{{Key: "keynote", Name: "Keynote"}}, seed.Stamps)
+
+	_, err = LoadStampSeedFile(filepath.Join(dir, "missing.yaml"))
+	assert.Error(t, err)
+}
+
+func TestParseStampSeed_Default(t *testing.T) {
+	seed, err := ParseStampSeed(seeds.Stamps, "yaml")
+	require.NoError(t, err)
+	assert.Len(t, seed.Stamps, 8)
+}
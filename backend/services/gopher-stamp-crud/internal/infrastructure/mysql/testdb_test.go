@@ -0,0 +1,66 @@
+package mysql
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/config"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/migrations"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// openTestDB creates an empty database on the MySQL server named by TEST_DB_HOST, and the optional
+// TEST_DB_PORT, TEST_DB_USER and TEST_DB_PASSWORD, and drops it when the test ends.
+// The test is skipped when TEST_DB_HOST is not set, so that `go test ./...` passes without MySQL.
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	host := os.Getenv("TEST_DB_HOST")
+	if host == "" {
+		t.Skip("Skipping MySQL test because TEST_DB_HOST is not set")
+	}
+	cfg := config.Database{
+		Host:     host,
+		Port:     cmp.Or(os.Getenv("TEST_DB_PORT"), "3306"),
+		User:     cmp.Or(os.Getenv("TEST_DB_USER"), "root"),
+		Password: os.Getenv("TEST_DB_PASSWORD"),
+	}
+
+	server, err := OpenMySQL(cfg)
+	require.NoError(t, err)
+	cfg.Name = fmt.Sprintf("stamprally_test_%d", time.Now().UnixNano())
+	require.NoError(t, server.Exec("CREATE DATABASE "+cfg.Name).Error)
+
+	db, err := OpenMySQL(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+		if err := server.Exec("DROP DATABASE " + cfg.Name).Error; err != nil {
+			t.Logf("Failed to drop test database %s: %v", cfg.Name, err)
+		}
+		if sqlDB, err := server.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	return db
+}
+
+// openMigratedTestDB is openTestDB with every migration applied.
+func openMigratedTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db := openTestDB(t)
+	migrator, err := NewMigrator(db, migrations.FS)
+	require.NoError(t, err)
+	_, err = migrator.Up(context.Background())
+	require.NoError(t, err)
+	return db
+}
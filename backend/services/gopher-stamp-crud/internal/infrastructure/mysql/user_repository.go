@@ -2,8 +2,10 @@ package mysql
 
 import (
 	"context"
+	"strings"
 
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
 
 	"gorm.io/gorm"
 )
@@ -17,29 +19,86 @@ func NewUserRepository(db *gorm.DB) *userRepository {
 }
 
 func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
-	return r.db.WithContext(ctx).Create(user).Error
+	// The chosen Go features are saved by GoFeatureRepository
+	return conn(ctx, r.db).Omit("GoFeatures").Create(user).Error
 }
 
 func (r *userRepository) FindByID(ctx context.Context, id uint) (*entity.User, error) {
 	var user entity.User
-	if err := r.db.WithContext(ctx).First(&user, id).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("GoFeatures", inDisplayOrder).First(&user, id).Error; err != nil {
 		return nil, err
 	}
 	return &user, nil
 }
 
-func (r *userRepository) FindAll(ctx context.Context) ([]*entity.User, error) {
+func (r *userRepository) FindAll(ctx context.Context, filter repository.UserFilter, sort repository.UserSort, desc bool, limit, offset int) ([]*entity.User, error) {
+	dir := " ASC"
+	if desc {
+		dir = " DESC"
+	}
+
+	query := r.filtered(ctx, filter)
+	switch sort {
+	case repository.UserSortName:
+		query = query.Order("users.name" + dir)
+	case repository.UserSortStampCount:
+		query = query.
+			Select("users.*").
+			Joins("LEFT JOIN (SELECT user_id, COUNT(*) AS stamp_count FROM user_stamps GROUP BY user_id) AS counts ON counts.user_id = users.id").
+			Order("COALESCE(counts.stamp_count, 0)" + dir)
+	default:
+		query = query.Order("users.created_at" + dir)
+	}
+
+	var users []*entity.User
+	if err := query.Order("users.id"+dir).Preload("GoFeatures", inDisplayOrder).Limit(limit).Offset(offset).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (r *userRepository) Count(ctx context.Context, filter repository.UserFilter) (int64, error) {
+	var count int64
+	err := r.filtered(ctx, filter).Count(&count).Error
+	return count, err
+}
+
+func (r *userRepository) filtered(ctx context.Context, filter repository.UserFilter) *gorm.DB {
+	query := conn(ctx, r.db).Model(&entity.User{}).Where("users.event_id = ?", filter.EventID)
+	if filter.Search != "" {
+		pattern := "%" + likeEscaper.Replace(filter.Search) + "%"
+		query = query.Where("(users.name LIKE ? OR users.twitter_id LIKE ?)", pattern, pattern)
+	}
+	return query
+}
+
+// likeEscaper escapes the LIKE wildcards so that search terms match literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func (r *userRepository) FindWithInlineIcons(ctx context.Context, afterID uint, limit int) ([]*entity.User, error) {
 	var users []*entity.User
-	if err := r.db.WithContext(ctx).Find(&users).Error; err != nil {
+	err := conn(ctx, r.db).
+		Where("id > ?", afterID).
+		Where("icon IS NOT NULL AND icon <> ''").
+		Where("icon NOT LIKE 'http://%' AND icon NOT LIKE 'https://%'").
+		Order("id ASC").
+		Limit(limit).
+		Find(&users).Error
+	if err != nil {
 		return nil, err
 	}
 	return users, nil
 }
 
 func (r *userRepository) Update(ctx context.Context, user *entity.User) error {
-	return r.db.WithContext(ctx).Save(user).Error
+	return conn(ctx, r.db).Omit("GoFeatures").Save(user).Error
+}
+
+// inDisplayOrder orders preloaded Go features as the catalogue lists them.
+func inDisplayOrder(db *gorm.DB) *gorm.DB {
+	return db.Order("go_features.sort_order ASC, go_features.id ASC")
 }
 
 func (r *userRepository) Delete(ctx context.Context, id uint) error {
-	return r.db.WithContext(ctx).Delete(&entity.User{}, id).Error
+	return conn(ctx, r.db).Delete(&entity.User{}, id).Error
 }
@@ -0,0 +1,50 @@
+package mysql
+
+import (
+	"context"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+type userRewardRepository struct {
+	db *gorm.DB
+}
+
+func NewUserRewardRepository(db *gorm.DB) repository.UserRewardRepository {
+	return &userRewardRepository{db: db}
+}
+
+func (r *userRewardRepository) FindByUserID(ctx context.Context, userID uint) ([]entity.UserReward, error) {
+	var userRewards []entity.UserReward
+	err := conn(ctx, r.db).
+		Preload("RewardRule").
+		Where("user_id = ?", userID).
+		Order("reward_rule_id").
+		Find(&userRewards).Error
+	return userRewards, err
+}
+
+func (r *userRewardRepository) Create(ctx context.Context, userReward *entity.UserReward) error {
+	return conn(ctx, r.db).Create(userReward).Error
+}
+
+func (r *userRewardRepository) MarkRedeemed(ctx context.Context, userID, ruleID uint, at time.Time) (bool, error) {
+	// The redeemed_at IS NULL condition makes the check and the update one atomic statement,
+	// so two staff members redeeming the same prize at once cannot both succeed.
+	result := conn(ctx, r.db).
+		Model(&entity.UserReward{}).
+		Where("user_id = ? AND reward_rule_id = ? AND redeemed_at IS NULL", userID, ruleID).
+		Update("redeemed_at", at)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *userRewardRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	return conn(ctx, r.db).Where("user_id = ?", userID).Delete(&entity.UserReward{}).Error
+}
@@ -0,0 +1,34 @@
+package mysql
+
+import (
+	"context"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+type userSessionRepository struct {
+	db *gorm.DB
+}
+
+func NewUserSessionRepository(db *gorm.DB) repository.UserSessionRepository {
+	return &userSessionRepository{db: db}
+}
+
+func (r *userSessionRepository) Create(ctx context.Context, session *entity.UserSession) error {
+	return conn(ctx, r.db).Create(session).Error
+}
+
+func (r *userSessionRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*entity.UserSession, error) {
+	var session entity.UserSession
+	if err := conn(ctx, r.db).Where("token_hash = ?", tokenHash).First(&session).Error; err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (r *userSessionRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	return conn(ctx, r.db).Where("user_id = ?", userID).Delete(&entity.UserSession{}).Error
+}
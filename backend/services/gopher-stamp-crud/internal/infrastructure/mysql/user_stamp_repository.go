@@ -19,7 +19,7 @@ func NewUserStampRepository(db *gorm.DB) repository.UserStampRepository {
 
 func (r *userStampRepository) FindByUserID(ctx context.Context, userID uint) ([]entity.UserStamp, error) {
 	var userStamps []entity.UserStamp
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Preload("Stamp").
 		Where("user_id = ?", userID).
 		Find(&userStamps).Error
@@ -27,37 +27,96 @@ func (r *userStampRepository) FindByUserID(ctx context.Context, userID uint) ([]
 }
 
 func (r *userStampRepository) Create(ctx context.Context, userStamp *entity.UserStamp) error {
-	return r.db.WithContext(ctx).Create(userStamp).Error
+	return conn(ctx, r.db).Create(userStamp).Error
 }
 
-func (r *userStampRepository) ExistsByUserIDAndStampID(ctx context.Context, userID, stampID uint) (bool, error) {
-	var count int64
-	err := r.db.WithContext(ctx).
-		Model(&entity.UserStamp{}).
-		Where("user_id = ? AND stamp_id = ?", userID, stampID).
-		Count(&count).Error
-	return count > 0, err
-}
+func (r *userStampRepository) FindStampIDsByUserIDs(ctx context.Context, userIDs []uint) (map[uint][]uint, error) {
+	userStampMap := make(map[uint][]uint)
+	if len(userIDs) == 0 {
+		return userStampMap, nil
+	}
 
-func (r *userStampRepository) FindAllUserStampIDs(ctx context.Context) (map[uint][]uint, error) {
 	var results []struct {
 		UserID  uint
 		StampID uint
 	}
 
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Model(&entity.UserStamp{}).
 		Select("user_id, stamp_id").
+		Where("user_id IN ?", userIDs).
 		Find(&results).Error
 
 	if err != nil {
 		return nil, err
 	}
 
-	userStampMap := make(map[uint][]uint)
 	for _, result := range results {
 		userStampMap[result.UserID] = append(userStampMap[result.UserID], result.StampID)
 	}
 
 	return userStampMap, nil
 }
+
+func (r *userStampRepository) FindLeaderboard(ctx context.Context, eventID uint, limit, offset int) ([]entity.LeaderboardEntry, error) {
+	var entries []entity.LeaderboardEntry
+	err := conn(ctx, r.db).
+		Table("users").
+		Select("users.id AS user_id, users.name, COUNT(user_stamps.stamp_id) AS stamp_count, MAX(user_stamps.acquired_at) AS last_acquired_at").
+		// Only stamps of the user's own event count; the nested join keeps users with none of them, with a count of 0
+		Joins("LEFT JOIN (user_stamps JOIN stamps ON stamps.id = user_stamps.stamp_id) ON user_stamps.user_id = users.id AND stamps.event_id = users.event_id").
+		Where("users.event_id = ?", eventID).
+		Group("users.id").
+		// users.id keeps the order stable for participants who are still tied
+		Order("stamp_count DESC, last_acquired_at ASC, users.id ASC").
+		Limit(limit).
+		Offset(offset).
+		Scan(&entries).Error
+	return entries, err
+}
+
+func (r *userStampRepository) CountByStamp(ctx context.Context, eventID uint) ([]entity.StampAcquisitionCount, error) {
+	var counts []entity.StampAcquisitionCount
+	err := conn(ctx, r.db).
+		Table("stamps").
+		Select("stamps.id AS stamp_id, stamps.name, COUNT(user_stamps.user_id) AS acquisitions").
+		// A LEFT JOIN keeps stamps nobody has acquired yet, with a count of 0
+		Joins("LEFT JOIN user_stamps ON user_stamps.stamp_id = stamps.id").
+		Where("stamps.event_id = ?", eventID).
+		Group("stamps.id").
+		Order("stamps.id ASC").
+		Scan(&counts).Error
+	return counts, err
+}
+
+func (r *userStampRepository) CountByHour(ctx context.Context, eventID uint) ([]entity.HourlyAcquisitionCount, error) {
+	var counts []entity.HourlyAcquisitionCount
+	err := conn(ctx, r.db).
+		Table("user_stamps").
+		Select("TIMESTAMP(DATE_FORMAT(user_stamps.acquired_at, '%Y-%m-%d %H:00:00')) AS hour, COUNT(*) AS acquisitions").
+		Joins("JOIN stamps ON stamps.id = user_stamps.stamp_id").
+		Where("stamps.event_id = ?", eventID).
+		Group("hour").
+		Order("hour ASC").
+		Scan(&counts).Error
+	return counts, err
+}
+
+func (r *userStampRepository) FindCompletions(ctx context.Context, eventID uint) ([]entity.Completion, error) {
+	var completions []entity.Completion
+	err := conn(ctx, r.db).
+		Table("users").
+		Select("users.id AS user_id, users.created_at AS registered_at, MAX(user_stamps.acquired_at) AS completed_at").
+		Joins("JOIN user_stamps ON user_stamps.user_id = users.id").
+		Where("users.event_id = ?", eventID).
+		Group("users.id").
+		// Participants can only acquire their own event's stamps, so having as many as the event means all of them
+		Having("COUNT(user_stamps.stamp_id) = (SELECT COUNT(*) FROM stamps WHERE stamps.event_id = ?)", eventID).
+		Order("completed_at ASC, users.id ASC").
+		Scan(&completions).Error
+	return completions, err
+}
+
+func (r *userStampRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	return conn(ctx, r.db).Where("user_id = ?", userID).Delete(&entity.UserStamp{}).Error
+}
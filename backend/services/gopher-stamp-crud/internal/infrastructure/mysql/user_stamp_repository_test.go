@@ -0,0 +1,128 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestUserStampRepository_Create_Concurrent(t *testing.T) {
+	db := openMigratedTestDB(t)
+	ctx := context.Background()
+	repo := NewUserStampRepository(db)
+	txManager := NewTxManager(db)
+
+	user := &entity.User{EventID: entity.DefaultEventID, Name: "Test User"}
+	require.NoError(t, db.Create(user).Error)
+	stamp := &entity.Stamp{EventID: entity.DefaultEventID, Name: "Test Stamp", Secret: "secret"}
+	require.NoError(t, db.Create(stamp).Error)
+
+	// Simulate rapid repeated taps on the acquire button, each acquiring in a transaction of its own
+	const attempts = 20
+	start := make(chan struct{})
+	results := make(chan error, attempts)
+	for range attempts {
+		go func() {
+			<-start
+			results <- txManager.Do(ctx, func(ctx context.Context) error {
+				return repo.Create(ctx, &entity.UserStamp{UserID: user.ID, StampID: stamp.ID})
+			})
+		}()
+	}
+	close(start)
+
+	// The primary key lets exactly one through and reports the others as duplicates,
+	// which AcquireStamp turns into ErrStampAlreadyAcquired
+	succeeded := 0
+	for range attempts {
+		err := <-results
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assert.True(t, errors.Is(err, gorm.ErrDuplicatedKey), "unexpected error: %v", err)
+	}
+	assert.Equal(t, 1, succeeded)
+
+	stamps, err := repo.FindByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Len(t, stamps, 1)
+}
+
+func TestUserStampRepository_FindLeaderboard(t *testing.T) {
+	db := openMigratedTestDB(t)
+	ctx := context.Background()
+	repo := NewUserStampRepository(db)
+
+	otherEvent := &entity.Event{Name: "Other Event"}
+	require.NoError(t, db.Create(otherEvent).Error)
+
+	var stamps []*entity.Stamp
+	for _, eventID := range []uint{entity.DefaultEventID, entity.DefaultEventID, otherEvent.ID, otherEvent.ID, otherEvent.ID} {
+		stamp := &entity.Stamp{EventID: eventID, Name: "Test Stamp", Secret: "secret"}
+		require.NoError(t, db.Create(stamp).Error)
+		stamps = append(stamps, stamp)
+	}
+	var users []*entity.User
+	for _, eventID := range []uint{entity.DefaultEventID, entity.DefaultEventID, entity.DefaultEventID, entity.DefaultEventID, entity.DefaultEventID, otherEvent.ID} {
+		user := &entity.User{EventID: eventID, Name: "Test User"}
+		require.NoError(t, db.Create(user).Error)
+		users = append(users, user)
+	}
+
+	base := time.Date(2025, 11, 22, 10, 0, 0, 0, time.UTC)
+	acquire := func(user *entity.User, stamp *entity.Stamp, at time.Time) {
+		t.Helper()
+		require.NoError(t, db.Create(&entity.UserStamp{UserID: user.ID, StampID: stamp.ID, AcquiredAt: at}).Error)
+	}
+	// users[0] and users[1] both hold the two stamps of the event, but users[1] got their second one first
+	acquire(users[0], stamps[0], base)
+	acquire(users[0], stamps[1], base.Add(2*time.Hour))
+	acquire(users[1], stamps[0], base)
+	acquire(users[1], stamps[1], base.Add(time.Hour))
+	// users[2] holds one stamp of the event; the stamps of the other event must not lift them past the others
+	acquire(users[2], stamps[1], base)
+	acquire(users[2], stamps[2], base)
+	acquire(users[2], stamps[3], base)
+	acquire(users[2], stamps[4], base)
+	// users[3] holds only stamps of the other event, and users[4] none at all
+	acquire(users[3], stamps[2], base)
+	// users[5] belongs to the other event
+	acquire(users[5], stamps[2], base)
+
+	entries, err := repo.FindLeaderboard(ctx, entity.DefaultEventID, 10, 0)
+	require.NoError(t, err)
+
+	type standing struct {
+		userID     uint
+		stampCount int64
+	}
+	var got []standing
+	for _, e := range entries {
+		got = append(got, standing{e.UserID, e.StampCount})
+	}
+	assert.Equal(t, []standing{
+		{users[1].ID, 2},
+		{users[0].ID, 2},
+		{users[2].ID, 1},
+		// Participants without a stamp are tied; the lower ID goes first
+		{users[3].ID, 0},
+		{users[4].ID, 0},
+	}, got)
+	require.NotNil(t, entries[0].LastAcquiredAt)
+	assert.True(t, entries[0].LastAcquiredAt.Equal(base.Add(time.Hour)))
+	assert.Nil(t, entries[3].LastAcquiredAt)
+
+	page, err := repo.FindLeaderboard(ctx, entity.DefaultEventID, 2, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, users[2].ID, page[0].UserID)
+	assert.Equal(t, users[3].ID, page[1].UserID)
+}
@@ -0,0 +1,41 @@
+package mysql
+
+import (
+	"context"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+type webhookDeliveryRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookDeliveryRepository(db *gorm.DB) repository.WebhookDeliveryRepository {
+	return &webhookDeliveryRepository{db: db}
+}
+
+func (r *webhookDeliveryRepository) Create(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	return conn(ctx, r.db).Omit("Webhook").Create(delivery).Error
+}
+
+func (r *webhookDeliveryRepository) FindByWebhookID(ctx context.Context, webhookID uint, limit int) ([]entity.WebhookDelivery, error) {
+	var deliveries []entity.WebhookDelivery
+	err := conn(ctx, r.db).
+		Where("webhook_id = ?", webhookID).
+		Order("id DESC").
+		Limit(limit).
+		Find(&deliveries).Error
+	return deliveries, err
+}
+
+func (r *webhookDeliveryRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	return conn(ctx, r.db).Where("user_id = ?", userID).Delete(&entity.WebhookDelivery{}).Error
+}
+
+func (r *webhookDeliveryRepository) DeleteBefore(ctx context.Context, before time.Time) error {
+	return conn(ctx, r.db).Where("attempted_at < ?", before).Delete(&entity.WebhookDelivery{}).Error
+}
@@ -0,0 +1,90 @@
+package mysql
+
+import (
+	"context"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type webhookJobRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookJobRepository(db *gorm.DB) repository.WebhookJobRepository {
+	return &webhookJobRepository{db: db}
+}
+
+func (r *webhookJobRepository) Create(ctx context.Context, jobs []entity.WebhookJob) error {
+	if len(jobs) == 0 {
+		return nil
+	}
+	return conn(ctx, r.db).
+		Omit("Webhook").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&jobs).Error
+}
+
+func (r *webhookJobRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]entity.WebhookJob, error) {
+	var jobs []entity.WebhookJob
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// SKIP LOCKED lets several servers claim different jobs at the same time
+		if err := tx.
+			Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+			Where("next_attempt_at <= ?", now).
+			Order("next_attempt_at, id").
+			Limit(limit).
+			Find(&jobs).Error; err != nil {
+			return err
+		}
+		if len(jobs) == 0 {
+			return nil
+		}
+
+		ids := make([]uint, len(jobs))
+		webhookIDs := make([]uint, len(jobs))
+		for i, job := range jobs {
+			ids[i] = job.ID
+			webhookIDs[i] = job.WebhookID
+		}
+		if err := tx.Model(&entity.WebhookJob{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", leaseUntil).Error; err != nil {
+			return err
+		}
+
+		// Read without locking; the webhooks only need to exist, which the foreign key ensures
+		var webhooks []entity.Webhook
+		if err := tx.Where("id IN ?", webhookIDs).Find(&webhooks).Error; err != nil {
+			return err
+		}
+		byID := make(map[uint]entity.Webhook, len(webhooks))
+		for _, webhook := range webhooks {
+			byID[webhook.ID] = webhook
+		}
+		for i := range jobs {
+			jobs[i].Webhook = byID[jobs[i].WebhookID]
+		}
+		return nil
+	})
+	return jobs, err
+}
+
+func (r *webhookJobRepository) Reschedule(ctx context.Context, id uint, attempts int, nextAttemptAt time.Time) error {
+	return conn(ctx, r.db).
+		Model(&entity.WebhookJob{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"attempts": attempts, "next_attempt_at": nextAttemptAt}).Error
+}
+
+func (r *webhookJobRepository) Delete(ctx context.Context, id uint) error {
+	return conn(ctx, r.db).Delete(&entity.WebhookJob{}, id).Error
+}
+
+func (r *webhookJobRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	return conn(ctx, r.db).Where("user_id = ?", userID).Delete(&entity.WebhookJob{}).Error
+}
@@ -0,0 +1,42 @@
+package mysql
+
+import (
+	"context"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) repository.WebhookRepository {
+	return &webhookRepository{db: db}
+}
+
+func (r *webhookRepository) FindByEventID(ctx context.Context, eventID uint) ([]entity.Webhook, error) {
+	var webhooks []entity.Webhook
+	err := conn(ctx, r.db).Where("event_id = ?", eventID).Order("id").Find(&webhooks).Error
+	return webhooks, err
+}
+
+func (r *webhookRepository) FindByID(ctx context.Context, id uint) (*entity.Webhook, error) {
+	var webhook entity.Webhook
+	err := conn(ctx, r.db).First(&webhook, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+func (r *webhookRepository) Create(ctx context.Context, webhook *entity.Webhook) error {
+	return conn(ctx, r.db).Create(webhook).Error
+}
+
+func (r *webhookRepository) Delete(ctx context.Context, id uint) error {
+	// webhook_deliveries and webhook_jobs rows are removed by ON DELETE CASCADE
+	return conn(ctx, r.db).Delete(&entity.Webhook{}, id).Error
+}
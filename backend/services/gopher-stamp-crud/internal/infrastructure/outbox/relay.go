@@ -0,0 +1,184 @@
+// Package outbox publishes the domain events recorded in the outbox table.
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+)
+
+const (
+	// DefaultInterval is how often the outbox is checked for pending messages.
+	DefaultInterval = time.Second
+	// DefaultBatchSize is how many pending messages are read at a time.
+	DefaultBatchSize = 100
+	// DefaultMaxAttempts is how many times a message is published before it is given up.
+	DefaultMaxAttempts = 10
+	// DefaultInitialBackoff is the wait before the first retry; it doubles with every further retry.
+	DefaultInitialBackoff = time.Second
+	// DefaultRetention is how long delivered and given-up messages are kept before they are purged.
+	DefaultRetention = 7 * 24 * time.Hour
+
+	// purgeInterval is how often the messages past their retention are purged.
+	purgeInterval = time.Hour
+	// maxBackoff caps the wait between retries.
+	maxBackoff = 10 * time.Minute
+	// maxErrorLength is the size of outbox.last_error.
+	maxErrorLength = 500
+)
+
+// Relay publishes pending outbox messages to every sink and then marks them delivered.
+//
+// Delivery is at least once: a message is published again if a sink fails or the server stops
+// before it is marked delivered. Messages are published in the order they were written, except
+// that a failed message is retried later, with exponential backoff, while the ones after it go
+// ahead. A message that still fails after maxAttempts is given up and left in the outbox with
+// failed_at set, so that one bad message cannot hold back the others. Delivered and given-up
+// messages are purged once they are older than the retention.
+type Relay struct {
+	outboxRepo     repository.OutboxRepository
+	sinks          []repository.OutboxSink
+	interval       time.Duration
+	batchSize      int
+	maxAttempts    int
+	initialBackoff time.Duration
+	retention      time.Duration
+	now            func() time.Time
+
+	// purgedAt is when the outbox was last purged; only Run touches it
+	purgedAt time.Time
+}
+
+func NewRelay(
+	outboxRepo repository.OutboxRepository,
+	sinks []repository.OutboxSink,
+	interval time.Duration,
+	batchSize int,
+	maxAttempts int,
+	initialBackoff time.Duration,
+	retention time.Duration,
+) *Relay {
+	return &Relay{
+		outboxRepo:     outboxRepo,
+		sinks:          sinks,
+		interval:       interval,
+		batchSize:      batchSize,
+		maxAttempts:    maxAttempts,
+		initialBackoff: initialBackoff,
+		retention:      retention,
+		now:            time.Now,
+	}
+}
+
+// Run publishes pending messages every interval until ctx is done.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.relayPending(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("outbox: failed to relay messages: %v", err)
+		}
+		if err := r.purge(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("outbox: failed to purge messages: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayPending publishes the pending messages, a batch at a time, until none are left.
+// Failed messages are scheduled for a retry; an error means the outbox itself could not be updated.
+func (r *Relay) relayPending(ctx context.Context) error {
+	for {
+		messages, err := r.outboxRepo.FindPending(ctx, r.now(), r.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for i := range messages {
+			message := &messages[i]
+			if err := r.publish(ctx, message); err != nil {
+				if ctx.Err() != nil {
+					// Shutting down is not the message's fault; it is published again on the next start
+					return ctx.Err()
+				}
+				if err := r.recordFailure(ctx, message, err); err != nil {
+					return fmt.Errorf("record failure of message %d: %w", message.ID, err)
+				}
+				continue
+			}
+			if err := r.outboxRepo.MarkDelivered(ctx, message.ID, r.now()); err != nil {
+				return fmt.Errorf("mark message %d delivered: %w", message.ID, err)
+			}
+		}
+
+		if len(messages) < r.batchSize {
+			return nil
+		}
+	}
+}
+
+// purge removes the messages delivered or given up more than the retention ago, at most once every purgeInterval.
+func (r *Relay) purge(ctx context.Context) error {
+	now := r.now()
+	if now.Sub(r.purgedAt) < purgeInterval {
+		return nil
+	}
+	if err := r.outboxRepo.DeleteFinishedBefore(ctx, now.Add(-r.retention)); err != nil {
+		return err
+	}
+	r.purgedAt = now
+	return nil
+}
+
+func (r *Relay) publish(ctx context.Context, message *entity.OutboxMessage) error {
+	for _, sink := range r.sinks {
+		if err := sink.Publish(ctx, *message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordFailure schedules the message for a retry, or gives it up once it has used up its attempts.
+func (r *Relay) recordFailure(ctx context.Context, message *entity.OutboxMessage, publishErr error) error {
+	message.Attempts++
+	msg := publishErr.Error()
+	if len(msg) > maxErrorLength {
+		msg = msg[:maxErrorLength]
+	}
+	message.LastError = &msg
+
+	now := r.now()
+	if message.Attempts >= r.maxAttempts {
+		log.Printf("outbox: gave up on message %d after %d attempts: %v", message.ID, message.Attempts, publishErr)
+		message.RetryAt = nil
+		message.FailedAt = &now
+	} else {
+		log.Printf("outbox: failed to publish message %d (attempt %d): %v", message.ID, message.Attempts, publishErr)
+		retryAt := now.Add(backoff(r.initialBackoff, message.Attempts))
+		message.RetryAt = &retryAt
+	}
+	return r.outboxRepo.RecordFailure(ctx, message)
+}
+
+// backoff returns the wait after the given failed attempt: initial, then twice as long each time, up to maxBackoff.
+func backoff(initial time.Duration, attempt int) time.Duration {
+	wait := initial
+	for range attempt - 1 {
+		wait *= 2
+		if wait >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return wait
+}
@@ -0,0 +1,284 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryOutboxRepository is an in-memory repository.OutboxRepository.
+type memoryOutboxRepository struct {
+	mu       sync.Mutex
+	nextID   uint
+	messages []entity.OutboxMessage
+}
+
+func (r *memoryOutboxRepository) Create(ctx context.Context, message *entity.OutboxMessage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	message.ID = r.nextID
+	r.messages = append(r.messages, *message)
+	return nil
+}
+
+func (r *memoryOutboxRepository) FindPending(ctx context.Context, now time.Time, limit int) ([]entity.OutboxMessage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var pending []entity.OutboxMessage
+	for _, message := range r.messages {
+		waiting := message.RetryAt != nil && message.RetryAt.After(now)
+		if message.DeliveredAt == nil && message.FailedAt == nil && !waiting && len(pending) < limit {
+			pending = append(pending, message)
+		}
+	}
+	return pending, nil
+}
+
+func (r *memoryOutboxRepository) MarkDelivered(ctx context.Context, id uint, deliveredAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.find(id).DeliveredAt = &deliveredAt
+	return nil
+}
+
+func (r *memoryOutboxRepository) RecordFailure(ctx context.Context, message *entity.OutboxMessage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := r.find(message.ID)
+	stored.Attempts = message.Attempts
+	stored.LastError = message.LastError
+	stored.RetryAt = message.RetryAt
+	stored.FailedAt = message.FailedAt
+	return nil
+}
+
+func (r *memoryOutboxRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages = slices.DeleteFunc(r.messages, func(message entity.OutboxMessage) bool { return message.UserID == userID })
+	return nil
+}
+
+func (r *memoryOutboxRepository) DeleteFinishedBefore(ctx context.Context, before time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages = slices.DeleteFunc(r.messages, func(message entity.OutboxMessage) bool {
+		return (message.DeliveredAt != nil && message.DeliveredAt.Before(before)) ||
+			(message.FailedAt != nil && message.FailedAt.Before(before))
+	})
+	return nil
+}
+
+// find returns the stored message with the ID; the caller must hold mu.
+func (r *memoryOutboxRepository) find(id uint) *entity.OutboxMessage {
+	return &r.messages[slices.IndexFunc(r.messages, func(message entity.OutboxMessage) bool { return message.ID == id })]
+}
+
+func (r *memoryOutboxRepository) message(id uint) entity.OutboxMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return *r.find(id)
+}
+
+func (r *memoryOutboxRepository) ids() []uint {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ids []uint
+	for _, message := range r.messages {
+		ids = append(ids, message.ID)
+	}
+	return ids
+}
+
+// pending counts the messages neither delivered nor given up.
+func (r *memoryOutboxRepository) pending() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pending := 0
+	for _, message := range r.messages {
+		if message.DeliveredAt == nil && message.FailedAt == nil {
+			pending++
+		}
+	}
+	return pending
+}
+
+// recordingSink remembers the IDs of the messages published to it. fail, when set, decides
+// whether publishing a message fails.
+type recordingSink struct {
+	mu   sync.Mutex
+	ids  []uint
+	fail func(message entity.OutboxMessage) bool
+}
+
+func (s *recordingSink) Publish(ctx context.Context, message entity.OutboxMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ids = append(s.ids, message.ID)
+	if s.fail != nil && s.fail(message) {
+		return errors.New("sink unavailable")
+	}
+	return nil
+}
+
+func (s *recordingSink) published() []uint {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]uint(nil), s.ids...)
+}
+
+func newTestOutbox(t *testing.T, count int) *memoryOutboxRepository {
+	repo := &memoryOutboxRepository{}
+	for range count {
+		require.NoError(t, repo.Create(context.Background(), &entity.OutboxMessage{Type: entity.OutboxStampAcquired, Payload: "{}"}))
+	}
+	return repo
+}
+
+func TestRelay_PublishesPendingMessages(t *testing.T) {
+	repo := newTestOutbox(t, 5)
+	first, second := &recordingSink{}, &recordingSink{}
+	// A batch smaller than the backlog makes the relay read the outbox several times
+	relay := NewRelay(repo, []repository.OutboxSink{first, second}, time.Hour, 2, DefaultMaxAttempts, time.Minute, DefaultRetention)
+
+	require.NoError(t, relay.relayPending(context.Background()))
+
+	assert.Equal(t, []uint{1, 2, 3, 4, 5}, first.published())
+	assert.Equal(t, []uint{1, 2, 3, 4, 5}, second.published())
+	assert.Equal(t, 0, repo.pending())
+
+	// Delivered messages are not published again
+	require.NoError(t, relay.relayPending(context.Background()))
+	assert.Len(t, first.published(), 5)
+}
+
+func TestRelay_RetriesAfterSinkFailure(t *testing.T) {
+	repo := newTestOutbox(t, 3)
+	failing := true
+	first := &recordingSink{}
+	second := &recordingSink{fail: func(message entity.OutboxMessage) bool { return failing && message.ID == 2 }}
+	relay := NewRelay(repo, []repository.OutboxSink{first, second}, time.Hour, DefaultBatchSize, DefaultMaxAttempts, time.Minute, DefaultRetention)
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	relay.now = func() time.Time { return now }
+
+	// The failed message waits for a retry while the ones after it go ahead
+	require.NoError(t, relay.relayPending(context.Background()))
+	assert.Equal(t, []uint{1, 2, 3}, first.published())
+	assert.Equal(t, []uint{1, 2, 3}, second.published())
+	assert.Equal(t, 1, repo.pending())
+
+	failed := repo.message(2)
+	assert.Equal(t, 1, failed.Attempts)
+	if assert.NotNil(t, failed.LastError) {
+		assert.Equal(t, "sink unavailable", *failed.LastError)
+	}
+	if assert.NotNil(t, failed.RetryAt) {
+		assert.Equal(t, now.Add(time.Minute), *failed.RetryAt)
+	}
+
+	// It is not published again before the retry is due
+	require.NoError(t, relay.relayPending(context.Background()))
+	assert.Len(t, first.published(), 3)
+
+	// Once the sink recovers it is published, again to every sink
+	failing = false
+	now = now.Add(time.Minute)
+	require.NoError(t, relay.relayPending(context.Background()))
+	assert.Equal(t, []uint{1, 2, 3, 2}, first.published())
+	assert.Equal(t, []uint{1, 2, 3, 2}, second.published())
+	assert.Equal(t, 0, repo.pending())
+}
+
+func TestRelay_GivesUpAfterMaxAttempts(t *testing.T) {
+	repo := newTestOutbox(t, 2)
+	sink := &recordingSink{fail: func(message entity.OutboxMessage) bool { return message.ID == 1 }}
+	relay := NewRelay(repo, []repository.OutboxSink{sink}, time.Hour, DefaultBatchSize, 3, time.Minute, DefaultRetention)
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	relay.now = func() time.Time { return now }
+
+	for range 3 {
+		require.NoError(t, relay.relayPending(context.Background()))
+		now = now.Add(time.Hour)
+	}
+	assert.Equal(t, []uint{1, 2, 1, 1}, sink.published())
+
+	poison := repo.message(1)
+	assert.Equal(t, 3, poison.Attempts)
+	assert.Nil(t, poison.RetryAt)
+	assert.NotNil(t, poison.FailedAt)
+	assert.Nil(t, poison.DeliveredAt)
+	assert.Equal(t, 0, repo.pending())
+
+	// A message given up is not published any more
+	require.NoError(t, relay.relayPending(context.Background()))
+	assert.Len(t, sink.published(), 4)
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, backoff(time.Second, 1))
+	assert.Equal(t, 4*time.Second, backoff(time.Second, 3))
+	assert.Equal(t, maxBackoff, backoff(time.Second, 30))
+}
+
+func TestRelay_Purge(t *testing.T) {
+	repo := newTestOutbox(t, 2)
+	sink := &recordingSink{fail: func(message entity.OutboxMessage) bool { return message.ID == 2 }}
+	relay := NewRelay(repo, []repository.OutboxSink{sink}, time.Hour, DefaultBatchSize, 1, time.Minute, 24*time.Hour)
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	relay.now = func() time.Time { return now }
+
+	// Message 1 is delivered, message 2 given up and message 3 still pending
+	require.NoError(t, relay.relayPending(context.Background()))
+	require.NoError(t, repo.Create(context.Background(), &entity.OutboxMessage{Type: entity.OutboxStampAcquired, Payload: "{}"}))
+
+	// Finished messages are kept for the retention
+	now = now.Add(24 * time.Hour)
+	require.NoError(t, relay.purge(context.Background()))
+	assert.Equal(t, []uint{1, 2, 3}, repo.ids())
+
+	// The outbox is purged at most once every purgeInterval
+	now = now.Add(purgeInterval / 2)
+	require.NoError(t, relay.purge(context.Background()))
+	assert.Equal(t, []uint{1, 2, 3}, repo.ids())
+
+	// Past the retention finished messages are purged, while pending ones stay
+	now = now.Add(purgeInterval / 2)
+	require.NoError(t, relay.purge(context.Background()))
+	assert.Equal(t, []uint{3}, repo.ids())
+}
+
+func TestRelay_Run(t *testing.T) {
+	repo := newTestOutbox(t, 1)
+	sink := &recordingSink{}
+	relay := NewRelay(repo, []repository.OutboxSink{sink}, time.Millisecond, DefaultBatchSize, DefaultMaxAttempts, DefaultInitialBackoff, DefaultRetention)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		relay.Run(ctx)
+		close(done)
+	}()
+
+	// Pending messages are published on start, and ones written later on a following check
+	require.Eventually(t, func() bool { return repo.pending() == 0 }, 5*time.Second, time.Millisecond)
+	require.NoError(t, repo.Create(context.Background(), &entity.OutboxMessage{Type: entity.OutboxStampAcquired, Payload: "{}"}))
+	require.Eventually(t, func() bool { return repo.pending() == 0 }, 5*time.Second, time.Millisecond)
+	assert.Equal(t, []uint{1, 2}, sink.published())
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after the context was canceled")
+	}
+}
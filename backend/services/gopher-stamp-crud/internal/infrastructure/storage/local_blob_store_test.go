@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := NewLocalBlobStore(dir, "http://localhost:8080/blobs/")
+	require.NoError(t, err)
+
+	url, err := store.Put(ctx, "icons/1/abc.png", "image/png", []byte("first"))
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/blobs/icons/1/abc.png", url)
+
+	// Put replaces an existing blob
+	_, err = store.Put(ctx, "icons/1/abc.png", "image/png", []byte("second"))
+	require.NoError(t, err)
+	data, err := os.ReadFile(filepath.Join(dir, "icons", "1", "abc.png"))
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(data))
+
+	key, ok := store.KeyForURL(url)
+	assert.True(t, ok)
+	assert.Equal(t, "icons/1/abc.png", key)
+
+	require.NoError(t, store.Delete(ctx, key))
+	_, err = os.Stat(filepath.Join(dir, "icons", "1", "abc.png"))
+	assert.True(t, os.IsNotExist(err))
+
+	// Deleting again is not an error
+	assert.NoError(t, store.Delete(ctx, key))
+
+	// No temporary files are left behind
+	entries, err := os.ReadDir(filepath.Join(dir, "icons", "1"))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestLocalBlobStore_InvalidKeys(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalBlobStore(t.TempDir(), "http://localhost:8080/blobs")
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "../escape.png", "icons/../../escape.png", "/etc/passwd", "icons//a.png", `icons\a.png`} {
+		_, err := store.Put(ctx, key, "image/png", []byte("x"))
+		assert.Error(t, err, key)
+		assert.Error(t, store.Delete(ctx, key), key)
+	}
+
+	for _, url := range []string{"https://example.com/a.png", "http://localhost:8080/blobs/../a.png", "data:image/png;base64,AAAA"} {
+		_, ok := store.KeyForURL(url)
+		assert.False(t, ok, url)
+	}
+}
@@ -0,0 +1,330 @@
+// Package webhook delivers stamp rally events to organizers' webhooks over HTTP.
+//
+// Every request is a POST of the JSON-encoded entity.WebhookEvent carrying these headers:
+//
+//	X-Webhook-Id         the event's ID, the same across retries
+//	X-Webhook-Event      the event type, e.g. "stamp.acquired"
+//	X-Webhook-Timestamp  Unix time of the attempt in seconds
+//	X-Webhook-Signature  "sha256=" + hex HMAC-SHA256 of "<timestamp>.<body>" keyed with the webhook's secret
+//
+// Receivers should verify the signature, reject stale timestamps and ignore IDs they have already handled.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+)
+
+const (
+	// DefaultMaxAttempts is how many times an event is sent to a webhook before giving up.
+	DefaultMaxAttempts = 5
+	// DefaultInitialBackoff is the wait before the first retry; it doubles with every further retry.
+	DefaultInitialBackoff = 2 * time.Second
+	// DefaultTimeout bounds a single request, so an unresponsive receiver cannot hold a delivery slot.
+	DefaultTimeout = 10 * time.Second
+	// DefaultInterval is how often the pending deliveries are checked for ones that are due.
+	DefaultInterval = time.Second
+	// DefaultRetention is how long the delivery log is kept before it is purged.
+	DefaultRetention = 30 * 24 * time.Hour
+
+	// maxBackoff caps the wait between retries.
+	maxBackoff = 5 * time.Minute
+	// maxConcurrentRequests bounds the requests in flight across all webhooks.
+	maxConcurrentRequests = 16
+	// maxErrorLength is the size of webhook_deliveries.error.
+	maxErrorLength = 500
+	// claimLease is how long a claimed delivery is kept from other dispatchers. It must outlast an
+	// attempt, request timeout included; a delivery left behind by a stopped server is resumed after it.
+	claimLease = time.Minute
+	// purgeInterval is how often the delivery log is purged of attempts past their retention.
+	purgeInterval = time.Hour
+)
+
+// Dispatcher is a repository.WebhookDispatcher that stores deliveries in the webhook_jobs table
+// and sends them from Run, retrying failed ones with exponential backoff. Pending deliveries
+// survive a restart, and every attempt is recorded in the delivery log, which is kept for the retention.
+type Dispatcher struct {
+	webhookRepo    repository.WebhookRepository
+	jobRepo        repository.WebhookJobRepository
+	deliveryRepo   repository.WebhookDeliveryRepository
+	client         *http.Client
+	maxAttempts    int
+	initialBackoff time.Duration
+	interval       time.Duration
+	retention      time.Duration
+	now            func() time.Time
+
+	// requests limits the requests in flight; waiting for a retry does not take a slot
+	requests chan struct{}
+	// wake tells Run that a delivery was dispatched, so it is sent without waiting for the next check
+	wake chan struct{}
+	// purgedAt is when the delivery log was last purged; only Run touches it
+	purgedAt time.Time
+}
+
+func NewDispatcher(
+	webhookRepo repository.WebhookRepository,
+	jobRepo repository.WebhookJobRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+	client *http.Client,
+	maxAttempts int,
+	initialBackoff time.Duration,
+	interval time.Duration,
+	retention time.Duration,
+) *Dispatcher {
+	return &Dispatcher{
+		webhookRepo:    webhookRepo,
+		jobRepo:        jobRepo,
+		deliveryRepo:   deliveryRepo,
+		client:         client,
+		maxAttempts:    maxAttempts,
+		initialBackoff: initialBackoff,
+		interval:       interval,
+		retention:      retention,
+		now:            time.Now,
+		requests:       make(chan struct{}, maxConcurrentRequests),
+		wake:           make(chan struct{}, 1),
+	}
+}
+
+// Dispatch stores a delivery of the event for every webhook subscribed to it. Called in a
+// transaction, the deliveries are made only if the transaction commits.
+func (d *Dispatcher) Dispatch(ctx context.Context, event entity.WebhookEvent) error {
+	webhooks, err := d.webhookRepo.FindByEventID(ctx, event.EventID)
+	if err != nil {
+		return fmt.Errorf("failed to find webhooks of event %d: %w", event.EventID, err)
+	}
+
+	if event.ID == "" {
+		if event.ID, err = newEventID(); err != nil {
+			return fmt.Errorf("failed to generate event ID: %w", err)
+		}
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", event.Type, err)
+	}
+
+	var jobs []entity.WebhookJob
+	for _, webhook := range webhooks {
+		if !webhook.Subscribes(event.Type) {
+			continue
+		}
+		jobs = append(jobs, entity.WebhookJob{
+			WebhookID:     webhook.ID,
+			MessageID:     event.ID,
+			EventType:     event.Type,
+			UserID:        event.UserID,
+			Payload:       string(payload),
+			NextAttemptAt: d.now(),
+		})
+	}
+	if len(jobs) == 0 {
+		return nil
+	}
+	if err := d.jobRepo.Create(ctx, jobs); err != nil {
+		return fmt.Errorf("failed to store deliveries of %s event: %w", event.Type, err)
+	}
+
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Run sends the stored deliveries as they fall due until ctx is done, then waits for the requests
+// in flight to finish. Deliveries still pending are resumed by the next Run, on this server or another.
+func (d *Dispatcher) Run(ctx context.Context) {
+	var inFlight sync.WaitGroup
+	defer inFlight.Wait()
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.sendDue(ctx, &inFlight); err != nil && ctx.Err() == nil {
+			log.Printf("webhook: failed to claim deliveries: %v", err)
+		}
+		if err := d.purge(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("webhook: failed to purge the delivery log: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+// sendDue claims due deliveries while request slots are free and sends each in the background.
+func (d *Dispatcher) sendDue(ctx context.Context, inFlight *sync.WaitGroup) error {
+	for {
+		// Only this goroutine takes slots, so the free ones can only grow until they are taken below
+		free := cap(d.requests) - len(d.requests)
+		if free == 0 {
+			return nil
+		}
+		now := d.now()
+		jobs, err := d.jobRepo.ClaimDue(ctx, now, now.Add(claimLease), free)
+		if err != nil {
+			return err
+		}
+
+		for i := range jobs {
+			job := &jobs[i]
+			d.requests <- struct{}{}
+			inFlight.Add(1)
+			go func() {
+				defer inFlight.Done()
+				defer func() { <-d.requests }()
+				d.send(ctx, job)
+			}()
+		}
+
+		if len(jobs) < free {
+			return nil
+		}
+	}
+}
+
+// purge removes the delivery attempts made more than the retention ago, at most once every purgeInterval.
+func (d *Dispatcher) purge(ctx context.Context) error {
+	now := d.now()
+	if now.Sub(d.purgedAt) < purgeInterval {
+		return nil
+	}
+	if err := d.deliveryRepo.DeleteBefore(ctx, now.Add(-d.retention)); err != nil {
+		return err
+	}
+	d.purgedAt = now
+	return nil
+}
+
+// send makes one attempt at the delivery and records it, then removes the delivery once it is
+// accepted, fails permanently or runs out of attempts, and otherwise schedules a retry.
+func (d *Dispatcher) send(ctx context.Context, job *entity.WebhookJob) {
+	// A request already on its way is allowed to finish on shutdown; the client's timeout bounds it
+	ctx = context.WithoutCancel(ctx)
+
+	delivery := d.attempt(ctx, job)
+	delivery.Attempt = job.Attempts + 1
+	if err := d.deliveryRepo.Create(ctx, delivery); err != nil {
+		log.Printf("webhook: failed to record delivery to webhook %d: %v", job.WebhookID, err)
+	}
+
+	var err error
+	if delivery.Succeeded || !retryable(delivery.StatusCode) || delivery.Attempt >= d.maxAttempts {
+		err = d.jobRepo.Delete(ctx, job.ID)
+	} else {
+		err = d.jobRepo.Reschedule(ctx, job.ID, delivery.Attempt, d.now().Add(backoff(d.initialBackoff, delivery.Attempt)))
+	}
+	// The delivery is then attempted again once its claim expires
+	if err != nil {
+		log.Printf("webhook: failed to update pending delivery %d: %v", job.ID, err)
+	}
+}
+
+// attempt sends one request and describes its outcome. Attempt is left for the caller to set.
+func (d *Dispatcher) attempt(ctx context.Context, job *entity.WebhookJob) *entity.WebhookDelivery {
+	delivery := &entity.WebhookDelivery{
+		WebhookID:   job.WebhookID,
+		EventType:   job.EventType,
+		UserID:      job.UserID,
+		Payload:     job.Payload,
+		AttemptedAt: d.now(),
+	}
+	fail := func(err error) *entity.WebhookDelivery {
+		msg := err.Error()
+		if len(msg) > maxErrorLength {
+			msg = msg[:maxErrorLength]
+		}
+		delivery.Error = &msg
+		return delivery
+	}
+
+	payload := []byte(job.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.Webhook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return fail(err)
+	}
+	timestamp := strconv.FormatInt(delivery.AttemptedAt.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "gopher-stamp-rally-webhook")
+	req.Header.Set("X-Webhook-Id", job.MessageID)
+	req.Header.Set("X-Webhook-Event", string(job.EventType))
+	req.Header.Set("X-Webhook-Timestamp", timestamp)
+	req.Header.Set("X-Webhook-Signature", Sign(job.Webhook.Secret, timestamp, payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fail(err)
+	}
+	defer resp.Body.Close()
+	// Drain a bounded amount of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	statusCode := resp.StatusCode
+	delivery.StatusCode = &statusCode
+	if statusCode < 200 || statusCode > 299 {
+		return fail(fmt.Errorf("unexpected status %s", resp.Status))
+	}
+	delivery.Succeeded = true
+	return delivery
+}
+
+// Sign returns the X-Webhook-Signature of a request with the timestamp and body.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// retryable reports whether a failed attempt may succeed later. Requests that got no response,
+// server errors, timeouts and rate limiting are retried; other client errors will not go away.
+func retryable(statusCode *int) bool {
+	if statusCode == nil {
+		return true
+	}
+	code := *statusCode
+	return code >= 500 || code == http.StatusRequestTimeout || code == http.StatusTooManyRequests
+}
+
+// backoff returns the wait after the given failed attempt: initial, then twice as long each time, up to maxBackoff.
+func backoff(initial time.Duration, attempt int) time.Duration {
+	wait := initial
+	for range attempt - 1 {
+		wait *= 2
+		if wait >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return wait
+}
+
+func newEventID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,480 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	mock "2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/mock_repository"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deliveryLog collects the attempts the dispatcher records.
+type deliveryLog struct {
+	mu         sync.Mutex
+	deliveries []entity.WebhookDelivery
+}
+
+func (l *deliveryLog) record(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.deliveries = append(l.deliveries, *delivery)
+	return nil
+}
+
+func (l *deliveryLog) snapshot() []entity.WebhookDelivery {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]entity.WebhookDelivery(nil), l.deliveries...)
+}
+
+// memoryJobRepository is an in-memory repository.WebhookJobRepository over a fixed set of webhooks.
+type memoryJobRepository struct {
+	mu       sync.Mutex
+	webhooks map[uint]entity.Webhook
+	jobs     []entity.WebhookJob
+	nextID   uint
+}
+
+func newMemoryJobRepository(webhooks []entity.Webhook) *memoryJobRepository {
+	r := &memoryJobRepository{webhooks: map[uint]entity.Webhook{}}
+	for _, webhook := range webhooks {
+		r.webhooks[webhook.ID] = webhook
+	}
+	return r
+}
+
+func (r *memoryJobRepository) Create(ctx context.Context, jobs []entity.WebhookJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, job := range jobs {
+		if slices.ContainsFunc(r.jobs, func(j entity.WebhookJob) bool {
+			return j.WebhookID == job.WebhookID && j.MessageID == job.MessageID
+		}) {
+			continue
+		}
+		r.nextID++
+		job.ID = r.nextID
+		r.jobs = append(r.jobs, job)
+	}
+	return nil
+}
+
+func (r *memoryJobRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]entity.WebhookJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var due []entity.WebhookJob
+	for i := range r.jobs {
+		job := &r.jobs[i]
+		if job.NextAttemptAt.After(now) || len(due) == limit {
+			continue
+		}
+		job.NextAttemptAt = leaseUntil
+		claimed := *job
+		claimed.Webhook = r.webhooks[job.WebhookID]
+		due = append(due, claimed)
+	}
+	return due, nil
+}
+
+func (r *memoryJobRepository) Reschedule(ctx context.Context, id uint, attempts int, nextAttemptAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.jobs {
+		if r.jobs[i].ID == id {
+			r.jobs[i].Attempts = attempts
+			r.jobs[i].NextAttemptAt = nextAttemptAt
+		}
+	}
+	return nil
+}
+
+func (r *memoryJobRepository) Delete(ctx context.Context, id uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobs = slices.DeleteFunc(r.jobs, func(job entity.WebhookJob) bool { return job.ID == id })
+	return nil
+}
+
+func (r *memoryJobRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobs = slices.DeleteFunc(r.jobs, func(job entity.WebhookJob) bool { return job.UserID == userID })
+	return nil
+}
+
+func (r *memoryJobRepository) snapshot() []entity.WebhookJob {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.jobs)
+}
+
+func newTestDispatcher(t *testing.T, webhooks []entity.Webhook, maxAttempts int, initialBackoff time.Duration) (*Dispatcher, *memoryJobRepository, *deliveryLog) {
+	ctrl := gomock.NewController(t)
+	mockWebhookRepo := mock.NewMockWebhookRepository(ctrl)
+	mockDeliveryRepo := mock.NewMockWebhookDeliveryRepository(ctrl)
+
+	mockWebhookRepo.EXPECT().
+		FindByEventID(gomock.Any(), uint(1)).
+		Return(webhooks, nil).
+		AnyTimes()
+	log := &deliveryLog{}
+	mockDeliveryRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(log.record).
+		AnyTimes()
+	mockDeliveryRepo.EXPECT().
+		DeleteBefore(gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+
+	jobs := newMemoryJobRepository(webhooks)
+	d := NewDispatcher(mockWebhookRepo, jobs, mockDeliveryRepo, &http.Client{Timeout: time.Second}, maxAttempts, initialBackoff, time.Millisecond, DefaultRetention)
+	return d, jobs, log
+}
+
+// run starts d.Run and returns a function that stops it and reports whether Run returned in time.
+func run(t *testing.T, d *Dispatcher) (stop func() bool) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		d.Run(ctx)
+		close(done)
+	}()
+
+	stop = func() bool {
+		cancel()
+		select {
+		case <-done:
+			return true
+		case <-time.After(5 * time.Second):
+			return false
+		}
+	}
+	t.Cleanup(func() { stop() })
+	return stop
+}
+
+func TestDispatcher_SignedDelivery(t *testing.T) {
+	type request struct {
+		header http.Header
+		body   []byte
+	}
+	requests := make(chan request, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- request{header: r.Header, body: body}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	d, jobs, log := newTestDispatcher(t, []entity.Webhook{
+		{ID: 1, EventID: 1, URL: server.URL, Secret: "s3cret", EventTypes: []entity.WebhookEventType{entity.WebhookStampAcquired}},
+		// Not subscribed to acquisitions, so never called
+		{ID: 2, EventID: 1, URL: server.URL, Secret: "other", EventTypes: []entity.WebhookEventType{entity.WebhookRallyCompleted}},
+	}, DefaultMaxAttempts, time.Millisecond)
+
+	occurredAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, d.Dispatch(context.Background(), entity.WebhookEvent{
+		Type:       entity.WebhookStampAcquired,
+		EventID:    1,
+		UserID:     7,
+		UserName:   "Gopher",
+		StampID:    3,
+		StampName:  "Gopher Wall2",
+		StampCount: 2,
+		OccurredAt: occurredAt,
+	}))
+
+	// Dispatch only stores the delivery; Run sends it
+	stored := jobs.snapshot()
+	require.Len(t, stored, 1)
+	assert.Equal(t, uint(1), stored[0].WebhookID)
+	assert.Equal(t, uint(7), stored[0].UserID)
+	assert.Equal(t, 0, stored[0].Attempts)
+	run(t, d)
+
+	var got request
+	select {
+	case got = <-requests:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the webhook was not called")
+	}
+
+	timestamp := got.header.Get("X-Webhook-Timestamp")
+	assert.Equal(t, Sign("s3cret", timestamp, got.body), got.header.Get("X-Webhook-Signature"))
+	assert.Equal(t, "stamp.acquired", got.header.Get("X-Webhook-Event"))
+	assert.Equal(t, "application/json", got.header.Get("Content-Type"))
+
+	var event entity.WebhookEvent
+	require.NoError(t, json.Unmarshal(got.body, &event))
+	assert.Equal(t, got.header.Get("X-Webhook-Id"), event.ID)
+	assert.Equal(t, stored[0].MessageID, event.ID)
+	assert.Len(t, event.ID, 32)
+	assert.Equal(t, entity.WebhookEvent{
+		ID:         event.ID,
+		Type:       entity.WebhookStampAcquired,
+		EventID:    1,
+		UserID:     7,
+		UserName:   "Gopher",
+		StampID:    3,
+		StampName:  "Gopher Wall2",
+		StampCount: 2,
+		OccurredAt: occurredAt,
+	}, event)
+
+	require.Eventually(t, func() bool { return len(log.snapshot()) == 1 && len(jobs.snapshot()) == 0 }, 5*time.Second, time.Millisecond)
+	delivery := log.snapshot()[0]
+	assert.Equal(t, uint(1), delivery.WebhookID)
+	assert.Equal(t, uint(7), delivery.UserID)
+	assert.Equal(t, 1, delivery.Attempt)
+	assert.True(t, delivery.Succeeded)
+	if assert.NotNil(t, delivery.StatusCode) {
+		assert.Equal(t, http.StatusNoContent, *delivery.StatusCode)
+	}
+	assert.Nil(t, delivery.Error)
+	assert.JSONEq(t, string(got.body), delivery.Payload)
+}
+
+func TestDispatcher_Retries(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []int // responses in order; the last one repeats
+		wantAttempts int
+		wantSuccess  bool
+	}{
+		{name: "succeeds after server errors", statuses: []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK}, wantAttempts: 3, wantSuccess: true},
+		{name: "retries rate limiting", statuses: []int{http.StatusTooManyRequests, http.StatusOK}, wantAttempts: 2, wantSuccess: true},
+		{name: "gives up after max attempts", statuses: []int{http.StatusServiceUnavailable}, wantAttempts: 4},
+		{name: "does not retry client errors", statuses: []int{http.StatusBadRequest}, wantAttempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			var ids sync.Map
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(calls.Add(1))
+				ids.Store(r.Header.Get("X-Webhook-Id"), true)
+				w.WriteHeader(tt.statuses[min(n, len(tt.statuses))-1])
+			}))
+			defer server.Close()
+
+			d, jobs, log := newTestDispatcher(t, []entity.Webhook{
+				{ID: 1, EventID: 1, URL: server.URL, Secret: "s3cret", EventTypes: entity.WebhookEventTypes},
+			}, 4, time.Millisecond)
+			require.NoError(t, d.Dispatch(context.Background(), entity.WebhookEvent{Type: entity.WebhookUserRegistered, EventID: 1, UserID: 7}))
+			run(t, d)
+
+			// The delivery is removed once it succeeds or is given up
+			require.Eventually(t, func() bool { return len(jobs.snapshot()) == 0 }, 5*time.Second, time.Millisecond)
+			// Wait for anything that should not happen, such as a further retry
+			time.Sleep(20 * time.Millisecond)
+
+			deliveries := log.snapshot()
+			require.Len(t, deliveries, tt.wantAttempts)
+			for i, delivery := range deliveries {
+				assert.Equal(t, i+1, delivery.Attempt)
+				assert.Equal(t, tt.wantSuccess && i == len(deliveries)-1, delivery.Succeeded)
+			}
+			assert.Equal(t, int32(tt.wantAttempts), calls.Load())
+
+			idCount := 0
+			ids.Range(func(_, _ any) bool { idCount++; return true })
+			assert.Equal(t, 1, idCount, "retries carry the same event ID")
+		})
+	}
+}
+
+func TestDispatcher_UnreachableWebhook(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	d, jobs, log := newTestDispatcher(t, []entity.Webhook{
+		{ID: 1, EventID: 1, URL: url, EventTypes: entity.WebhookEventTypes},
+	}, 2, time.Millisecond)
+	require.NoError(t, d.Dispatch(context.Background(), entity.WebhookEvent{Type: entity.WebhookUserRegistered, EventID: 1}))
+	run(t, d)
+
+	require.Eventually(t, func() bool { return len(log.snapshot()) == 2 && len(jobs.snapshot()) == 0 }, 5*time.Second, time.Millisecond)
+	for _, delivery := range log.snapshot() {
+		assert.False(t, delivery.Succeeded)
+		assert.Nil(t, delivery.StatusCode)
+		assert.NotNil(t, delivery.Error)
+	}
+}
+
+func TestDispatcher_KeepsEventID(t *testing.T) {
+	ids := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids <- r.Header.Get("X-Webhook-Id")
+	}))
+	defer server.Close()
+
+	d, jobs, _ := newTestDispatcher(t, []entity.Webhook{
+		{ID: 1, EventID: 1, URL: server.URL, EventTypes: entity.WebhookEventTypes},
+	}, DefaultMaxAttempts, time.Millisecond)
+	event := entity.WebhookEvent{ID: "stamp.acquired-42", Type: entity.WebhookStampAcquired, EventID: 1}
+	require.NoError(t, d.Dispatch(context.Background(), event))
+	// Dispatching the same event again, as the outbox relay may, stores no second delivery
+	require.NoError(t, d.Dispatch(context.Background(), event))
+	assert.Len(t, jobs.snapshot(), 1)
+	run(t, d)
+
+	select {
+	case id := <-ids:
+		assert.Equal(t, "stamp.acquired-42", id)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the webhook was not called")
+	}
+}
+
+func TestDispatcher_DispatchError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockWebhookRepo := mock.NewMockWebhookRepository(ctrl)
+	mockDeliveryRepo := mock.NewMockWebhookDeliveryRepository(ctrl)
+	mockWebhookRepo.EXPECT().
+		FindByEventID(gomock.Any(), uint(1)).
+		Return(nil, assert.AnError)
+
+	jobs := newMemoryJobRepository(nil)
+	d := NewDispatcher(mockWebhookRepo, jobs, mockDeliveryRepo, http.DefaultClient, DefaultMaxAttempts, time.Millisecond, time.Millisecond, DefaultRetention)
+	assert.ErrorIs(t, d.Dispatch(context.Background(), entity.WebhookEvent{Type: entity.WebhookUserRegistered, EventID: 1}), assert.AnError)
+	assert.Empty(t, jobs.snapshot())
+}
+
+func TestDispatcher_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDeliveryRepo := mock.NewMockWebhookDeliveryRepository(ctrl)
+	d := NewDispatcher(mock.NewMockWebhookRepository(ctrl), newMemoryJobRepository(nil), mockDeliveryRepo, http.DefaultClient, DefaultMaxAttempts, time.Millisecond, time.Millisecond, 24*time.Hour)
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	d.now = func() time.Time { return now }
+
+	// Attempts older than the retention are purged, at most once every purgeInterval
+	mockDeliveryRepo.EXPECT().DeleteBefore(gomock.Any(), now.Add(-24*time.Hour)).Return(nil)
+	require.NoError(t, d.purge(context.Background()))
+	now = now.Add(purgeInterval / 2)
+	require.NoError(t, d.purge(context.Background()))
+
+	mockDeliveryRepo.EXPECT().DeleteBefore(gomock.Any(), now.Add(purgeInterval/2-24*time.Hour)).Return(assert.AnError)
+	now = now.Add(purgeInterval / 2)
+	assert.ErrorIs(t, d.purge(context.Background()), assert.AnError)
+
+	// A failed purge is tried again on the next check
+	mockDeliveryRepo.EXPECT().DeleteBefore(gomock.Any(), now.Add(-24*time.Hour)).Return(nil)
+	require.NoError(t, d.purge(context.Background()))
+}
+
+func TestDispatcher_ResumesStoredDeliveries(t *testing.T) {
+	requests := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- r.Header.Get("X-Webhook-Id")
+	}))
+	defer server.Close()
+
+	d, jobs, log := newTestDispatcher(t, []entity.Webhook{
+		{ID: 1, EventID: 1, URL: server.URL, EventTypes: entity.WebhookEventTypes},
+	}, DefaultMaxAttempts, time.Millisecond)
+	// Stored before a restart, after two failed attempts
+	require.NoError(t, jobs.Create(context.Background(), []entity.WebhookJob{{
+		WebhookID:     1,
+		MessageID:     "user.registered-1",
+		EventType:     entity.WebhookUserRegistered,
+		Payload:       `{"id":"user.registered-1"}`,
+		Attempts:      2,
+		NextAttemptAt: time.Now(),
+	}}))
+	run(t, d)
+
+	select {
+	case id := <-requests:
+		assert.Equal(t, "user.registered-1", id)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the stored delivery was not sent")
+	}
+	require.Eventually(t, func() bool { return len(jobs.snapshot()) == 0 }, 5*time.Second, time.Millisecond)
+	deliveries := log.snapshot()
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, 3, deliveries[0].Attempt)
+	assert.True(t, deliveries[0].Succeeded)
+}
+
+func TestDispatcher_StopKeepsPendingRetry(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	// The retry would come an hour later; stopping must not wait for it
+	d, jobs, log := newTestDispatcher(t, []entity.Webhook{
+		{ID: 1, EventID: 1, URL: server.URL, EventTypes: entity.WebhookEventTypes},
+	}, DefaultMaxAttempts, time.Hour)
+	require.NoError(t, d.Dispatch(context.Background(), entity.WebhookEvent{Type: entity.WebhookUserRegistered, EventID: 1}))
+	stop := run(t, d)
+
+	require.Eventually(t, func() bool {
+		stored := jobs.snapshot()
+		return len(stored) == 1 && stored[0].Attempts == 1
+	}, 5*time.Second, time.Millisecond)
+	require.True(t, stop(), "Run did not return while a retry was pending")
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Len(t, log.snapshot(), 1)
+
+	// The retry stays stored for the next start
+	stored := jobs.snapshot()
+	require.Len(t, stored, 1)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), stored[0].NextAttemptAt, time.Minute)
+}
+
+func TestDispatcher_StopWaitsForRequestInFlight(t *testing.T) {
+	received := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(received)
+		<-release
+	}))
+	defer server.Close()
+
+	d, jobs, log := newTestDispatcher(t, []entity.Webhook{
+		{ID: 1, EventID: 1, URL: server.URL, EventTypes: entity.WebhookEventTypes},
+	}, DefaultMaxAttempts, time.Millisecond)
+	require.NoError(t, d.Dispatch(context.Background(), entity.WebhookEvent{Type: entity.WebhookUserRegistered, EventID: 1}))
+	stop := run(t, d)
+	<-received
+
+	stopped := make(chan bool)
+	go func() { stopped <- stop() }()
+	select {
+	case <-stopped:
+		t.Fatal("Run returned before the request in flight finished")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	require.True(t, <-stopped)
+	deliveries := log.snapshot()
+	require.Len(t, deliveries, 1)
+	assert.True(t, deliveries[0].Succeeded)
+	assert.Empty(t, jobs.snapshot())
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 2*time.Second, backoff(2*time.Second, 1))
+	assert.Equal(t, 4*time.Second, backoff(2*time.Second, 2))
+	assert.Equal(t, 16*time.Second, backoff(2*time.Second, 4))
+	assert.Equal(t, maxBackoff, backoff(2*time.Second, 20))
+}
@@ -0,0 +1,11 @@
+package handler
+
+import "2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+
+// Errors detected by the handlers themselves. Usecase errors are passed to c.Error as is
+// and converted to responses by middleware.ErrorHandler.
+var (
+	errInvalidRequestBody = apperr.New(apperr.CodeInvalidRequest, "Invalid request body")
+	errNoFieldsToUpdate   = apperr.New(apperr.CodeInvalidRequest, "At least one field must be provided")
+	errInvalidPage        = apperr.New(apperr.CodeInvalidRequest, "limit must be between 1 and 1000 and offset must not be negative")
+)
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"net/http"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/usecase"
+	openapi "2025_gopher_StampRally/services/gopher-stamp-crud/swagger"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EventHandler struct {
+	eventUseCase usecase.EventUseCase
+}
+
+func NewEventHandler(eventUseCase usecase.EventUseCase) *EventHandler {
+	return &EventHandler{
+		eventUseCase: eventUseCase,
+	}
+}
+
+// ListEvents implements openapi.ServerInterface
+func (h *EventHandler) ListEvents(c *gin.Context) {
+	events, err := h.eventUseCase.ListEvents(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := make([]openapi.Event, len(events))
+	for i, event := range events {
+		response[i] = openapi.Event{
+			Id:        int64(event.ID),
+			Name:      event.Name,
+			StartsAt:  event.StartsAt,
+			EndsAt:    event.EndsAt,
+			CreatedAt: &event.CreatedAt,
+			UpdatedAt: &event.UpdatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateEvent implements openapi.ServerInterface
+func (h *EventHandler) CreateEvent(c *gin.Context) {
+	var req openapi.EventCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	event, err := h.eventUseCase.CreateEvent(c.Request.Context(), req.Name, req.StartsAt, req.EndsAt)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, openapi.Event{
+		Id:        int64(event.ID),
+		Name:      event.Name,
+		StartsAt:  event.StartsAt,
+		EndsAt:    event.EndsAt,
+		CreatedAt: &event.CreatedAt,
+		UpdatedAt: &event.UpdatedAt,
+	})
+}
+
+// GetEvent implements openapi.ServerInterface
+func (h *EventHandler) GetEvent(c *gin.Context, eventId openapi.EventId) {
+	event, err := h.eventUseCase.GetEvent(c.Request.Context(), uint(eventId))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, openapi.Event{
+		Id:        int64(event.ID),
+		Name:      event.Name,
+		StartsAt:  event.StartsAt,
+		EndsAt:    event.EndsAt,
+		CreatedAt: &event.CreatedAt,
+		UpdatedAt: &event.UpdatedAt,
+	})
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/usecase"
+	openapi "2025_gopher_StampRally/services/gopher-stamp-crud/swagger"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// feedKeepAliveInterval is how often an idle stream sends a comment, so that proxies and load
+	// balancers do not close it for inactivity.
+	feedKeepAliveInterval = 25 * time.Second
+	// feedWriteTimeout bounds each write to the stream. It replaces the server's write timeout,
+	// which would otherwise end the stream, while still dropping clients that stop reading.
+	feedWriteTimeout = 10 * time.Second
+)
+
+type FeedHandler struct {
+	feedUseCase usecase.FeedUseCase
+}
+
+func NewFeedHandler(feedUseCase usecase.FeedUseCase) *FeedHandler {
+	return &FeedHandler{
+		feedUseCase: feedUseCase,
+	}
+}
+
+// StreamEvents implements openapi.ServerInterface
+func (h *FeedHandler) StreamEvents(c *gin.Context, params openapi.StreamEventsParams) {
+	eventID := entity.DefaultEventID
+	if params.EventId != nil {
+		eventID = uint(*params.EventId)
+	}
+
+	// The request context ends when the client disconnects, which unsubscribes it
+	ctx := c.Request.Context()
+	events, err := h.feedUseCase.Subscribe(ctx, eventID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	// Keeps reverse proxies such as nginx from buffering the stream
+	c.Header("X-Accel-Buffering", "no")
+	rc := http.NewResponseController(c.Writer)
+	extendWriteDeadline := func() {
+		// Not every ResponseWriter supports deadlines; those without one have no timeout to extend
+		_ = rc.SetWriteDeadline(time.Now().Add(feedWriteTimeout))
+	}
+	extendWriteDeadline()
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(feedKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			extendWriteDeadline()
+			if !ok {
+				// The client fell behind or the server is shutting down; it has to reload before it reconnects
+				c.SSEvent("resync", gin.H{})
+				c.Writer.Flush()
+				return
+			}
+			c.SSEvent(string(event.Type), toFeedMessage(event))
+		case <-keepAlive.C:
+			extendWriteDeadline()
+			_, _ = io.WriteString(c.Writer, ": keep-alive\n\n")
+		}
+		c.Writer.Flush()
+	}
+}
+
+// toFeedMessage converts a feed event to the data of its Server-Sent Event.
+func toFeedMessage(event entity.FeedEvent) any {
+	switch event.Type {
+	case entity.FeedUserRegistered:
+		return openapi.FeedUserRegistered{
+			UserId:        int64(event.UserID),
+			Name:          event.UserName,
+			IconThumbnail: event.IconThumbnail,
+			RegisteredAt:  event.OccurredAt,
+		}
+	case entity.FeedStampAcquired:
+		return openapi.FeedStampAcquired{
+			UserId:        int64(event.UserID),
+			Name:          event.UserName,
+			IconThumbnail: event.IconThumbnail,
+			StampId:       int64(event.StampID),
+			StampName:     event.StampName,
+			AcquiredAt:    event.OccurredAt,
+		}
+	default:
+		return openapi.FeedLeaderboardChange{
+			UserId:         int64(event.UserID),
+			Name:           event.UserName,
+			StampCount:     event.StampCount,
+			LastAcquiredAt: event.OccurredAt,
+		}
+	}
+}
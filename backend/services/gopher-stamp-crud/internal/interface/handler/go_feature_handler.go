@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"net/http"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/usecase"
+	openapi "2025_gopher_StampRally/services/gopher-stamp-crud/swagger"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GoFeatureHandler struct {
+	goFeatureUseCase usecase.GoFeatureUseCase
+}
+
+func NewGoFeatureHandler(goFeatureUseCase usecase.GoFeatureUseCase) *GoFeatureHandler {
+	return &GoFeatureHandler{
+		goFeatureUseCase: goFeatureUseCase,
+	}
+}
+
+// ListGoFeatures implements openapi.ServerInterface
+func (h *GoFeatureHandler) ListGoFeatures(c *gin.Context) {
+	features, err := h.goFeatureUseCase.ListGoFeatures(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := make([]openapi.GoFeature, len(features))
+	for i, feature := range features {
+		response[i] = openapi.GoFeature{
+			Code:  feature.Code,
+			Label: feature.Label,
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"go_features": response,
+	})
+}
+
+// GetGoFeatureCounts implements openapi.ServerInterface for the default event
+func (h *GoFeatureHandler) GetGoFeatureCounts(c *gin.Context) {
+	h.getGoFeatureCounts(c, entity.DefaultEventID)
+}
+
+// GetEventGoFeatureCounts implements openapi.ServerInterface
+func (h *GoFeatureHandler) GetEventGoFeatureCounts(c *gin.Context, eventId openapi.EventId) {
+	h.getGoFeatureCounts(c, uint(eventId))
+}
+
+func (h *GoFeatureHandler) getGoFeatureCounts(c *gin.Context, eventID uint) {
+	counts, participants, err := h.goFeatureUseCase.GetGoFeatureCounts(c.Request.Context(), eventID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := openapi.GoFeatureCounts{
+		GoFeatures:   make([]openapi.GoFeatureCount, len(counts)),
+		Participants: participants,
+	}
+	for i, count := range counts {
+		response.GoFeatures[i] = openapi.GoFeatureCount{
+			Code:      count.Code,
+			Label:     count.Label,
+			UserCount: count.UserCount,
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// goFeatureCodes lists the codes of a user's chosen Go features for the API.
+func goFeatureCodes(features []entity.GoFeature) *[]string {
+	codes := make([]string, len(features))
+	for i, feature := range features {
+		codes[i] = feature.Code
+	}
+	return &codes
+}
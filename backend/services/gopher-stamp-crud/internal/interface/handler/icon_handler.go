@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/icon"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxIconRequestBytes leaves room for the multipart headers around an icon of icon.MaxBytes.
+const maxIconRequestBytes = icon.MaxBytes + 64<<10
+
+var errIconTooLarge = apperr.ErrInvalidIcon.WithDetails(fmt.Sprintf("icon must be at most %d MB", icon.MaxBytes>>20))
+
+type IconHandler struct {
+	iconUseCase usecase.IconUseCase
+}
+
+func NewIconHandler(iconUseCase usecase.IconUseCase) *IconHandler {
+	return &IconHandler{
+		iconUseCase: iconUseCase,
+	}
+}
+
+// UploadUserIcon implements openapi.ServerInterface
+func (h *IconHandler) UploadUserIcon(c *gin.Context, id int64) {
+	// Reject oversized uploads while reading instead of buffering them first
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxIconRequestBytes)
+
+	fileHeader, err := c.FormFile("icon")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			_ = c.Error(errIconTooLarge)
+			return
+		}
+		_ = c.Error(errInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+	if fileHeader.Size > icon.MaxBytes {
+		_ = c.Error(errIconTooLarge)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	user, err := h.iconUseCase.UploadIcon(c.Request.Context(), uint(id), data)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOpenAPIUser(user))
+}
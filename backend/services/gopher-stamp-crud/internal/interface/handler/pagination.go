@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TotalCountHeader carries the number of items a paged list operation matched, for operations
+// whose body is the bare array of items on the page.
+const TotalCountHeader = "X-Total-Count"
+
+const (
+	// defaultPageLimit is the page size when the limit parameter is omitted.
+	defaultPageLimit = 100
+	// maxPageLimit is the largest page a list operation returns.
+	maxPageLimit = 1000
+)
+
+// pageParams applies the defaults to the limit and offset query parameters and checks them
+// against the bounds declared in the OpenAPI spec, which the generated server does not enforce.
+func pageParams(limitParam, offsetParam *int) (limit, offset int, err error) {
+	limit = defaultPageLimit
+	if limitParam != nil {
+		limit = *limitParam
+	}
+	if offsetParam != nil {
+		offset = *offsetParam
+	}
+	if limit < 1 || limit > maxPageLimit || offset < 0 {
+		return 0, 0, errInvalidPage
+	}
+	return limit, offset, nil
+}
+
+// setTotalCount sets TotalCountHeader on the response.
+func setTotalCount(c *gin.Context, total int64) {
+	c.Header(TotalCountHeader, strconv.FormatInt(total, 10))
+}
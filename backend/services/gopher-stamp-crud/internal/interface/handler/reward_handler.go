@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"net/http"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/usecase"
+	openapi "2025_gopher_StampRally/services/gopher-stamp-crud/swagger"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RewardHandler struct {
+	rewardUseCase usecase.RewardUseCase
+}
+
+func NewRewardHandler(rewardUseCase usecase.RewardUseCase) *RewardHandler {
+	return &RewardHandler{
+		rewardUseCase: rewardUseCase,
+	}
+}
+
+// ListEventRewards implements openapi.ServerInterface
+func (h *RewardHandler) ListEventRewards(c *gin.Context, eventId openapi.EventId) {
+	rules, err := h.rewardUseCase.ListRewardRules(c.Request.Context(), uint(eventId))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := make([]openapi.RewardRule, len(rules))
+	for i := range rules {
+		response[i] = toOpenAPIRewardRule(&rules[i])
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateEventReward implements openapi.ServerInterface
+func (h *RewardHandler) CreateEventReward(c *gin.Context, eventId openapi.EventId) {
+	var req openapi.RewardRuleCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	var requiredCount int
+	if req.RequiredCount != nil {
+		requiredCount = *req.RequiredCount
+	}
+	var stampIDs []uint
+	if req.StampIds != nil {
+		for _, id := range *req.StampIds {
+			stampIDs = append(stampIDs, uint(id))
+		}
+	}
+
+	rule, err := h.rewardUseCase.CreateRewardRule(c.Request.Context(), uint(eventId), req.Name, entity.RewardKind(req.Kind), requiredCount, stampIDs)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toOpenAPIRewardRule(rule))
+}
+
+// GrantEventRewards implements openapi.ServerInterface
+func (h *RewardHandler) GrantEventRewards(c *gin.Context, eventId openapi.EventId) {
+	granted, err := h.rewardUseCase.GrantRewards(c.Request.Context(), uint(eventId))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, openapi.RewardGrantResult{Granted: granted})
+}
+
+// ListUserRewards implements openapi.ServerInterface
+func (h *RewardHandler) ListUserRewards(c *gin.Context, id int64) {
+	userRewards, err := h.rewardUseCase.ListUserRewards(c.Request.Context(), uint(id))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := make([]openapi.UserReward, len(userRewards))
+	for i := range userRewards {
+		response[i] = toOpenAPIUserReward(&userRewards[i])
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"rewards": response,
+	})
+}
+
+// RedeemUserReward implements openapi.ServerInterface
+func (h *RewardHandler) RedeemUserReward(c *gin.Context, id int64, rewardId int64) {
+	userReward, err := h.rewardUseCase.RedeemReward(c.Request.Context(), uint(id), uint(rewardId))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOpenAPIUserReward(userReward))
+}
+
+func toOpenAPIRewardRule(rule *entity.RewardRule) openapi.RewardRule {
+	response := openapi.RewardRule{
+		Id:        int64(rule.ID),
+		EventId:   int64(rule.EventID),
+		Name:      rule.Name,
+		Kind:      openapi.RewardKind(rule.Kind),
+		CreatedAt: &rule.CreatedAt,
+		UpdatedAt: &rule.UpdatedAt,
+	}
+	switch rule.Kind {
+	case entity.RewardKindCount:
+		response.RequiredCount = &rule.RequiredCount
+	case entity.RewardKindStamps:
+		stampIDs := make([]int64, len(rule.Stamps))
+		for i, stamp := range rule.Stamps {
+			stampIDs[i] = int64(stamp.ID)
+		}
+		response.StampIds = &stampIDs
+	}
+	return response
+}
+
+func toOpenAPIUserReward(userReward *entity.UserReward) openapi.UserReward {
+	return openapi.UserReward{
+		UserId:     int64(userReward.UserID),
+		RewardId:   int64(userReward.RewardRuleID),
+		Name:       userReward.RewardRule.Name,
+		EarnedAt:   userReward.EarnedAt,
+		RedeemedAt: userReward.RedeemedAt,
+	}
+}
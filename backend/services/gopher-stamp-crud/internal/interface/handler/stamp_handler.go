@@ -3,6 +3,7 @@ package handler
 import (
 	"net/http"
 
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/usecase"
 	openapi "2025_gopher_StampRally/services/gopher-stamp-crud/swagger"
 
@@ -19,36 +20,33 @@ func NewStampHandler(stampUseCase usecase.StampUseCase) *StampHandler {
 	}
 }
 
-// ListStamps implements openapi.ServerInterface
+// ListStamps implements openapi.ServerInterface for the default event
 func (h *StampHandler) ListStamps(c *gin.Context, params openapi.ListStampsParams) {
-	limit := 100
-	offset := 0
+	h.listStamps(c, entity.DefaultEventID, params.Limit, params.Offset)
+}
 
-	if params.Limit != nil {
-		limit = *params.Limit
-	}
-	if params.Offset != nil {
-		offset = *params.Offset
+// ListEventStamps implements openapi.ServerInterface
+func (h *StampHandler) ListEventStamps(c *gin.Context, eventId openapi.EventId, params openapi.ListEventStampsParams) {
+	h.listStamps(c, uint(eventId), params.Limit, params.Offset)
+}
+
+func (h *StampHandler) listStamps(c *gin.Context, eventID uint, limitParam, offsetParam *int) {
+	limit, offset, err := pageParams(limitParam, offsetParam)
+	if err != nil {
+		_ = c.Error(err)
+		return
 	}
 
-	stamps, total, err := h.stampUseCase.ListStamps(c.Request.Context(), limit, offset)
+	stamps, total, err := h.stampUseCase.ListStamps(c.Request.Context(), eventID, limit, offset)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, openapi.Error{
-			Code:    "INTERNAL_ERROR",
-			Message: "Failed to fetch stamps",
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	// Convert entity to openapi types
 	response := make([]openapi.Stamp, len(stamps))
 	for i, stamp := range stamps {
-		response[i] = openapi.Stamp{
-			Id:        int64(stamp.ID),
-			Name:      stamp.Name,
-			CreatedAt: &stamp.CreatedAt,
-			UpdatedAt: &stamp.UpdatedAt,
-		}
+		response[i] = toOpenAPIStamp(&stamp)
 	}
 
 	c.JSON(http.StatusOK, gin.H{
@@ -57,131 +55,109 @@ func (h *StampHandler) ListStamps(c *gin.Context, params openapi.ListStampsParam
 	})
 }
 
-// CreateStamp implements openapi.ServerInterface
+// CreateStamp implements openapi.ServerInterface for the default event
 func (h *StampHandler) CreateStamp(c *gin.Context) {
+	h.createStamp(c, entity.DefaultEventID)
+}
+
+// CreateEventStamp implements openapi.ServerInterface
+func (h *StampHandler) CreateEventStamp(c *gin.Context, eventId openapi.EventId) {
+	h.createStamp(c, uint(eventId))
+}
+
+func (h *StampHandler) createStamp(c *gin.Context, eventID uint) {
 	var req openapi.StampCreateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		errMsg := err.Error()
-		c.JSON(http.StatusBadRequest, openapi.Error{
-			Code:    "INVALID_REQUEST",
-			Message: "Invalid request body",
-			Details: &errMsg,
-		})
+		_ = c.Error(errInvalidRequestBody.WithDetails(err.Error()))
 		return
 	}
 
-	stamp, err := h.stampUseCase.CreateStamp(c.Request.Context(), req.Name)
+	stamp, err := h.stampUseCase.CreateStamp(c.Request.Context(), eventID, req.Name, req.AvailableFrom, req.AvailableUntil)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, openapi.Error{
-			Code:    "INTERNAL_ERROR",
-			Message: "Failed to create stamp",
-		})
+		_ = c.Error(err)
 		return
 	}
 
-	response := openapi.Stamp{
-		Id:        int64(stamp.ID),
-		Name:      stamp.Name,
-		CreatedAt: &stamp.CreatedAt,
-		UpdatedAt: &stamp.UpdatedAt,
-	}
-
-	c.JSON(http.StatusCreated, response)
+	c.JSON(http.StatusCreated, toOpenAPIStamp(stamp))
 }
 
 // GetStamp implements openapi.ServerInterface
 func (h *StampHandler) GetStamp(c *gin.Context, id int64) {
 	stamp, err := h.stampUseCase.GetStamp(c.Request.Context(), uint(id))
 	if err != nil {
-		if err.Error() == "stamp not found" {
-			c.JSON(http.StatusNotFound, openapi.Error{
-				Code:    "NOT_FOUND",
-				Message: "Stamp not found",
-			})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, openapi.Error{
-			Code:    "INTERNAL_ERROR",
-			Message: "Failed to fetch stamp",
-		})
+		_ = c.Error(err)
 		return
 	}
 
-	response := openapi.Stamp{
-		Id:        int64(stamp.ID),
-		Name:      stamp.Name,
-		CreatedAt: &stamp.CreatedAt,
-		UpdatedAt: &stamp.UpdatedAt,
-	}
-
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, toOpenAPIStamp(stamp))
 }
 
 // UpdateStamp implements openapi.ServerInterface
 func (h *StampHandler) UpdateStamp(c *gin.Context, id int64) {
 	var req openapi.StampUpdateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		errMsg := err.Error()
-		c.JSON(http.StatusBadRequest, openapi.Error{
-			Code:    "INVALID_REQUEST",
-			Message: "Invalid request body",
-			Details: &errMsg,
-		})
+		_ = c.Error(errInvalidRequestBody.WithDetails(err.Error()))
 		return
 	}
 
-	stamp, err := h.stampUseCase.UpdateStamp(c.Request.Context(), uint(id), req.Name)
+	stamp, err := h.stampUseCase.UpdateStamp(c.Request.Context(), uint(id), req.Name, req.AvailableFrom, req.AvailableUntil)
 	if err != nil {
-		if err.Error() == "stamp not found" {
-			c.JSON(http.StatusNotFound, openapi.Error{
-				Code:    "NOT_FOUND",
-				Message: "Stamp not found",
-			})
-			return
-		}
-		if err.Error() == "name must be provided" {
-			errMsg := err.Error()
-			c.JSON(http.StatusBadRequest, openapi.Error{
-				Code:    "INVALID_REQUEST",
-				Message: "name must be provided",
-				Details: &errMsg,
-			})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, openapi.Error{
-			Code:    "INTERNAL_ERROR",
-			Message: "Failed to update stamp",
-		})
+		_ = c.Error(err)
 		return
 	}
 
-	response := openapi.Stamp{
-		Id:        int64(stamp.ID),
-		Name:      stamp.Name,
-		CreatedAt: &stamp.CreatedAt,
-		UpdatedAt: &stamp.UpdatedAt,
-	}
-
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, toOpenAPIStamp(stamp))
 }
 
 // DeleteStamp implements openapi.ServerInterface
 func (h *StampHandler) DeleteStamp(c *gin.Context, id int64) {
 	err := h.stampUseCase.DeleteStamp(c.Request.Context(), uint(id))
 	if err != nil {
-		if err.Error() == "stamp not found" {
-			c.JSON(http.StatusNotFound, openapi.Error{
-				Code:    "NOT_FOUND",
-				Message: "Stamp not found",
-			})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, openapi.Error{
-			Code:    "INTERNAL_ERROR",
-			Message: "Failed to delete stamp",
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
+
+// IssueStampToken implements openapi.ServerInterface
+func (h *StampHandler) IssueStampToken(c *gin.Context, id int64) {
+	token, expiresAt, err := h.stampUseCase.IssueStampToken(c.Request.Context(), uint(id))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, openapi.StampToken{
+		StampId:   id,
+		Token:     token,
+		ExpiresAt: expiresAt,
+	})
+}
+
+// GetStampCode implements openapi.ServerInterface
+func (h *StampHandler) GetStampCode(c *gin.Context, id int64) {
+	code, expiresAt, err := h.stampUseCase.GetStampCode(c.Request.Context(), uint(id))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, openapi.StampCode{
+		StampId:   id,
+		Code:      code,
+		ExpiresAt: expiresAt,
+	})
+}
+
+func toOpenAPIStamp(stamp *entity.Stamp) openapi.Stamp {
+	return openapi.Stamp{
+		Id:             int64(stamp.ID),
+		EventId:        int64(stamp.EventID),
+		Name:           stamp.Name,
+		AvailableFrom:  stamp.AvailableFrom,
+		AvailableUntil: stamp.AvailableUntil,
+		CreatedAt:      &stamp.CreatedAt,
+		UpdatedAt:      &stamp.UpdatedAt,
+	}
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"net/http"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/usecase"
+	openapi "2025_gopher_StampRally/services/gopher-stamp-crud/swagger"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StatsHandler struct {
+	statsUseCase usecase.StatsUseCase
+}
+
+func NewStatsHandler(statsUseCase usecase.StatsUseCase) *StatsHandler {
+	return &StatsHandler{
+		statsUseCase: statsUseCase,
+	}
+}
+
+// GetAdminStats implements openapi.ServerInterface
+func (h *StatsHandler) GetAdminStats(c *gin.Context, params openapi.GetAdminStatsParams) {
+	eventID := entity.DefaultEventID
+	if params.EventId != nil {
+		eventID = uint(*params.EventId)
+	}
+
+	stats, err := h.statsUseCase.GetEventStats(c.Request.Context(), eventID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := openapi.EventStats{
+		EventId:            int64(stats.EventID),
+		Participants:       stats.Participants,
+		Completed:          stats.Completed,
+		CompletionRate:     stats.CompletionRate,
+		Stamps:             make([]openapi.StampStats, len(stats.Stamps)),
+		HourlyAcquisitions: make([]openapi.HourlyAcquisitions, len(stats.Hourly)),
+	}
+	for i, stamp := range stats.Stamps {
+		response.Stamps[i] = openapi.StampStats{
+			StampId:      int64(stamp.StampID),
+			Name:         stamp.Name,
+			Acquisitions: stamp.Acquisitions,
+		}
+	}
+	for i, hour := range stats.Hourly {
+		response.HourlyAcquisitions[i] = openapi.HourlyAcquisitions{
+			Hour:         hour.Hour,
+			Acquisitions: hour.Acquisitions,
+		}
+	}
+	if stats.MedianCompletion != nil {
+		seconds := int64(stats.MedianCompletion.Seconds())
+		response.MedianCompletionSeconds = &seconds
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"net/http"
 
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/usecase"
 	openapi "2025_gopher_StampRally/services/gopher-stamp-crud/swagger"
 
@@ -12,58 +13,86 @@ import (
 type UserHandler struct {
 	userUsecase      usecase.UserUsecase
 	userStampUseCase usecase.UserStampUseCase
+	eventHandler     *EventHandler
 	stampHandler     *StampHandler
 	userStampHandler *UserStampHandler
+	rewardHandler    *RewardHandler
+	iconHandler      *IconHandler
+	goFeatureHandler *GoFeatureHandler
+	statsHandler     *StatsHandler
+	feedHandler      *FeedHandler
+	webhookHandler   *WebhookHandler
 }
 
 func NewUserHandler(
 	userUsecase usecase.UserUsecase,
 	userStampUseCase usecase.UserStampUseCase,
+	eventHandler *EventHandler,
 	stampHandler *StampHandler,
 	userStampHandler *UserStampHandler,
+	rewardHandler *RewardHandler,
+	iconHandler *IconHandler,
+	goFeatureHandler *GoFeatureHandler,
+	statsHandler *StatsHandler,
+	feedHandler *FeedHandler,
+	webhookHandler *WebhookHandler,
 ) openapi.ServerInterface {
 	return &UserHandler{
 		userUsecase:      userUsecase,
 		userStampUseCase: userStampUseCase,
+		eventHandler:     eventHandler,
 		stampHandler:     stampHandler,
 		userStampHandler: userStampHandler,
+		rewardHandler:    rewardHandler,
+		iconHandler:      iconHandler,
+		goFeatureHandler: goFeatureHandler,
+		statsHandler:     statsHandler,
+		feedHandler:      feedHandler,
+		webhookHandler:   webhookHandler,
 	}
 }
 
-// (POST /users) Swagger生成のインターフェースに合わせたメソッド
+// (POST /users) Swagger生成のインターフェースに合わせたメソッド。デフォルトイベントに登録する
 func (h *UserHandler) CreateUser(c *gin.Context) {
+	h.createUser(c, entity.DefaultEventID)
+}
+
+// (POST /events/{event_id}/users) Swagger生成のインターフェースに合わせたメソッド
+func (h *UserHandler) CreateEventUser(c *gin.Context, eventId openapi.EventId) {
+	h.createUser(c, uint(eventId))
+}
+
+func (h *UserHandler) createUser(c *gin.Context, eventID uint) {
 	var request openapi.UserCreateRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		errMsg := err.Error()
-		c.JSON(http.StatusBadRequest, openapi.Error{
-			Code:    "INVALID_REQUEST",
-			Message: "Invalid request body",
-			Details: &errMsg,
-		})
+		_ = c.Error(errInvalidRequestBody.WithDetails(err.Error()))
 		return
 	}
 
-	user, err := h.userUsecase.Create(
+	user, accessToken, err := h.userUsecase.Create(
 		c.Request.Context(),
+		eventID,
 		request.Name,
 		request.TwitterId,
 		request.FavoriteGoFeature,
+		request.GoFeatures,
 		request.Icon,
 	)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, openapi.Error{
-			Code:    "INTERNAL_ERROR",
-			Message: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, openapi.User{
+	c.JSON(http.StatusCreated, openapi.UserCreateResponse{
+		AccessToken:       accessToken,
 		Id:                int64(user.ID),
+		EventId:           int64(user.EventID),
 		Name:              user.Name,
 		TwitterId:         user.TwitterID,
 		FavoriteGoFeature: user.FavoriteGoFeature,
+		GoFeatures:        goFeatureCodes(user.GoFeatures),
 		Icon:              user.Icon,
+		IconThumbnail:     user.IconThumbnail,
 		CreatedAt:         &user.CreatedAt,
 		UpdatedAt:         &user.UpdatedAt,
 	})
@@ -75,20 +104,14 @@ func (h *UserHandler) GetUser(c *gin.Context, id int64) {
 
 	user, err := h.userUsecase.GetByID(ctx, uint(id))
 	if err != nil {
-		c.JSON(http.StatusNotFound, openapi.Error{
-			Code:    "NOT_FOUND",
-			Message: "user not found",
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	// Get user stamps
 	userStamps, err := h.userStampUseCase.ListUserStamps(ctx, uint(id))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, openapi.Error{
-			Code:    "INTERNAL_ERROR",
-			Message: "Failed to fetch user stamps",
-		})
+		_ = c.Error(err)
 		return
 	}
 
@@ -104,28 +127,53 @@ func (h *UserHandler) GetUser(c *gin.Context, id int64) {
 
 	c.JSON(http.StatusOK, openapi.UserDetail{
 		Id:                int64(user.ID),
+		EventId:           int64(user.EventID),
 		Name:              user.Name,
 		TwitterId:         user.TwitterID,
 		FavoriteGoFeature: user.FavoriteGoFeature,
+		GoFeatures:        goFeatureCodes(user.GoFeatures),
 		Icon:              user.Icon,
+		IconThumbnail:     user.IconThumbnail,
 		CreatedAt:         &user.CreatedAt,
 		UpdatedAt:         &user.UpdatedAt,
 		AcquiredStamps:    &acquiredStamps,
 	})
 }
 
-// (GET /users) Swagger生成のインターフェースに合わせたメソッド
-func (h *UserHandler) ListUsers(c *gin.Context) {
-	// Check if include_stamp_counts query parameter is present
-	includeStampCounts := c.Query("include_stamp_counts") == "true"
+// (GET /users) Swagger生成のインターフェースに合わせたメソッド。デフォルトイベントの参加者を返す
+func (h *UserHandler) ListUsers(c *gin.Context, params openapi.ListUsersParams) {
+	h.listUsers(c, entity.DefaultEventID, params)
+}
+
+// (GET /events/{event_id}/users) Swagger生成のインターフェースに合わせたメソッド
+func (h *UserHandler) ListEventUsers(c *gin.Context, eventId openapi.EventId, params openapi.ListEventUsersParams) {
+	h.listUsers(c, uint(eventId), openapi.ListUsersParams(params))
+}
+
+func (h *UserHandler) listUsers(c *gin.Context, eventID uint, params openapi.ListUsersParams) {
+	limit, offset, err := pageParams(params.Limit, params.Offset)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	opts := usecase.UserListOptions{
+		Limit:  limit,
+		Offset: offset,
+	}
+	if params.Sort != nil {
+		opts.Sort = string(*params.Sort)
+	}
+	if params.Order != nil {
+		opts.Order = string(*params.Order)
+	}
+	if params.Q != nil {
+		opts.Search = *params.Q
+	}
 
-	if includeStampCounts {
-		users, userStampMap, err := h.userUsecase.GetAllWithStampCounts(c.Request.Context())
+	if params.IncludeStampCounts != nil && *params.IncludeStampCounts {
+		users, userStampMap, total, err := h.userUsecase.GetAllWithStampCounts(c.Request.Context(), eventID, opts)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, openapi.Error{
-				Code:    "INTERNAL_ERROR",
-				Message: err.Error(),
-			})
+			_ = c.Error(err)
 			return
 		}
 
@@ -144,46 +192,29 @@ func (h *UserHandler) ListUsers(c *gin.Context) {
 			}
 
 			swaggerUsers[i] = UserWithStamps{
-				User: openapi.User{
-					Id:                int64(user.ID),
-					Name:              user.Name,
-					TwitterId:         user.TwitterID,
-					FavoriteGoFeature: user.FavoriteGoFeature,
-					Icon:              user.Icon,
-					CreatedAt:         &user.CreatedAt,
-					UpdatedAt:         &user.UpdatedAt,
-				},
+				User:     toOpenAPIUser(user),
 				StampIds: stampIDsInt64,
 			}
 		}
 
+		setTotalCount(c, total)
 		c.JSON(http.StatusOK, swaggerUsers)
 		return
 	}
 
 	// Original behavior without stamp counts
-	users, err := h.userUsecase.GetAll(c.Request.Context())
+	users, total, err := h.userUsecase.GetAll(c.Request.Context(), eventID, opts)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, openapi.Error{
-			Code:    "INTERNAL_ERROR",
-			Message: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
 	swaggerUsers := make([]openapi.User, len(users))
 	for i, user := range users {
-		swaggerUsers[i] = openapi.User{
-			Id:                int64(user.ID),
-			Name:              user.Name,
-			TwitterId:         user.TwitterID,
-			FavoriteGoFeature: user.FavoriteGoFeature,
-			Icon:              user.Icon,
-			CreatedAt:         &user.CreatedAt,
-			UpdatedAt:         &user.UpdatedAt,
-		}
+		swaggerUsers[i] = toOpenAPIUser(user)
 	}
 
+	setTotalCount(c, total)
 	c.JSON(http.StatusOK, swaggerUsers)
 }
 
@@ -191,21 +222,13 @@ func (h *UserHandler) ListUsers(c *gin.Context) {
 func (h *UserHandler) UpdateUser(c *gin.Context, id int64) {
 	var request openapi.UserUpdateRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		errMsg := err.Error()
-		c.JSON(http.StatusBadRequest, openapi.Error{
-			Code:    "INVALID_REQUEST",
-			Message: "Invalid request body",
-			Details: &errMsg,
-		})
+		_ = c.Error(errInvalidRequestBody.WithDetails(err.Error()))
 		return
 	}
 
 	// At least one field must be provided
-	if request.Name == nil && request.TwitterId == nil && request.FavoriteGoFeature == nil && request.Icon == nil {
-		c.JSON(http.StatusBadRequest, openapi.Error{
-			Code:    "INVALID_REQUEST",
-			Message: "At least one field must be provided",
-		})
+	if request.Name == nil && request.TwitterId == nil && request.FavoriteGoFeature == nil && request.GoFeatures == nil && request.Icon == nil {
+		_ = c.Error(errNoFieldsToUpdate)
 		return
 	}
 
@@ -215,25 +238,50 @@ func (h *UserHandler) UpdateUser(c *gin.Context, id int64) {
 		request.Name,
 		request.TwitterId,
 		request.FavoriteGoFeature,
+		request.GoFeatures,
 		request.Icon,
 	)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, openapi.Error{
-			Code:    "INTERNAL_ERROR",
-			Message: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, openapi.User{
-		Id:                int64(user.ID),
-		Name:              user.Name,
-		TwitterId:         user.TwitterID,
-		FavoriteGoFeature: user.FavoriteGoFeature,
-		Icon:              user.Icon,
-		CreatedAt:         &user.CreatedAt,
-		UpdatedAt:         &user.UpdatedAt,
-	})
+	c.JSON(http.StatusOK, toOpenAPIUser(user))
+}
+
+// (DELETE /users/{id}) Swagger生成のインターフェースに合わせたメソッド
+func (h *UserHandler) DeleteUser(c *gin.Context, id int64) {
+	err := h.userUsecase.Delete(c.Request.Context(), uint(id))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// (POST /users/{id}/token) Swagger生成のインターフェースに合わせたメソッド
+func (h *UserHandler) IssueUserToken(c *gin.Context, id int64) {
+	accessToken, err := h.userUsecase.IssueToken(c.Request.Context(), uint(id))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, openapi.UserAccessToken{AccessToken: accessToken})
+}
+
+// Delegate event methods to EventHandler
+func (h *UserHandler) ListEvents(c *gin.Context) {
+	h.eventHandler.ListEvents(c)
+}
+
+func (h *UserHandler) CreateEvent(c *gin.Context) {
+	h.eventHandler.CreateEvent(c)
+}
+
+func (h *UserHandler) GetEvent(c *gin.Context, eventId openapi.EventId) {
+	h.eventHandler.GetEvent(c, eventId)
 }
 
 // Delegate stamp methods to StampHandler
@@ -245,6 +293,14 @@ func (h *UserHandler) CreateStamp(c *gin.Context) {
 	h.stampHandler.CreateStamp(c)
 }
 
+func (h *UserHandler) ListEventStamps(c *gin.Context, eventId openapi.EventId, params openapi.ListEventStampsParams) {
+	h.stampHandler.ListEventStamps(c, eventId, params)
+}
+
+func (h *UserHandler) CreateEventStamp(c *gin.Context, eventId openapi.EventId) {
+	h.stampHandler.CreateEventStamp(c, eventId)
+}
+
 func (h *UserHandler) GetStamp(c *gin.Context, id int64) {
 	h.stampHandler.GetStamp(c, id)
 }
@@ -257,6 +313,14 @@ func (h *UserHandler) DeleteStamp(c *gin.Context, id int64) {
 	h.stampHandler.DeleteStamp(c, id)
 }
 
+func (h *UserHandler) IssueStampToken(c *gin.Context, id int64) {
+	h.stampHandler.IssueStampToken(c, id)
+}
+
+func (h *UserHandler) GetStampCode(c *gin.Context, id int64) {
+	h.stampHandler.GetStampCode(c, id)
+}
+
 // Delegate user stamp methods to UserStampHandler
 func (h *UserHandler) ListUserStamps(c *gin.Context, id int64) {
 	h.userStampHandler.ListUserStamps(c, id)
@@ -265,3 +329,92 @@ func (h *UserHandler) ListUserStamps(c *gin.Context, id int64) {
 func (h *UserHandler) AcquireStamp(c *gin.Context, id int64) {
 	h.userStampHandler.AcquireStamp(c, id)
 }
+
+func (h *UserHandler) GetLeaderboard(c *gin.Context, params openapi.GetLeaderboardParams) {
+	h.userStampHandler.GetLeaderboard(c, params)
+}
+
+func (h *UserHandler) GetEventLeaderboard(c *gin.Context, eventId openapi.EventId, params openapi.GetEventLeaderboardParams) {
+	h.userStampHandler.GetEventLeaderboard(c, eventId, params)
+}
+
+// Delegate reward methods to RewardHandler
+func (h *UserHandler) ListEventRewards(c *gin.Context, eventId openapi.EventId) {
+	h.rewardHandler.ListEventRewards(c, eventId)
+}
+
+func (h *UserHandler) CreateEventReward(c *gin.Context, eventId openapi.EventId) {
+	h.rewardHandler.CreateEventReward(c, eventId)
+}
+
+func (h *UserHandler) GrantEventRewards(c *gin.Context, eventId openapi.EventId) {
+	h.rewardHandler.GrantEventRewards(c, eventId)
+}
+
+func (h *UserHandler) ListUserRewards(c *gin.Context, id int64) {
+	h.rewardHandler.ListUserRewards(c, id)
+}
+
+func (h *UserHandler) RedeemUserReward(c *gin.Context, id int64, rewardId int64) {
+	h.rewardHandler.RedeemUserReward(c, id, rewardId)
+}
+
+// Delegate icon methods to IconHandler
+func (h *UserHandler) UploadUserIcon(c *gin.Context, id int64) {
+	h.iconHandler.UploadUserIcon(c, id)
+}
+
+// Delegate Go feature methods to GoFeatureHandler
+func (h *UserHandler) ListGoFeatures(c *gin.Context) {
+	h.goFeatureHandler.ListGoFeatures(c)
+}
+
+func (h *UserHandler) GetGoFeatureCounts(c *gin.Context) {
+	h.goFeatureHandler.GetGoFeatureCounts(c)
+}
+
+func (h *UserHandler) GetEventGoFeatureCounts(c *gin.Context, eventId openapi.EventId) {
+	h.goFeatureHandler.GetEventGoFeatureCounts(c, eventId)
+}
+
+// Delegate stats methods to StatsHandler
+func (h *UserHandler) GetAdminStats(c *gin.Context, params openapi.GetAdminStatsParams) {
+	h.statsHandler.GetAdminStats(c, params)
+}
+
+// Delegate feed methods to FeedHandler
+func (h *UserHandler) StreamEvents(c *gin.Context, params openapi.StreamEventsParams) {
+	h.feedHandler.StreamEvents(c, params)
+}
+
+// Delegate webhook methods to WebhookHandler
+func (h *UserHandler) ListEventWebhooks(c *gin.Context, eventId openapi.EventId) {
+	h.webhookHandler.ListEventWebhooks(c, eventId)
+}
+
+func (h *UserHandler) CreateEventWebhook(c *gin.Context, eventId openapi.EventId) {
+	h.webhookHandler.CreateEventWebhook(c, eventId)
+}
+
+func (h *UserHandler) DeleteWebhook(c *gin.Context, webhookId openapi.WebhookId) {
+	h.webhookHandler.DeleteWebhook(c, webhookId)
+}
+
+func (h *UserHandler) ListWebhookDeliveries(c *gin.Context, webhookId openapi.WebhookId, params openapi.ListWebhookDeliveriesParams) {
+	h.webhookHandler.ListWebhookDeliveries(c, webhookId, params)
+}
+
+func toOpenAPIUser(user *entity.User) openapi.User {
+	return openapi.User{
+		Id:                int64(user.ID),
+		EventId:           int64(user.EventID),
+		Name:              user.Name,
+		TwitterId:         user.TwitterID,
+		FavoriteGoFeature: user.FavoriteGoFeature,
+		GoFeatures:        goFeatureCodes(user.GoFeatures),
+		Icon:              user.Icon,
+		IconThumbnail:     user.IconThumbnail,
+		CreatedAt:         &user.CreatedAt,
+		UpdatedAt:         &user.UpdatedAt,
+	}
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"net/http"
 
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/usecase"
 	openapi "2025_gopher_StampRally/services/gopher-stamp-crud/swagger"
 
@@ -23,17 +24,7 @@ func NewUserStampHandler(userStampUseCase usecase.UserStampUseCase) *UserStampHa
 func (h *UserStampHandler) ListUserStamps(c *gin.Context, id int64) {
 	userStamps, err := h.userStampUseCase.ListUserStamps(c.Request.Context(), uint(id))
 	if err != nil {
-		if err.Error() == "user not found" {
-			c.JSON(http.StatusNotFound, openapi.Error{
-				Code:    "NOT_FOUND",
-				Message: "User not found",
-			})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, openapi.Error{
-			Code:    "INTERNAL_ERROR",
-			Message: "Failed to fetch user stamps",
-		})
+		_ = c.Error(err)
 		return
 	}
 
@@ -56,37 +47,22 @@ func (h *UserStampHandler) ListUserStamps(c *gin.Context, id int64) {
 func (h *UserStampHandler) AcquireStamp(c *gin.Context, id int64) {
 	var req openapi.AcquireStampRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		errMsg := err.Error()
-		c.JSON(http.StatusBadRequest, openapi.Error{
-			Code:    "INVALID_REQUEST",
-			Message: "Invalid request body",
-			Details: &errMsg,
-		})
+		_ = c.Error(errInvalidRequestBody.WithDetails(err.Error()))
 		return
 	}
 
-	userStamp, err := h.userStampUseCase.AcquireStamp(c.Request.Context(), uint(id), uint(req.StampId))
+	var token, code string
+	if req.Token != nil {
+		token = *req.Token
+	}
+	if req.Code != nil {
+		code = *req.Code
+	}
+
+	userStamp, err := h.userStampUseCase.AcquireStamp(c.Request.Context(), uint(id), uint(req.StampId), token, code)
 	if err != nil {
-		switch err.Error() {
-		case "user not found", "stamp not found":
-			c.JSON(http.StatusNotFound, openapi.Error{
-				Code:    "NOT_FOUND",
-				Message: err.Error(),
-			})
-			return
-		case "stamp already acquired":
-			c.JSON(http.StatusConflict, openapi.Error{
-				Code:    "ALREADY_EXISTS",
-				Message: "Stamp already acquired",
-			})
-			return
-		default:
-			c.JSON(http.StatusInternalServerError, openapi.Error{
-				Code:    "INTERNAL_ERROR",
-				Message: "Failed to acquire stamp",
-			})
-			return
-		}
+		_ = c.Error(err)
+		return
 	}
 
 	response := openapi.UserStamp{
@@ -97,3 +73,43 @@ func (h *UserStampHandler) AcquireStamp(c *gin.Context, id int64) {
 
 	c.JSON(http.StatusCreated, response)
 }
+
+// GetLeaderboard implements openapi.ServerInterface for the default event
+func (h *UserStampHandler) GetLeaderboard(c *gin.Context, params openapi.GetLeaderboardParams) {
+	h.getLeaderboard(c, entity.DefaultEventID, params.Limit, params.Offset)
+}
+
+// GetEventLeaderboard implements openapi.ServerInterface
+func (h *UserStampHandler) GetEventLeaderboard(c *gin.Context, eventId openapi.EventId, params openapi.GetEventLeaderboardParams) {
+	h.getLeaderboard(c, uint(eventId), params.Limit, params.Offset)
+}
+
+func (h *UserStampHandler) getLeaderboard(c *gin.Context, eventID uint, limitParam, offsetParam *int) {
+	limit, offset, err := pageParams(limitParam, offsetParam)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	entries, total, err := h.userStampUseCase.GetLeaderboard(c.Request.Context(), eventID, limit, offset)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := openapi.Leaderboard{
+		Entries: make([]openapi.LeaderboardEntry, len(entries)),
+		Total:   total,
+	}
+	for i, entry := range entries {
+		response.Entries[i] = openapi.LeaderboardEntry{
+			Rank:           entry.Rank,
+			UserId:         int64(entry.UserID),
+			Name:           entry.Name,
+			StampCount:     entry.StampCount,
+			LastAcquiredAt: entry.LastAcquiredAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
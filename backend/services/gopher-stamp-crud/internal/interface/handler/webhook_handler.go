@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"net/http"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/usecase"
+	openapi "2025_gopher_StampRally/services/gopher-stamp-crud/swagger"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookHandler struct {
+	webhookUseCase usecase.WebhookUseCase
+}
+
+func NewWebhookHandler(webhookUseCase usecase.WebhookUseCase) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUseCase: webhookUseCase,
+	}
+}
+
+// ListEventWebhooks implements openapi.ServerInterface
+func (h *WebhookHandler) ListEventWebhooks(c *gin.Context, eventId openapi.EventId) {
+	webhooks, err := h.webhookUseCase.ListWebhooks(c.Request.Context(), uint(eventId))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := make([]openapi.Webhook, len(webhooks))
+	for i := range webhooks {
+		response[i] = toOpenAPIWebhook(&webhooks[i])
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateEventWebhook implements openapi.ServerInterface
+func (h *WebhookHandler) CreateEventWebhook(c *gin.Context, eventId openapi.EventId) {
+	var req openapi.WebhookCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	eventTypes := make([]entity.WebhookEventType, len(req.EventTypes))
+	for i, t := range req.EventTypes {
+		eventTypes[i] = entity.WebhookEventType(t)
+	}
+	var secret string
+	if req.Secret != nil {
+		secret = *req.Secret
+	}
+
+	webhook, err := h.webhookUseCase.CreateWebhook(c.Request.Context(), uint(eventId), req.Url, eventTypes, secret)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	// The secret is shown only once, when the webhook is registered
+	response := toOpenAPIWebhook(webhook)
+	response.Secret = &webhook.Secret
+	c.JSON(http.StatusCreated, response)
+}
+
+// DeleteWebhook implements openapi.ServerInterface
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context, webhookId openapi.WebhookId) {
+	if err := h.webhookUseCase.DeleteWebhook(c.Request.Context(), uint(webhookId)); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListWebhookDeliveries implements openapi.ServerInterface
+func (h *WebhookHandler) ListWebhookDeliveries(c *gin.Context, webhookId openapi.WebhookId, params openapi.ListWebhookDeliveriesParams) {
+	limit, _, err := pageParams(params.Limit, nil)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	deliveries, err := h.webhookUseCase.ListDeliveries(c.Request.Context(), uint(webhookId), limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := make([]openapi.WebhookDelivery, len(deliveries))
+	for i := range deliveries {
+		response[i] = toOpenAPIWebhookDelivery(&deliveries[i])
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"deliveries": response,
+	})
+}
+
+func toOpenAPIWebhook(webhook *entity.Webhook) openapi.Webhook {
+	eventTypes := make([]openapi.WebhookEventType, len(webhook.EventTypes))
+	for i, t := range webhook.EventTypes {
+		eventTypes[i] = openapi.WebhookEventType(t)
+	}
+	return openapi.Webhook{
+		Id:         int64(webhook.ID),
+		EventId:    int64(webhook.EventID),
+		Url:        webhook.URL,
+		EventTypes: eventTypes,
+		CreatedAt:  webhook.CreatedAt,
+	}
+}
+
+func toOpenAPIWebhookDelivery(delivery *entity.WebhookDelivery) openapi.WebhookDelivery {
+	return openapi.WebhookDelivery{
+		Id:          int64(delivery.ID),
+		WebhookId:   int64(delivery.WebhookID),
+		EventType:   openapi.WebhookEventType(delivery.EventType),
+		Payload:     delivery.Payload,
+		Attempt:     delivery.Attempt,
+		StatusCode:  delivery.StatusCode,
+		Error:       delivery.Error,
+		Succeeded:   delivery.Succeeded,
+		AttemptedAt: delivery.AttemptedAt,
+	}
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	openapi "2025_gopher_StampRally/services/gopher-stamp-crud/swagger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminAPIKeyHeader is the header organizers send their API key in.
+const AdminAPIKeyHeader = "X-Admin-Key"
+
+var errAdminKeyRequired = apperr.New(apperr.CodeUnauthorized, "Admin API key required")
+
+type AdminMiddleware struct {
+	apiKey string
+}
+
+func NewAdminMiddleware(apiKey string) *AdminMiddleware {
+	return &AdminMiddleware{
+		apiKey: apiKey,
+	}
+}
+
+// RequireAdmin rejects operations that declare adminApiKey security in the OpenAPI spec
+// unless the request carries the organizer API key.
+// Operations without adminApiKey pass through untouched.
+func (m *AdminMiddleware) RequireAdmin(c *gin.Context) {
+	if _, ok := c.Get(openapi.AdminApiKeyScopes); !ok {
+		return
+	}
+
+	key := c.GetHeader(AdminAPIKeyHeader)
+	if key == "" || subtle.ConstantTimeCompare([]byte(key), []byte(m.apiKey)) != 1 {
+		abortWithError(c, errAdminKeyRequired)
+		return
+	}
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	openapi "2025_gopher_StampRally/services/gopher-stamp-crud/swagger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// stampServer answers the stamp operations used below. Any other operation panics.
+type stampServer struct {
+	openapi.ServerInterface
+}
+
+func (stampServer) GetStamp(c *gin.Context, id int64) {
+	c.Status(http.StatusOK)
+}
+
+func (stampServer) IssueStampToken(c *gin.Context, id int64) {
+	c.Status(http.StatusOK)
+}
+
+// TestRequireAdmin runs the middleware on the generated routes, so the security declared in the
+// OpenAPI spec is checked as well: a stamp token lets anyone acquire the stamp, so only
+// organizers may issue one.
+func TestRequireAdmin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		path       string
+		key        string
+		wantStatus int
+	}{
+		{name: "token without key", path: "/stamps/1/token", wantStatus: http.StatusUnauthorized},
+		{name: "token with wrong key", path: "/stamps/1/token", key: "wrong-key", wantStatus: http.StatusUnauthorized},
+		{name: "token with admin key", path: "/stamps/1/token", key: "admin-key", wantStatus: http.StatusOK},
+		{name: "public operation", path: "/stamps/1", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(ErrorHandler)
+			openapi.RegisterHandlersWithOptions(r, stampServer{}, openapi.GinServerOptions{
+				ErrorHandler: OpenAPIErrorHandler,
+				Middlewares:  []openapi.MiddlewareFunc{NewAdminMiddleware("admin-key").RequireAdmin},
+			})
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.key != "" {
+				req.Header.Set(AdminAPIKeyHeader, tt.key)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
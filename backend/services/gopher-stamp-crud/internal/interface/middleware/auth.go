@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"strconv"
+	"strings"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/usecase"
+	openapi "2025_gopher_StampRally/services/gopher-stamp-crud/swagger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuthUserIDKey is the gin context key holding the ID of the authenticated participant.
+const AuthUserIDKey = "authUserID"
+
+var (
+	errMissingBearerToken = apperr.New(apperr.CodeUnauthorized, "Missing bearer token")
+	errNotOwner           = apperr.New(apperr.CodeForbidden, "You can only modify your own account")
+)
+
+type AuthMiddleware struct {
+	authUseCase usecase.AuthUseCase
+}
+
+func NewAuthMiddleware(authUseCase usecase.AuthUseCase) *AuthMiddleware {
+	return &AuthMiddleware{
+		authUseCase: authUseCase,
+	}
+}
+
+// RequireOwner authenticates operations that declare bearerAuth security in the OpenAPI spec
+// and ensures the caller owns the user identified by the {id} path parameter.
+// Operations without bearerAuth pass through untouched.
+// It is meant to be registered as an openapi.MiddlewareFunc, which runs after path parameters are bound.
+func (m *AuthMiddleware) RequireOwner(c *gin.Context) {
+	if _, ok := c.Get(openapi.BearerAuthScopes); !ok {
+		return
+	}
+
+	token, ok := bearerToken(c.GetHeader("Authorization"))
+	if !ok {
+		abortWithError(c, errMissingBearerToken)
+		return
+	}
+
+	userID, err := m.authUseCase.Authenticate(c.Request.Context(), token)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+
+	if id := c.Param("id"); id != "" && !ownsID(userID, id) {
+		abortWithError(c, errNotOwner)
+		return
+	}
+
+	c.Set(AuthUserIDKey, userID)
+}
+
+func ownsID(userID uint, param string) bool {
+	id, err := strconv.ParseUint(param, 10, 64)
+	return err == nil && id == uint64(userID)
+}
+
+func bearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
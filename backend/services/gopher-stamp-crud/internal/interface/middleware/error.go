@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	openapi "2025_gopher_StampRally/services/gopher-stamp-crud/swagger"
+
+	"github.com/gin-gonic/gin"
+)
+
+var statusByCode = map[apperr.Code]int{
+	apperr.CodeInvalidRequest:    http.StatusBadRequest,
+	apperr.CodeUnauthorized:      http.StatusUnauthorized,
+	apperr.CodeForbidden:         http.StatusForbidden,
+	apperr.CodeInvalidStampToken: http.StatusForbidden,
+	apperr.CodeInvalidStampCode:  http.StatusForbidden,
+	apperr.CodeStampNotActive:    http.StatusForbidden,
+	apperr.CodeNotFound:          http.StatusNotFound,
+	apperr.CodeAlreadyExists:     http.StatusConflict,
+	apperr.CodeRewardNotEarned:   http.StatusConflict,
+	apperr.CodeAlreadyRedeemed:   http.StatusConflict,
+	apperr.CodeInvalidIcon:       http.StatusBadRequest,
+	apperr.CodeTooManyRequests:   http.StatusTooManyRequests,
+	apperr.CodeInternal:          http.StatusInternalServerError,
+}
+
+// ErrorHandler writes the last error attached with c.Error as an openapi.Error response.
+// Errors that are not an *apperr.Error are logged and reported as INTERNAL_ERROR without their message.
+// It must be registered before the routes it covers.
+func ErrorHandler(c *gin.Context) {
+	c.Next()
+
+	if len(c.Errors) == 0 || c.Writer.Written() {
+		return
+	}
+
+	err := c.Errors.Last().Err
+	var appErr *apperr.Error
+	if !errors.As(err, &appErr) {
+		log.Printf("%s %s: %v", c.Request.Method, c.Request.URL.Path, err)
+		appErr = apperr.New(apperr.CodeInternal, "Internal server error")
+	}
+
+	status, ok := statusByCode[appErr.Code]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+
+	resp := openapi.Error{
+		Code:    string(appErr.Code),
+		Message: appErr.Message,
+	}
+	if appErr.Details != "" {
+		resp.Details = &appErr.Details
+	}
+	c.JSON(status, resp)
+}
+
+// OpenAPIErrorHandler reports parameter binding failures from the generated wrappers
+// through ErrorHandler, so they share the openapi.Error format.
+func OpenAPIErrorHandler(c *gin.Context, err error, _ int) {
+	_ = c.Error(apperr.New(apperr.CodeInvalidRequest, "Invalid request parameters").WithDetails(err.Error()))
+}
+
+// abortWithError attaches err for ErrorHandler and stops the handler chain.
+func abortWithError(c *gin.Context, err error) {
+	_ = c.Error(err)
+	c.Abort()
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/stamptoken"
+	openapi "2025_gopher_StampRally/services/gopher-stamp-crud/swagger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	details := "name is required"
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		want       openapi.Error
+	}{
+		{
+			name:       "not found",
+			err:        apperr.ErrStampNotFound,
+			wantStatus: http.StatusNotFound,
+			want:       openapi.Error{Code: "NOT_FOUND", Message: "stamp not found"},
+		},
+		{
+			name:       "wrapped conflict",
+			err:        fmt.Errorf("acquire: %w", apperr.ErrStampAlreadyAcquired),
+			wantStatus: http.StatusConflict,
+			want:       openapi.Error{Code: "ALREADY_EXISTS", Message: "stamp already acquired"},
+		},
+		{
+			name:       "stamp token",
+			err:        stamptoken.ErrExpiredToken,
+			wantStatus: http.StatusForbidden,
+			want:       openapi.Error{Code: "INVALID_STAMP_TOKEN", Message: "stamp token expired"},
+		},
+		{
+			name:       "too many requests",
+			err:        stamptoken.ErrTooManyCodeFailures,
+			wantStatus: http.StatusTooManyRequests,
+			want:       openapi.Error{Code: "TOO_MANY_REQUESTS", Message: "too many invalid stamp codes"},
+		},
+		{
+			name:       "details",
+			err:        apperr.New(apperr.CodeInvalidRequest, "Invalid request body").WithDetails(details),
+			wantStatus: http.StatusBadRequest,
+			want:       openapi.Error{Code: "INVALID_REQUEST", Message: "Invalid request body", Details: &details},
+		},
+		{
+			name:       "unexpected error is not leaked",
+			err:        assert.AnError,
+			wantStatus: http.StatusInternalServerError,
+			want:       openapi.Error{Code: "INTERNAL_ERROR", Message: "Internal server error"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(ErrorHandler)
+			r.GET("/", func(c *gin.Context) {
+				_ = c.Error(tt.err)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			var got openapi.Error
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestErrorHandler_ResponseAlreadyWritten(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	r.Use(ErrorHandler)
+	r.GET("/", func(c *gin.Context) {
+		c.String(http.StatusOK, "partial")
+		_ = c.Error(assert.AnError)
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "partial", w.Body.String())
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+type AuthUseCase interface {
+	// IssueToken creates a new opaque bearer token for the user.
+	IssueToken(ctx context.Context, userID uint) (string, error)
+	// Authenticate returns the ID of the user the token was issued to.
+	Authenticate(ctx context.Context, token string) (uint, error)
+}
+
+type authUseCase struct {
+	sessionRepo repository.UserSessionRepository
+}
+
+func NewAuthUseCase(sessionRepo repository.UserSessionRepository) AuthUseCase {
+	return &authUseCase{
+		sessionRepo: sessionRepo,
+	}
+}
+
+func (uc *authUseCase) IssueToken(ctx context.Context, userID uint) (string, error) {
+	return issueToken(ctx, uc.sessionRepo, userID)
+}
+
+func (uc *authUseCase) Authenticate(ctx context.Context, token string) (uint, error) {
+	if token == "" {
+		return 0, apperr.ErrInvalidCredentials
+	}
+
+	session, err := uc.sessionRepo.FindByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, apperr.ErrInvalidCredentials
+		}
+		return 0, err
+	}
+
+	return session.UserID, nil
+}
+
+// issueToken creates a session for the user and returns its token. Called in a transaction, the
+// session is stored only if the transaction commits.
+func issueToken(ctx context.Context, sessionRepo repository.UserSessionRepository, userID uint) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	session := &entity.UserSession{
+		TokenHash: hashToken(token),
+		UserID:    userID,
+	}
+	if err := sessionRepo.Create(ctx, session); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// hashToken returns the value stored in user_sessions, so a database leak does not expose usable tokens.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	mock "2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/mock_repository"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestAuthUseCase_IssueToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionRepo := mock.NewMockUserSessionRepository(ctrl)
+	usecase := NewAuthUseCase(mockSessionRepo)
+
+	tests := []struct {
+		name    string
+		userID  uint
+		mockFn  func()
+		wantErr bool
+	}{
+		{
+			name:   "success",
+			userID: 1,
+			mockFn: func() {
+				mockSessionRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, session *entity.UserSession) error {
+						assert.Equal(t, uint(1), session.UserID)
+						assert.Len(t, session.TokenHash, 64)
+						return nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name:   "database error",
+			userID: 1,
+			mockFn: func() {
+				mockSessionRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+			got, err := usecase.IssueToken(context.Background(), tt.userID)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Empty(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, got)
+			}
+		})
+	}
+}
+
+func TestAuthUseCase_Authenticate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionRepo := mock.NewMockUserSessionRepository(ctrl)
+	usecase := NewAuthUseCase(mockSessionRepo)
+
+	tests := []struct {
+		name    string
+		token   string
+		mockFn  func()
+		want    uint
+		wantErr bool
+		errIs   error
+	}{
+		{
+			name:  "success",
+			token: "valid-token",
+			mockFn: func() {
+				mockSessionRepo.EXPECT().
+					FindByTokenHash(gomock.Any(), hashToken("valid-token")).
+					Return(&entity.UserSession{TokenHash: hashToken("valid-token"), UserID: 1}, nil)
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name:    "empty token",
+			token:   "",
+			mockFn:  func() {},
+			wantErr: true,
+			errIs:   apperr.ErrInvalidCredentials,
+		},
+		{
+			name:  "unknown token",
+			token: "unknown-token",
+			mockFn: func() {
+				mockSessionRepo.EXPECT().
+					FindByTokenHash(gomock.Any(), hashToken("unknown-token")).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrInvalidCredentials,
+		},
+		{
+			name:  "database error",
+			token: "valid-token",
+			mockFn: func() {
+				mockSessionRepo.EXPECT().
+					FindByTokenHash(gomock.Any(), hashToken("valid-token")).
+					Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+			got, err := usecase.Authenticate(context.Background(), tt.token)
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
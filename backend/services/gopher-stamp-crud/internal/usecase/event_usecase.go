@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+type EventUseCase interface {
+	ListEvents(ctx context.Context) ([]entity.Event, error)
+	GetEvent(ctx context.Context, id uint) (*entity.Event, error)
+	CreateEvent(ctx context.Context, name string, startsAt, endsAt time.Time) (*entity.Event, error)
+}
+
+type eventUseCase struct {
+	eventRepo repository.EventRepository
+}
+
+func NewEventUseCase(eventRepo repository.EventRepository) EventUseCase {
+	return &eventUseCase{
+		eventRepo: eventRepo,
+	}
+}
+
+func (uc *eventUseCase) ListEvents(ctx context.Context) ([]entity.Event, error) {
+	return uc.eventRepo.FindAll(ctx)
+}
+
+func (uc *eventUseCase) GetEvent(ctx context.Context, id uint) (*entity.Event, error) {
+	return findEvent(ctx, uc.eventRepo, id)
+}
+
+func (uc *eventUseCase) CreateEvent(ctx context.Context, name string, startsAt, endsAt time.Time) (*entity.Event, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, apperr.ErrEventNameRequired
+	}
+	if !endsAt.After(startsAt) {
+		return nil, apperr.ErrInvalidEventPeriod
+	}
+
+	event := &entity.Event{
+		Name:     name,
+		StartsAt: &startsAt,
+		EndsAt:   &endsAt,
+	}
+
+	if err := uc.eventRepo.Create(ctx, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// findEvent loads the event that an event-scoped operation targets, so that an unknown
+// event ID is reported as such instead of as an empty list or a foreign key violation.
+func findEvent(ctx context.Context, eventRepo repository.EventRepository, id uint) (*entity.Event, error) {
+	event, err := eventRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperr.ErrEventNotFound
+		}
+		return nil, err
+	}
+	return event, nil
+}
@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	mock "2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/mock_repository"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestEventUseCase_GetEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockEventRepository(ctrl)
+	usecase := NewEventUseCase(mockRepo)
+
+	tests := []struct {
+		name    string
+		id      uint
+		mockFn  func()
+		want    *entity.Event
+		wantErr bool
+		errIs   error
+	}{
+		{
+			name: "success",
+			id:   1,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1, Name: "Test Event"}, nil)
+			},
+			want:    &entity.Event{ID: 1, Name: "Test Event"},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			id:   999,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(999)).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			want:    nil,
+			wantErr: true,
+			errIs:   apperr.ErrEventNotFound,
+		},
+		{
+			name: "database error",
+			id:   1,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(nil, assert.AnError)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+			got, err := usecase.GetEvent(context.Background(), tt.id)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestEventUseCase_CreateEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockEventRepository(ctrl)
+	usecase := NewEventUseCase(mockRepo)
+
+	startsAt := time.Date(2025, 11, 22, 9, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(9 * time.Hour)
+
+	tests := []struct {
+		name     string
+		inName   string
+		startsAt time.Time
+		endsAt   time.Time
+		mockFn   func()
+		wantErr  bool
+		errIs    error
+	}{
+		{
+			name:     "success",
+			inName:   " Go Workshop ",
+			startsAt: startsAt,
+			endsAt:   endsAt,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, event *entity.Event) error {
+						event.ID = 2
+						return nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name:     "blank name",
+			inName:   "  ",
+			startsAt: startsAt,
+			endsAt:   endsAt,
+			mockFn:   func() {},
+			wantErr:  true,
+			errIs:    apperr.ErrEventNameRequired,
+		},
+		{
+			name:     "ends before it starts",
+			inName:   "Go Workshop",
+			startsAt: endsAt,
+			endsAt:   startsAt,
+			mockFn:   func() {},
+			wantErr:  true,
+			errIs:    apperr.ErrInvalidEventPeriod,
+		},
+		{
+			name:     "create error",
+			inName:   "Go Workshop",
+			startsAt: startsAt,
+			endsAt:   endsAt,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+			event, err := usecase.CreateEvent(context.Background(), tt.inName, tt.startsAt, tt.endsAt)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, event)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(2), event.ID)
+				assert.Equal(t, "Go Workshop", event.Name)
+				assert.Equal(t, tt.startsAt, *event.StartsAt)
+				assert.Equal(t, tt.endsAt, *event.EndsAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+)
+
+type FeedUseCase interface {
+	// Subscribe streams what happens in the event until ctx is done. The channel is closed early
+	// when the client cannot keep up; it should then reload the state it shows and subscribe again.
+	Subscribe(ctx context.Context, eventID uint) (<-chan entity.FeedEvent, error)
+}
+
+type feedUseCase struct {
+	feedBus   repository.FeedBus
+	eventRepo repository.EventRepository
+}
+
+func NewFeedUseCase(feedBus repository.FeedBus, eventRepo repository.EventRepository) FeedUseCase {
+	return &feedUseCase{
+		feedBus:   feedBus,
+		eventRepo: eventRepo,
+	}
+}
+
+func (uc *feedUseCase) Subscribe(ctx context.Context, eventID uint) (<-chan entity.FeedEvent, error) {
+	if _, err := findEvent(ctx, uc.eventRepo, eventID); err != nil {
+		return nil, err
+	}
+	return uc.feedBus.Subscribe(ctx, eventID), nil
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+)
+
+type GoFeatureUseCase interface {
+	// ListGoFeatures returns the catalogue participants choose their favorite Go features from.
+	ListGoFeatures(ctx context.Context) ([]entity.GoFeature, error)
+	// GetGoFeatureCounts returns how many of the event's participants chose each Go feature,
+	// most chosen first, and the number of participants of the event.
+	GetGoFeatureCounts(ctx context.Context, eventID uint) ([]entity.GoFeatureCount, int64, error)
+}
+
+type goFeatureUseCase struct {
+	goFeatureRepo repository.GoFeatureRepository
+	userRepo      repository.UserRepository
+	eventRepo     repository.EventRepository
+}
+
+func NewGoFeatureUseCase(
+	goFeatureRepo repository.GoFeatureRepository,
+	userRepo repository.UserRepository,
+	eventRepo repository.EventRepository,
+) GoFeatureUseCase {
+	return &goFeatureUseCase{
+		goFeatureRepo: goFeatureRepo,
+		userRepo:      userRepo,
+		eventRepo:     eventRepo,
+	}
+}
+
+func (uc *goFeatureUseCase) ListGoFeatures(ctx context.Context) ([]entity.GoFeature, error) {
+	return uc.goFeatureRepo.FindAll(ctx)
+}
+
+func (uc *goFeatureUseCase) GetGoFeatureCounts(ctx context.Context, eventID uint) ([]entity.GoFeatureCount, int64, error) {
+	if _, err := findEvent(ctx, uc.eventRepo, eventID); err != nil {
+		return nil, 0, err
+	}
+
+	counts, err := uc.goFeatureRepo.CountByEventID(ctx, eventID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	participants, err := uc.userRepo.Count(ctx, repository.UserFilter{EventID: eventID})
+	if err != nil {
+		return nil, 0, err
+	}
+	return counts, participants, nil
+}
+
+// chooseGoFeatures resolves the Go features chosen by a profile request, in display order.
+// Codes, when given, must all be in the catalogue. Otherwise the features are taken from the
+// codes found in the free-form favoriteGoFeature, which is how clients chose them before the
+// catalogue existed. chosen is false when the request changes neither.
+func chooseGoFeatures(ctx context.Context, repo repository.GoFeatureRepository, codes *[]string, favoriteGoFeature *string) (features []entity.GoFeature, chosen bool, err error) {
+	if codes == nil && favoriteGoFeature == nil {
+		return nil, false, nil
+	}
+
+	catalogue, err := repo.FindAll(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	known := make(map[string]bool, len(catalogue))
+	for _, f := range catalogue {
+		known[f.Code] = true
+	}
+
+	selected := make(map[string]bool)
+	if codes != nil {
+		var problems fieldProblems
+		for _, code := range *codes {
+			code = strings.TrimSpace(code)
+			if !known[code] {
+				problems.add("go_features", fmt.Sprintf("unknown feature %q", code))
+				continue
+			}
+			selected[code] = true
+		}
+		if len(problems) > 0 {
+			return nil, false, apperr.ErrInvalidUserProfile.WithDetails(strings.Join(problems, "; "))
+		}
+	} else {
+		// Free text that is not a code is kept in the column only
+		for _, code := range strings.Split(*favoriteGoFeature, ",") {
+			selected[strings.TrimSpace(code)] = true
+		}
+	}
+
+	features = []entity.GoFeature{}
+	for _, f := range catalogue {
+		if selected[f.Code] {
+			features = append(features, f)
+		}
+	}
+	return features, true, nil
+}
+
+// joinGoFeatureCodes renders chosen features as the comma-separated codes stored in
+// users.favorite_go_feature, so that clients reading that field keep working.
+func joinGoFeatureCodes(features []entity.GoFeature) *string {
+	codes := make([]string, len(features))
+	for i, f := range features {
+		codes[i] = f.Code
+	}
+	joined := strings.Join(codes, ",")
+	return nilIfEmpty(&joined)
+}
+
+func goFeatureIDs(features []entity.GoFeature) []uint {
+	ids := make([]uint, len(features))
+	for i, f := range features {
+		ids[i] = f.ID
+	}
+	return ids
+}
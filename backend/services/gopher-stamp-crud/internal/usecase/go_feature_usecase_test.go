@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	mock "2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/mock_repository"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// testGoFeatures mirrors the catalogue seeded by the migrations, in display order.
+var testGoFeatures = []entity.GoFeature{
+	{ID: 1, Code: "CONCURRENCY", Label: "並行処理（goroutines/channels）", SortOrder: 1},
+	{ID: 2, Code: "INTERFACES", Label: "インターフェース", SortOrder: 2},
+	{ID: 3, Code: "ERROR_HANDLING", Label: "エラーハンドリング", SortOrder: 3},
+	{ID: 4, Code: "PERFORMANCE", Label: "パフォーマンス", SortOrder: 4},
+	{ID: 5, Code: "STD_LIB", Label: "標準ライブラリ", SortOrder: 5},
+	{ID: 6, Code: "TESTING", Label: "テスト", SortOrder: 6},
+}
+
+func TestGoFeatureUseCase_GetGoFeatureCounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGoFeatureRepo := mock.NewMockGoFeatureRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	uc := NewGoFeatureUseCase(mockGoFeatureRepo, mockUserRepo, mockEventRepo)
+
+	counts := []entity.GoFeatureCount{
+		{GoFeature: testGoFeatures[5], UserCount: 3},
+		{GoFeature: testGoFeatures[0], UserCount: 1},
+	}
+
+	tests := []struct {
+		name             string
+		mockFn           func()
+		want             []entity.GoFeatureCount
+		wantParticipants int64
+		wantErr          error
+	}{
+		{
+			name: "success",
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(2)).
+					Return(&entity.Event{ID: 2}, nil)
+				mockGoFeatureRepo.EXPECT().
+					CountByEventID(gomock.Any(), uint(2)).
+					Return(counts, nil)
+				mockUserRepo.EXPECT().
+					Count(gomock.Any(), repository.UserFilter{EventID: 2}).
+					Return(int64(5), nil)
+			},
+			want:             counts,
+			wantParticipants: 5,
+		},
+		{
+			name: "event not found",
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(2)).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: apperr.ErrEventNotFound,
+		},
+		{
+			name: "count error",
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(2)).
+					Return(&entity.Event{ID: 2}, nil)
+				mockGoFeatureRepo.EXPECT().
+					CountByEventID(gomock.Any(), uint(2)).
+					Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+			got, participants, err := uc.GetGoFeatureCounts(context.Background(), 2)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.wantParticipants, participants)
+			}
+		})
+	}
+}
+
+func TestChooseGoFeatures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGoFeatureRepo := mock.NewMockGoFeatureRepository(ctrl)
+	mockGoFeatureRepo.EXPECT().FindAll(gomock.Any()).Return(testGoFeatures, nil).AnyTimes()
+
+	codes := func(c ...string) *[]string { return &c }
+	text := func(s string) *string { return &s }
+
+	tests := []struct {
+		name              string
+		codes             *[]string
+		favoriteGoFeature *string
+		want              []entity.GoFeature
+		wantChosen        bool
+		wantErr           string
+	}{
+		{
+			name: "nothing to choose",
+		},
+		{
+			name:       "codes in display order",
+			codes:      codes("TESTING", "CONCURRENCY", "TESTING"),
+			want:       []entity.GoFeature{testGoFeatures[0], testGoFeatures[5]},
+			wantChosen: true,
+		},
+		{
+			name:       "empty codes clear the choice",
+			codes:      codes(),
+			want:       []entity.GoFeature{},
+			wantChosen: true,
+		},
+		{
+			name:              "codes win over the free-form text",
+			codes:             codes("STD_LIB"),
+			favoriteGoFeature: text("CONCURRENCY"),
+			want:              []entity.GoFeature{testGoFeatures[4]},
+			wantChosen:        true,
+		},
+		{
+			name:    "unknown codes",
+			codes:   codes("GENERICS", "CONCURRENCY", "iota"),
+			wantErr: `go_features: unknown feature "GENERICS"; go_features: unknown feature "iota"`,
+		},
+		{
+			name:              "codes in the free-form text",
+			favoriteGoFeature: text("INTERFACES, ERROR_HANDLING"),
+			want:              []entity.GoFeature{testGoFeatures[1], testGoFeatures[2]},
+			wantChosen:        true,
+		},
+		{
+			name:              "free-form text without codes",
+			favoriteGoFeature: text("goroutineによる並行処理"),
+			want:              []entity.GoFeature{},
+			wantChosen:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, chosen, err := chooseGoFeatures(context.Background(), mockGoFeatureRepo, tt.codes, tt.favoriteGoFeature)
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, apperr.ErrInvalidUserProfile)
+				var appErr *apperr.Error
+				if assert.ErrorAs(t, err, &appErr) {
+					assert.Equal(t, tt.wantErr, appErr.Details)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantChosen, chosen)
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/icon"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+type IconUseCase interface {
+	// UploadIcon replaces the user's icon with the uploaded image. Only the URLs of the
+	// normalized icon and its thumbnail are stored on the user.
+	UploadIcon(ctx context.Context, userID uint, data []byte) (*entity.User, error)
+	// MigrateInlineIcons moves icons still stored as base64 in the users table to the blob store.
+	// Icons that cannot be decoded are left untouched and counted as failed.
+	MigrateInlineIcons(ctx context.Context) (migrated, failed int, err error)
+}
+
+type iconUseCase struct {
+	userRepo  repository.UserRepository
+	blobStore repository.BlobStore
+}
+
+func NewIconUseCase(userRepo repository.UserRepository, blobStore repository.BlobStore) IconUseCase {
+	return &iconUseCase{
+		userRepo:  userRepo,
+		blobStore: blobStore,
+	}
+}
+
+func (uc *iconUseCase) UploadIcon(ctx context.Context, userID uint, data []byte) (*entity.User, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperr.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	if err := uc.replaceIcon(ctx, user, data); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// migrationBatchSize is the number of users loaded at a time by MigrateInlineIcons.
+const migrationBatchSize = 100
+
+func (uc *iconUseCase) MigrateInlineIcons(ctx context.Context) (migrated, failed int, err error) {
+	var afterID uint
+	for {
+		users, err := uc.userRepo.FindWithInlineIcons(ctx, afterID, migrationBatchSize)
+		if err != nil {
+			return migrated, failed, err
+		}
+		if len(users) == 0 {
+			return migrated, failed, nil
+		}
+
+		for _, user := range users {
+			afterID = user.ID
+
+			data, err := icon.DecodeDataURL(*user.Icon)
+			if err == nil {
+				err = uc.replaceIcon(ctx, user, data)
+			}
+			if errors.Is(err, apperr.ErrInvalidIcon) {
+				failed++
+				continue
+			}
+			if err != nil {
+				return migrated, failed, fmt.Errorf("failed to migrate the icon of user %d: %w", user.ID, err)
+			}
+			migrated++
+		}
+	}
+}
+
+func (uc *iconUseCase) replaceIcon(ctx context.Context, user *entity.User, data []byte) error {
+	normalized, err := normalizeIcon(data)
+	if err != nil {
+		return err
+	}
+	stored, err := storeIcon(ctx, uc.userRepo, uc.blobStore, user, normalized)
+	if err != nil {
+		return err
+	}
+	// Outside a transaction the update is already committed
+	stored.committed(ctx)
+	return nil
+}
+
+// normalizedIcon is an image that passed validation, rendered as the stored icon and thumbnail.
+type normalizedIcon struct {
+	icon      icon.Image
+	thumbnail icon.Image
+}
+
+func normalizeIcon(data []byte) (*normalizedIcon, error) {
+	normalized, thumbnail, err := icon.Normalize(data)
+	if err != nil {
+		return nil, err
+	}
+	return &normalizedIcon{icon: normalized, thumbnail: thumbnail}, nil
+}
+
+// normalizeInlineIcon validates an icon sent in a profile request as a data URL or base64.
+func normalizeInlineIcon(s string) (*normalizedIcon, error) {
+	data, err := icon.DecodeDataURL(s)
+	if err != nil {
+		return nil, err
+	}
+	return normalizeIcon(data)
+}
+
+// storeIcon saves the icon and thumbnail in the blob store and points the user at them, saving
+// the user's other fields too. The update may belong to a transaction that is yet to commit, so
+// no blob the user pointed at before is deleted here; see storedIcon.
+func storeIcon(ctx context.Context, userRepo repository.UserRepository, store repository.BlobStore, user *entity.User, normalized *normalizedIcon) (*storedIcon, error) {
+	// A fresh name per upload lets clients and CDNs cache icons indefinitely
+	name, err := randomName()
+	if err != nil {
+		return nil, err
+	}
+	prefix := fmt.Sprintf("icons/%d/%s", user.ID, name)
+
+	iconURL, err := store.Put(ctx, prefix+normalized.icon.Extension(), normalized.icon.ContentType, normalized.icon.Data)
+	if err != nil {
+		return nil, err
+	}
+	thumbnailURL, err := store.Put(ctx, prefix+"_thumb"+normalized.thumbnail.Extension(), normalized.thumbnail.ContentType, normalized.thumbnail.Data)
+	if err != nil {
+		deleteBlobs(ctx, store, &iconURL)
+		return nil, err
+	}
+
+	stored := &storedIcon{
+		store:             store,
+		icon:              &iconURL,
+		thumbnail:         &thumbnailURL,
+		previousIcon:      user.Icon,
+		previousThumbnail: user.IconThumbnail,
+	}
+	user.Icon, user.IconThumbnail = stored.icon, stored.thumbnail
+	if err := userRepo.Update(ctx, user); err != nil {
+		user.Icon, user.IconThumbnail = stored.previousIcon, stored.previousThumbnail
+		stored.rolledBack(ctx)
+		return nil, err
+	}
+	return stored, nil
+}
+
+// storedIcon is an icon saved by storeIcon. Deleting a blob cannot be rolled back, so the blobs
+// left unused are deleted only once the transaction that updated the user has ended: the previous
+// icon's when it commits and the new icon's when it rolls back. A nil storedIcon deletes nothing.
+type storedIcon struct {
+	store                           repository.BlobStore
+	icon, thumbnail                 *string
+	previousIcon, previousThumbnail *string
+}
+
+// committed deletes the blobs of the icon that was replaced.
+func (s *storedIcon) committed(ctx context.Context) {
+	if s != nil {
+		deleteBlobs(ctx, s.store, s.previousIcon, s.previousThumbnail)
+	}
+}
+
+// rolledBack deletes the blobs of the new icon, which the user does not point at after all.
+func (s *storedIcon) rolledBack(ctx context.Context) {
+	if s != nil {
+		deleteBlobs(ctx, s.store, s.icon, s.thumbnail)
+	}
+}
+
+// deleteBlobs removes the blobs behind the given URLs, skipping URLs the store does not serve
+// such as inline base64 icons. Failures only leave an orphaned file behind, so they are ignored.
+func deleteBlobs(ctx context.Context, store repository.BlobStore, urls ...*string) {
+	for _, url := range urls {
+		if url == nil {
+			continue
+		}
+		if key, ok := store.KeyForURL(*url); ok {
+			_ = store.Delete(ctx, key)
+		}
+	}
+}
+
+func randomName() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate icon name: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	mock "2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/mock_repository"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func testPNG(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, 32, 32))))
+	return buf.Bytes()
+}
+
+// expectPut stores every blob under a URL derived from its key.
+func expectPut(blobStore *mock.MockBlobStore) *gomock.Call {
+	return blobStore.EXPECT().
+		Put(gomock.Any(), gomock.Any(), "image/png", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key, contentType string, data []byte) (string, error) {
+			return "http://blobs/" + key, nil
+		})
+}
+
+func TestIconUseCase_UploadIcon(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockBlobStore := mock.NewMockBlobStore(ctrl)
+	uc := NewIconUseCase(mockUserRepo, mockBlobStore)
+
+	t.Run("success replaces the previous icon", func(t *testing.T) {
+		oldIcon := "http://blobs/icons/1/old.png"
+		// The previous icon is deleted only once the user no longer points at it
+		gomock.InOrder(
+			mockUserRepo.EXPECT().
+				FindByID(gomock.Any(), uint(1)).
+				Return(&entity.User{ID: 1, Icon: &oldIcon}, nil),
+			expectPut(mockBlobStore).Times(2),
+			mockUserRepo.EXPECT().
+				Update(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, user *entity.User) error {
+					assert.Regexp(t, `^http://blobs/icons/1/[0-9a-f]{32}\.png$`, *user.Icon)
+					assert.Equal(t, strings.TrimSuffix(*user.Icon, ".png")+"_thumb.png", *user.IconThumbnail)
+					return nil
+				}),
+			mockBlobStore.EXPECT().KeyForURL(oldIcon).Return("icons/1/old.png", true),
+			mockBlobStore.EXPECT().Delete(gomock.Any(), "icons/1/old.png").Return(nil),
+		)
+
+		user, err := uc.UploadIcon(context.Background(), 1, testPNG(t))
+		require.NoError(t, err)
+		assert.NotEqual(t, oldIcon, *user.Icon)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		mockUserRepo.EXPECT().
+			FindByID(gomock.Any(), uint(2)).
+			Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := uc.UploadIcon(context.Background(), 2, testPNG(t))
+		assert.ErrorIs(t, err, apperr.ErrUserNotFound)
+	})
+
+	t.Run("invalid image is not stored", func(t *testing.T) {
+		mockUserRepo.EXPECT().
+			FindByID(gomock.Any(), uint(1)).
+			Return(&entity.User{ID: 1}, nil)
+
+		_, err := uc.UploadIcon(context.Background(), 1, []byte("not an image"))
+		assert.ErrorIs(t, err, apperr.ErrInvalidIcon)
+	})
+
+	t.Run("update failure removes the new blobs", func(t *testing.T) {
+		oldIcon := "http://blobs/icons/1/old.png"
+		mockUserRepo.EXPECT().
+			FindByID(gomock.Any(), uint(1)).
+			Return(&entity.User{ID: 1, Icon: &oldIcon}, nil)
+		expectPut(mockBlobStore).Times(2)
+		mockUserRepo.EXPECT().
+			Update(gomock.Any(), gomock.Any()).
+			Return(assert.AnError)
+		mockBlobStore.EXPECT().
+			KeyForURL(gomock.Any()).
+			DoAndReturn(func(url string) (string, bool) {
+				assert.NotEqual(t, oldIcon, url, "the previous icon must be kept")
+				return strings.TrimPrefix(url, "http://blobs/"), true
+			}).
+			Times(2)
+		mockBlobStore.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		_, err := uc.UploadIcon(context.Background(), 1, testPNG(t))
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestIconUseCase_MigrateInlineIcons(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockBlobStore := mock.NewMockBlobStore(ctrl)
+	uc := NewIconUseCase(mockUserRepo, mockBlobStore)
+
+	dataURL := "data:image/png;base64," + base64.StdEncoding.EncodeToString(testPNG(t))
+	bare := base64.StdEncoding.EncodeToString(testPNG(t))
+	broken := "data:image/png;base64,!!!"
+
+	gomock.InOrder(
+		mockUserRepo.EXPECT().
+			FindWithInlineIcons(gomock.Any(), uint(0), migrationBatchSize).
+			Return([]*entity.User{{ID: 1, Icon: &dataURL}, {ID: 3, Icon: &broken}}, nil),
+		mockUserRepo.EXPECT().
+			FindWithInlineIcons(gomock.Any(), uint(3), migrationBatchSize).
+			Return([]*entity.User{{ID: 4, Icon: &bare}}, nil),
+		mockUserRepo.EXPECT().
+			FindWithInlineIcons(gomock.Any(), uint(4), migrationBatchSize).
+			Return(nil, nil),
+	)
+	expectPut(mockBlobStore).Times(4)
+	mockUserRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	// The inline icons being replaced are not blobs
+	mockBlobStore.EXPECT().KeyForURL(gomock.Any()).Return("", false).Times(2)
+
+	migrated, failed, err := uc.MigrateInlineIcons(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, migrated)
+	assert.Equal(t, 1, failed)
+}
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+)
+
+// feedSink tells the venue screen who registered, who acquired a stamp and how that moves them on the leaderboard.
+type feedSink struct {
+	feedBus repository.FeedBus
+}
+
+func NewFeedOutboxSink(feedBus repository.FeedBus) repository.OutboxSink {
+	return &feedSink{feedBus: feedBus}
+}
+
+func (s *feedSink) Publish(ctx context.Context, message entity.OutboxMessage) error {
+	switch message.Type {
+	case entity.OutboxUserRegistered:
+		return s.publishRegistration(message)
+	case entity.OutboxStampAcquired:
+		return s.publishAcquisition(message)
+	}
+	return nil
+}
+
+func (s *feedSink) publishRegistration(message entity.OutboxMessage) error {
+	var registration entity.UserRegistration
+	if err := message.Decode(&registration); err != nil {
+		return err
+	}
+
+	s.feedBus.Publish(entity.FeedEvent{
+		Type:          entity.FeedUserRegistered,
+		EventID:       registration.EventID,
+		UserID:        registration.UserID,
+		UserName:      registration.UserName,
+		IconThumbnail: registration.IconThumbnail,
+		OccurredAt:    registration.RegisteredAt,
+	})
+	return nil
+}
+
+func (s *feedSink) publishAcquisition(message entity.OutboxMessage) error {
+	var acquisition entity.StampAcquisition
+	if err := message.Decode(&acquisition); err != nil {
+		return err
+	}
+
+	event := entity.FeedEvent{
+		Type:          entity.FeedStampAcquired,
+		EventID:       acquisition.EventID,
+		UserID:        acquisition.UserID,
+		UserName:      acquisition.UserName,
+		IconThumbnail: acquisition.IconThumbnail,
+		StampID:       acquisition.StampID,
+		StampName:     acquisition.StampName,
+		OccurredAt:    acquisition.AcquiredAt,
+	}
+	s.feedBus.Publish(event)
+
+	event.Type = entity.FeedLeaderboardChanged
+	event.StampID, event.StampName = 0, ""
+	event.StampCount = acquisition.StampCount
+	s.feedBus.Publish(event)
+	return nil
+}
+
+// webhookSink notifies the event's webhooks of an acquisition and of the participant completing the rally with it.
+type webhookSink struct {
+	webhooks repository.WebhookDispatcher
+}
+
+func NewWebhookOutboxSink(webhooks repository.WebhookDispatcher) repository.OutboxSink {
+	return &webhookSink{webhooks: webhooks}
+}
+
+func (s *webhookSink) Publish(ctx context.Context, message entity.OutboxMessage) error {
+	if message.Type != entity.OutboxStampAcquired {
+		return nil
+	}
+	var acquisition entity.StampAcquisition
+	if err := message.Decode(&acquisition); err != nil {
+		return err
+	}
+
+	// IDs derived from the message stay the same when the relay publishes it again,
+	// so receivers can tell the duplicate apart
+	notification := entity.WebhookEvent{
+		ID:         fmt.Sprintf("%s-%d", entity.WebhookStampAcquired, message.ID),
+		Type:       entity.WebhookStampAcquired,
+		EventID:    acquisition.EventID,
+		UserID:     acquisition.UserID,
+		UserName:   acquisition.UserName,
+		StampID:    acquisition.StampID,
+		StampName:  acquisition.StampName,
+		StampCount: acquisition.StampCount,
+		OccurredAt: acquisition.AcquiredAt,
+	}
+	if err := s.webhooks.Dispatch(ctx, notification); err != nil {
+		return err
+	}
+	if acquisition.CompletesRally {
+		notification.ID = fmt.Sprintf("%s-%d", entity.WebhookRallyCompleted, message.ID)
+		notification.Type = entity.WebhookRallyCompleted
+		return s.webhooks.Dispatch(ctx, notification)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	mock "2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/mock_repository"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeedOutboxSink_Publish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFeedBus := mock.NewMockFeedBus(ctrl)
+	sink := NewFeedOutboxSink(mockFeedBus)
+
+	acquiredAt := time.Date(2025, 11, 22, 10, 0, 0, 0, time.UTC)
+	thumbnail := "http://blobs/icon_thumb.png"
+
+	// The venue screen hears of the acquisition and of the new stamp count
+	gomock.InOrder(
+		mockFeedBus.EXPECT().Publish(entity.FeedEvent{
+			Type:          entity.FeedStampAcquired,
+			EventID:       1,
+			UserID:        2,
+			UserName:      "Test User",
+			IconThumbnail: &thumbnail,
+			StampID:       3,
+			StampName:     "Test Stamp",
+			OccurredAt:    acquiredAt,
+		}),
+		mockFeedBus.EXPECT().Publish(entity.FeedEvent{
+			Type:          entity.FeedLeaderboardChanged,
+			EventID:       1,
+			UserID:        2,
+			UserName:      "Test User",
+			IconThumbnail: &thumbnail,
+			StampCount:    4,
+			OccurredAt:    acquiredAt,
+		}),
+	)
+
+	message := newOutboxMessage(t, entity.OutboxStampAcquired, 2, entity.StampAcquisition{
+		EventID:       1,
+		UserID:        2,
+		UserName:      "Test User",
+		IconThumbnail: &thumbnail,
+		StampID:       3,
+		StampName:     "Test Stamp",
+		StampCount:    4,
+		AcquiredAt:    acquiredAt,
+	})
+	assert.NoError(t, sink.Publish(context.Background(), *message))
+
+	// And of registrations
+	registeredAt := acquiredAt.Add(-time.Hour)
+	mockFeedBus.EXPECT().Publish(entity.FeedEvent{
+		Type:          entity.FeedUserRegistered,
+		EventID:       1,
+		UserID:        2,
+		UserName:      "Test User",
+		IconThumbnail: &thumbnail,
+		OccurredAt:    registeredAt,
+	})
+	message = newOutboxMessage(t, entity.OutboxUserRegistered, 2, entity.UserRegistration{
+		EventID:       1,
+		UserID:        2,
+		UserName:      "Test User",
+		IconThumbnail: &thumbnail,
+		RegisteredAt:  registeredAt,
+	})
+	assert.NoError(t, sink.Publish(context.Background(), *message))
+
+	// Messages of other types are ignored
+	assert.NoError(t, sink.Publish(context.Background(), entity.OutboxMessage{Type: "user.deleted", Payload: "{}"}))
+
+	assert.Error(t, sink.Publish(context.Background(), entity.OutboxMessage{Type: entity.OutboxStampAcquired, Payload: "not json"}))
+	assert.Error(t, sink.Publish(context.Background(), entity.OutboxMessage{Type: entity.OutboxUserRegistered, Payload: "not json"}))
+}
+
+func TestWebhookOutboxSink_Publish(t *testing.T) {
+	acquiredAt := time.Date(2025, 11, 22, 10, 0, 0, 0, time.UTC)
+	acquisition := entity.StampAcquisition{
+		EventID:    1,
+		UserID:     2,
+		UserName:   "Test User",
+		StampID:    3,
+		StampName:  "Test Stamp",
+		StampCount: 4,
+		AcquiredAt: acquiredAt,
+	}
+	acquired := entity.WebhookEvent{
+		ID:         "stamp.acquired-7",
+		Type:       entity.WebhookStampAcquired,
+		EventID:    1,
+		UserID:     2,
+		UserName:   "Test User",
+		StampID:    3,
+		StampName:  "Test Stamp",
+		StampCount: 4,
+		OccurredAt: acquiredAt,
+	}
+	completed := acquired
+	completed.ID = "rally.completed-7"
+	completed.Type = entity.WebhookRallyCompleted
+
+	tests := []struct {
+		name           string
+		completesRally bool
+		want           []entity.WebhookEvent
+	}{
+		{name: "acquisition", want: []entity.WebhookEvent{acquired}},
+		// The acquisition is followed by the completion, carrying the same details
+		{name: "last stamp completes the rally", completesRally: true, want: []entity.WebhookEvent{acquired, completed}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockWebhooks := mock.NewMockWebhookDispatcher(ctrl)
+			sink := NewWebhookOutboxSink(mockWebhooks)
+
+			var calls []*gomock.Call
+			for _, event := range tt.want {
+				calls = append(calls, mockWebhooks.EXPECT().Dispatch(gomock.Any(), event).Return(nil))
+			}
+			gomock.InOrder(calls...)
+
+			acquisition := acquisition
+			acquisition.CompletesRally = tt.completesRally
+			message := newOutboxMessage(t, entity.OutboxStampAcquired, acquisition.UserID, acquisition)
+			message.ID = 7
+			assert.NoError(t, sink.Publish(context.Background(), *message))
+		})
+	}
+}
+
+func TestWebhookOutboxSink_PublishError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockWebhooks := mock.NewMockWebhookDispatcher(ctrl)
+	sink := NewWebhookOutboxSink(mockWebhooks)
+
+	// The message stays pending, so the relay retries it
+	mockWebhooks.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Return(assert.AnError)
+	message := newOutboxMessage(t, entity.OutboxStampAcquired, 2, entity.StampAcquisition{EventID: 1, UserID: 2, CompletesRally: true})
+	assert.ErrorIs(t, sink.Publish(context.Background(), *message), assert.AnError)
+}
@@ -0,0 +1,293 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+type RewardUseCase interface {
+	ListRewardRules(ctx context.Context, eventID uint) ([]entity.RewardRule, error)
+	// CreateRewardRule defines a prize of the event. requiredCount is used by entity.RewardKindCount
+	// and stampIDs, which must be stamps of the event, by entity.RewardKindStamps.
+	CreateRewardRule(ctx context.Context, eventID uint, name string, kind entity.RewardKind, requiredCount int, stampIDs []uint) (*entity.RewardRule, error)
+	// ListUserRewards returns the rewards the participant has earned. Rewards are granted as stamps are
+	// acquired; participants who met a rule before it was created receive it from GrantRewards.
+	ListUserRewards(ctx context.Context, userID uint) ([]entity.UserReward, error)
+	// GrantRewards grants every participant of the event the rewards they have earned but not received,
+	// e.g. after a rule was added during the event, and returns how many it granted.
+	GrantRewards(ctx context.Context, eventID uint) (int, error)
+	// RedeemReward marks the prize as handed out. Each earned reward can be redeemed only once.
+	RedeemReward(ctx context.Context, userID, ruleID uint) (*entity.UserReward, error)
+}
+
+type rewardUseCase struct {
+	ruleRepo       repository.RewardRuleRepository
+	userRewardRepo repository.UserRewardRepository
+	userRepo       repository.UserRepository
+	stampRepo      repository.StampRepository
+	eventRepo      repository.EventRepository
+	granter        *rewardGranter
+}
+
+func NewRewardUseCase(
+	ruleRepo repository.RewardRuleRepository,
+	userRewardRepo repository.UserRewardRepository,
+	userRepo repository.UserRepository,
+	stampRepo repository.StampRepository,
+	userStampRepo repository.UserStampRepository,
+	eventRepo repository.EventRepository,
+) RewardUseCase {
+	return &rewardUseCase{
+		ruleRepo:       ruleRepo,
+		userRewardRepo: userRewardRepo,
+		userRepo:       userRepo,
+		stampRepo:      stampRepo,
+		eventRepo:      eventRepo,
+		granter:        newRewardGranter(ruleRepo, userRewardRepo, stampRepo, userStampRepo),
+	}
+}
+
+func (uc *rewardUseCase) ListRewardRules(ctx context.Context, eventID uint) ([]entity.RewardRule, error) {
+	if _, err := findEvent(ctx, uc.eventRepo, eventID); err != nil {
+		return nil, err
+	}
+	return uc.ruleRepo.FindByEventID(ctx, eventID)
+}
+
+func (uc *rewardUseCase) CreateRewardRule(ctx context.Context, eventID uint, name string, kind entity.RewardKind, requiredCount int, stampIDs []uint) (*entity.RewardRule, error) {
+	if _, err := findEvent(ctx, uc.eventRepo, eventID); err != nil {
+		return nil, err
+	}
+
+	rule := &entity.RewardRule{
+		EventID: eventID,
+		Name:    strings.TrimSpace(name),
+		Kind:    kind,
+	}
+	if rule.Name == "" {
+		return nil, apperr.ErrInvalidRewardRule.WithDetails("name must be provided")
+	}
+
+	switch kind {
+	case entity.RewardKindAll:
+	case entity.RewardKindCount:
+		if requiredCount < 1 {
+			return nil, apperr.ErrInvalidRewardRule.WithDetails("required_count must be at least 1")
+		}
+		rule.RequiredCount = requiredCount
+	case entity.RewardKindStamps:
+		if len(stampIDs) == 0 {
+			return nil, apperr.ErrInvalidRewardRule.WithDetails("stamp_ids must not be empty")
+		}
+		seen := make(map[uint]bool, len(stampIDs))
+		for _, id := range stampIDs {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+
+			stamp, err := uc.stampRepo.FindByID(ctx, id)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, err
+			}
+			if err != nil || stamp.EventID != eventID {
+				return nil, apperr.ErrInvalidRewardRule.WithDetails("stamp_ids must be stamps of the event")
+			}
+			rule.Stamps = append(rule.Stamps, *stamp)
+		}
+	default:
+		return nil, apperr.ErrInvalidRewardRule.WithDetails(`kind must be one of "all", "count" or "stamps"`)
+	}
+
+	if err := uc.ruleRepo.Create(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (uc *rewardUseCase) ListUserRewards(ctx context.Context, userID uint) ([]entity.UserReward, error) {
+	if _, err := uc.findUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	return uc.userRewardRepo.FindByUserID(ctx, userID)
+}
+
+// grantPageSize is the number of participants GrantRewards loads at a time.
+const grantPageSize = 500
+
+func (uc *rewardUseCase) GrantRewards(ctx context.Context, eventID uint) (int, error) {
+	if _, err := findEvent(ctx, uc.eventRepo, eventID); err != nil {
+		return 0, err
+	}
+
+	// Participants registering meanwhile are appended in registration order, so none is skipped
+	filter := repository.UserFilter{EventID: eventID}
+	granted := 0
+	for offset := 0; ; offset += grantPageSize {
+		users, err := uc.userRepo.FindAll(ctx, filter, repository.UserSortCreatedAt, false, grantPageSize, offset)
+		if err != nil {
+			return 0, err
+		}
+		for _, user := range users {
+			n, err := uc.granter.grant(ctx, user)
+			if err != nil {
+				return 0, err
+			}
+			granted += n
+		}
+		if len(users) < grantPageSize {
+			return granted, nil
+		}
+	}
+}
+
+func (uc *rewardUseCase) RedeemReward(ctx context.Context, userID, ruleID uint) (*entity.UserReward, error) {
+	user, err := uc.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := uc.ruleRepo.FindByID(ctx, ruleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperr.ErrRewardRuleNotFound
+		}
+		return nil, err
+	}
+	if rule.EventID != user.EventID {
+		return nil, apperr.ErrRewardRuleNotFound
+	}
+
+	userRewards, err := uc.userRewardRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var reward *entity.UserReward
+	for i := range userRewards {
+		if userRewards[i].RewardRuleID == ruleID {
+			reward = &userRewards[i]
+			break
+		}
+	}
+	if reward == nil {
+		return nil, apperr.ErrRewardNotEarned
+	}
+	if reward.RedeemedAt != nil {
+		return nil, apperr.ErrRewardAlreadyRedeemed
+	}
+
+	now := time.Now()
+	redeemed, err := uc.userRewardRepo.MarkRedeemed(ctx, userID, ruleID, now)
+	if err != nil {
+		return nil, err
+	}
+	if !redeemed {
+		// Another staff member redeemed it between the check above and the update
+		return nil, apperr.ErrRewardAlreadyRedeemed
+	}
+
+	reward.RedeemedAt = &now
+	return reward, nil
+}
+
+func (uc *rewardUseCase) findUser(ctx context.Context, id uint) (*entity.User, error) {
+	user, err := uc.userRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperr.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// rewardGranter evaluates the reward rules of a participant's event and records the rewards they
+// have newly earned. It is idempotent, so it can run after every acquisition as well as in a backfill.
+type rewardGranter struct {
+	ruleRepo       repository.RewardRuleRepository
+	userRewardRepo repository.UserRewardRepository
+	stampRepo      repository.StampRepository
+	userStampRepo  repository.UserStampRepository
+}
+
+func newRewardGranter(
+	ruleRepo repository.RewardRuleRepository,
+	userRewardRepo repository.UserRewardRepository,
+	stampRepo repository.StampRepository,
+	userStampRepo repository.UserStampRepository,
+) *rewardGranter {
+	return &rewardGranter{
+		ruleRepo:       ruleRepo,
+		userRewardRepo: userRewardRepo,
+		stampRepo:      stampRepo,
+		userStampRepo:  userStampRepo,
+	}
+}
+
+// grant returns the number of rewards it granted.
+func (g *rewardGranter) grant(ctx context.Context, user *entity.User) (int, error) {
+	rules, err := g.ruleRepo.FindByEventID(ctx, user.EventID)
+	if err != nil {
+		return 0, err
+	}
+	if len(rules) == 0 {
+		return 0, nil
+	}
+
+	earned, err := g.userRewardRepo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return 0, err
+	}
+	alreadyEarned := make(map[uint]bool, len(earned))
+	for _, ur := range earned {
+		alreadyEarned[ur.RewardRuleID] = true
+	}
+
+	userStamps, err := g.userStampRepo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return 0, err
+	}
+	acquired := make(map[uint]bool, len(userStamps))
+	for _, us := range userStamps {
+		acquired[us.StampID] = true
+	}
+
+	// Only "all" rules need the size of the event, so count its stamps at most once and only when needed
+	var eventStampCount int64 = -1
+	granted := 0
+	for i := range rules {
+		rule := &rules[i]
+		if alreadyEarned[rule.ID] {
+			continue
+		}
+		if rule.Kind == entity.RewardKindAll && eventStampCount < 0 {
+			if eventStampCount, err = g.stampRepo.Count(ctx, user.EventID); err != nil {
+				return 0, err
+			}
+		}
+		if !rule.IsSatisfied(acquired, eventStampCount) {
+			continue
+		}
+
+		// A concurrent request may have granted it first; the reward is earned either way
+		err := g.userRewardRepo.Create(ctx, &entity.UserReward{UserID: user.ID, RewardRuleID: rule.ID})
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		granted++
+	}
+
+	return granted, nil
+}
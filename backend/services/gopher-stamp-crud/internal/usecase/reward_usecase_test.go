@@ -0,0 +1,463 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	mock "2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/mock_repository"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestRewardUseCase_CreateRewardRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRuleRepo := mock.NewMockRewardRuleRepository(ctrl)
+	mockUserRewardRepo := mock.NewMockUserRewardRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockStampRepo := mock.NewMockStampRepository(ctrl)
+	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	usecase := NewRewardUseCase(mockRuleRepo, mockUserRewardRepo, mockUserRepo, mockStampRepo, mockUserStampRepo, mockEventRepo)
+
+	mockEventRepo.EXPECT().
+		FindByID(gomock.Any(), uint(1)).
+		Return(&entity.Event{ID: 1}, nil).
+		AnyTimes()
+
+	tests := []struct {
+		name          string
+		eventID       uint
+		inName        string
+		kind          entity.RewardKind
+		requiredCount int
+		stampIDs      []uint
+		mockFn        func()
+		wantStamps    int
+		wantErr       bool
+		errIs         error
+	}{
+		{
+			name:    "all stamps",
+			eventID: 1,
+			inName:  " コンプリート賞 ",
+			kind:    entity.RewardKindAll,
+			mockFn: func() {
+				mockRuleRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:          "any 5 stamps",
+			eventID:       1,
+			inName:        "参加賞",
+			kind:          entity.RewardKindCount,
+			requiredCount: 5,
+			mockFn: func() {
+				mockRuleRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:     "specific stamps",
+			eventID:  1,
+			inName:   "ワークショップ賞",
+			kind:     entity.RewardKindStamps,
+			stampIDs: []uint{1, 2, 1},
+			mockFn: func() {
+				mockStampRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&entity.Stamp{ID: 1, EventID: 1}, nil)
+				mockStampRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(&entity.Stamp{ID: 2, EventID: 1}, nil)
+				mockRuleRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantStamps: 2,
+		},
+		{
+			name:    "event not found",
+			eventID: 999,
+			inName:  "コンプリート賞",
+			kind:    entity.RewardKindAll,
+			mockFn: func() {
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), uint(999)).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrEventNotFound,
+		},
+		{
+			name:    "blank name",
+			eventID: 1,
+			inName:  "  ",
+			kind:    entity.RewardKindAll,
+			mockFn:  func() {},
+			wantErr: true,
+			errIs:   apperr.ErrInvalidRewardRule,
+		},
+		{
+			name:    "unknown kind",
+			eventID: 1,
+			inName:  "コンプリート賞",
+			kind:    "most",
+			mockFn:  func() {},
+			wantErr: true,
+			errIs:   apperr.ErrInvalidRewardRule,
+		},
+		{
+			name:    "count without required_count",
+			eventID: 1,
+			inName:  "参加賞",
+			kind:    entity.RewardKindCount,
+			mockFn:  func() {},
+			wantErr: true,
+			errIs:   apperr.ErrInvalidRewardRule,
+		},
+		{
+			name:    "stamps without stamp_ids",
+			eventID: 1,
+			inName:  "ワークショップ賞",
+			kind:    entity.RewardKindStamps,
+			mockFn:  func() {},
+			wantErr: true,
+			errIs:   apperr.ErrInvalidRewardRule,
+		},
+		{
+			name:     "stamp of another event",
+			eventID:  1,
+			inName:   "ワークショップ賞",
+			kind:     entity.RewardKindStamps,
+			stampIDs: []uint{3},
+			mockFn: func() {
+				mockStampRepo.EXPECT().FindByID(gomock.Any(), uint(3)).Return(&entity.Stamp{ID: 3, EventID: 2}, nil)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrInvalidRewardRule,
+		},
+		{
+			name:     "unknown stamp",
+			eventID:  1,
+			inName:   "ワークショップ賞",
+			kind:     entity.RewardKindStamps,
+			stampIDs: []uint{999},
+			mockFn: func() {
+				mockStampRepo.EXPECT().FindByID(gomock.Any(), uint(999)).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrInvalidRewardRule,
+		},
+		{
+			name:    "create error",
+			eventID: 1,
+			inName:  "コンプリート賞",
+			kind:    entity.RewardKindAll,
+			mockFn: func() {
+				mockRuleRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+			rule, err := usecase.CreateRewardRule(context.Background(), tt.eventID, tt.inName, tt.kind, tt.requiredCount, tt.stampIDs)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, rule)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.eventID, rule.EventID)
+				assert.NotContains(t, rule.Name, " ")
+				assert.Equal(t, tt.requiredCount, rule.RequiredCount)
+				assert.Len(t, rule.Stamps, tt.wantStamps)
+			}
+		})
+	}
+}
+
+func TestRewardUseCase_ListUserRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRuleRepo := mock.NewMockRewardRuleRepository(ctrl)
+	mockUserRewardRepo := mock.NewMockUserRewardRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockStampRepo := mock.NewMockStampRepository(ctrl)
+	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	usecase := NewRewardUseCase(mockRuleRepo, mockUserRewardRepo, mockUserRepo, mockStampRepo, mockUserStampRepo, mockEventRepo)
+
+	earned := []entity.UserReward{{UserID: 1, RewardRuleID: 1}}
+
+	tests := []struct {
+		name    string
+		userID  uint
+		mockFn  func()
+		want    []entity.UserReward
+		wantErr bool
+		errIs   error
+	}{
+		{
+			// Listing only reads: no rule is evaluated and no reward is granted
+			name:   "success",
+			userID: 1,
+			mockFn: func() {
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&entity.User{ID: 1, EventID: 1}, nil)
+				mockUserRewardRepo.EXPECT().FindByUserID(gomock.Any(), uint(1)).Return(earned, nil)
+			},
+			want: earned,
+		},
+		{
+			name:   "user not found",
+			userID: 999,
+			mockFn: func() {
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), uint(999)).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+			got, err := usecase.ListUserRewards(context.Background(), tt.userID)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRewardUseCase_GrantRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRuleRepo := mock.NewMockRewardRuleRepository(ctrl)
+	mockUserRewardRepo := mock.NewMockUserRewardRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockStampRepo := mock.NewMockStampRepository(ctrl)
+	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	usecase := NewRewardUseCase(mockRuleRepo, mockUserRewardRepo, mockUserRepo, mockStampRepo, mockUserStampRepo, mockEventRepo)
+
+	rules := []entity.RewardRule{
+		{ID: 1, EventID: 1, Name: "参加賞", Kind: entity.RewardKindCount, RequiredCount: 1},
+		{ID: 2, EventID: 1, Name: "ワークショップ賞", Kind: entity.RewardKindStamps, Stamps: []entity.Stamp{{ID: 2}}},
+	}
+	users := []*entity.User{{ID: 1, EventID: 1}, {ID: 2, EventID: 1}, {ID: 3, EventID: 1}}
+	filter := repository.UserFilter{EventID: 1}
+
+	tests := []struct {
+		name    string
+		eventID uint
+		mockFn  func()
+		want    int
+		wantErr bool
+		errIs   error
+	}{
+		{
+			name:    "success",
+			eventID: 1,
+			mockFn: func() {
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&entity.Event{ID: 1}, nil)
+				mockUserRepo.EXPECT().
+					FindAll(gomock.Any(), filter, repository.UserSortCreatedAt, false, grantPageSize, 0).
+					Return(users, nil)
+				mockRuleRepo.EXPECT().FindByEventID(gomock.Any(), uint(1)).Return(rules, nil).Times(3)
+
+				// User 1 holds stamp 1 and receives the reward for any stamp
+				mockUserRewardRepo.EXPECT().FindByUserID(gomock.Any(), uint(1)).Return(nil, nil)
+				mockUserStampRepo.EXPECT().FindByUserID(gomock.Any(), uint(1)).Return([]entity.UserStamp{{UserID: 1, StampID: 1}}, nil)
+				mockUserRewardRepo.EXPECT().Create(gomock.Any(), &entity.UserReward{UserID: 1, RewardRuleID: 1}).Return(nil)
+
+				// User 2 already has that one and now receives the one for stamp 2
+				mockUserRewardRepo.EXPECT().FindByUserID(gomock.Any(), uint(2)).Return([]entity.UserReward{{UserID: 2, RewardRuleID: 1}}, nil)
+				mockUserStampRepo.EXPECT().FindByUserID(gomock.Any(), uint(2)).Return([]entity.UserStamp{{UserID: 2, StampID: 1}, {UserID: 2, StampID: 2}}, nil)
+				mockUserRewardRepo.EXPECT().Create(gomock.Any(), &entity.UserReward{UserID: 2, RewardRuleID: 2}).Return(nil)
+
+				// User 3 acquired their stamp meanwhile, and the acquisition granted the reward first
+				mockUserRewardRepo.EXPECT().FindByUserID(gomock.Any(), uint(3)).Return(nil, nil)
+				mockUserStampRepo.EXPECT().FindByUserID(gomock.Any(), uint(3)).Return([]entity.UserStamp{{UserID: 3, StampID: 1}}, nil)
+				mockUserRewardRepo.EXPECT().Create(gomock.Any(), &entity.UserReward{UserID: 3, RewardRuleID: 1}).Return(gorm.ErrDuplicatedKey)
+			},
+			want: 2,
+		},
+		{
+			name:    "event not found",
+			eventID: 999,
+			mockFn: func() {
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), uint(999)).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrEventNotFound,
+		},
+		{
+			name:    "database error",
+			eventID: 1,
+			mockFn: func() {
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&entity.Event{ID: 1}, nil)
+				mockUserRepo.EXPECT().
+					FindAll(gomock.Any(), filter, repository.UserSortCreatedAt, false, grantPageSize, 0).
+					Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+			got, err := usecase.GrantRewards(context.Background(), tt.eventID)
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRewardUseCase_RedeemReward(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRuleRepo := mock.NewMockRewardRuleRepository(ctrl)
+	mockUserRewardRepo := mock.NewMockUserRewardRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockStampRepo := mock.NewMockStampRepository(ctrl)
+	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	usecase := NewRewardUseCase(mockRuleRepo, mockUserRewardRepo, mockUserRepo, mockStampRepo, mockUserStampRepo, mockEventRepo)
+
+	rule := entity.RewardRule{ID: 1, EventID: 1, Name: "参加賞", Kind: entity.RewardKindCount, RequiredCount: 1}
+	redeemedAt := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name    string
+		userID  uint
+		ruleID  uint
+		mockFn  func()
+		wantErr bool
+		errIs   error
+	}{
+		{
+			name:   "success",
+			userID: 1,
+			ruleID: 1,
+			mockFn: func() {
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&entity.User{ID: 1, EventID: 1}, nil)
+				mockRuleRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&rule, nil)
+				earned := []entity.UserReward{{UserID: 1, RewardRuleID: 1, RewardRule: rule}}
+				mockUserRewardRepo.EXPECT().FindByUserID(gomock.Any(), uint(1)).Return(earned, nil)
+				mockUserRewardRepo.EXPECT().MarkRedeemed(gomock.Any(), uint(1), uint(1), gomock.Any()).Return(true, nil)
+			},
+		},
+		{
+			name:   "user not found",
+			userID: 999,
+			ruleID: 1,
+			mockFn: func() {
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), uint(999)).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrUserNotFound,
+		},
+		{
+			name:   "reward not found",
+			userID: 1,
+			ruleID: 999,
+			mockFn: func() {
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&entity.User{ID: 1, EventID: 1}, nil)
+				mockRuleRepo.EXPECT().FindByID(gomock.Any(), uint(999)).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrRewardRuleNotFound,
+		},
+		{
+			name:   "reward of another event",
+			userID: 1,
+			ruleID: 2,
+			mockFn: func() {
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&entity.User{ID: 1, EventID: 1}, nil)
+				mockRuleRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(&entity.RewardRule{ID: 2, EventID: 2}, nil)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrRewardRuleNotFound,
+		},
+		{
+			name:   "not earned",
+			userID: 1,
+			ruleID: 2,
+			mockFn: func() {
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&entity.User{ID: 1, EventID: 1}, nil)
+				mockRuleRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(&entity.RewardRule{ID: 2, EventID: 1}, nil)
+				earned := []entity.UserReward{{UserID: 1, RewardRuleID: 1, RewardRule: rule}}
+				mockUserRewardRepo.EXPECT().FindByUserID(gomock.Any(), uint(1)).Return(earned, nil)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrRewardNotEarned,
+		},
+		{
+			name:   "already redeemed",
+			userID: 1,
+			ruleID: 1,
+			mockFn: func() {
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&entity.User{ID: 1, EventID: 1}, nil)
+				mockRuleRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&rule, nil)
+				earned := []entity.UserReward{{UserID: 1, RewardRuleID: 1, RedeemedAt: &redeemedAt, RewardRule: rule}}
+				mockUserRewardRepo.EXPECT().FindByUserID(gomock.Any(), uint(1)).Return(earned, nil)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrRewardAlreadyRedeemed,
+		},
+		{
+			name:   "redeemed concurrently",
+			userID: 1,
+			ruleID: 1,
+			mockFn: func() {
+				mockUserRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&entity.User{ID: 1, EventID: 1}, nil)
+				mockRuleRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&rule, nil)
+				earned := []entity.UserReward{{UserID: 1, RewardRuleID: 1, RewardRule: rule}}
+				mockUserRewardRepo.EXPECT().FindByUserID(gomock.Any(), uint(1)).Return(earned, nil)
+				mockUserRewardRepo.EXPECT().MarkRedeemed(gomock.Any(), uint(1), uint(1), gomock.Any()).Return(false, nil)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrRewardAlreadyRedeemed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+			got, err := usecase.RedeemReward(context.Background(), tt.userID, tt.ruleID)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ruleID, got.RewardRuleID)
+				assert.NotNil(t, got.RedeemedAt)
+			}
+		})
+	}
+}
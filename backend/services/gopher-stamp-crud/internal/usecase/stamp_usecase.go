@@ -3,38 +3,59 @@ package usecase
 import (
 	"context"
 	"errors"
+	"time"
 
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/stamptoken"
 
 	"gorm.io/gorm"
 )
 
 type StampUseCase interface {
-	ListStamps(ctx context.Context, limit, offset int) ([]entity.Stamp, int64, error)
+	ListStamps(ctx context.Context, eventID uint, limit, offset int) ([]entity.Stamp, int64, error)
 	GetStamp(ctx context.Context, id uint) (*entity.Stamp, error)
-	CreateStamp(ctx context.Context, name string) (*entity.Stamp, error)
-	UpdateStamp(ctx context.Context, id uint, name *string) (*entity.Stamp, error)
+	CreateStamp(ctx context.Context, eventID uint, name string, availableFrom, availableUntil *time.Time) (*entity.Stamp, error)
+	// UpdateStamp replaces the name and acquisition window of the stamp. Nil bounds remove the limit.
+	UpdateStamp(ctx context.Context, id uint, name *string, availableFrom, availableUntil *time.Time) (*entity.Stamp, error)
 	DeleteStamp(ctx context.Context, id uint) error
+	IssueStampToken(ctx context.Context, id uint) (string, time.Time, error)
+	GetStampCode(ctx context.Context, id uint) (string, time.Time, error)
 }
 
 type stampUseCase struct {
-	stampRepo repository.StampRepository
+	stampRepo   repository.StampRepository
+	eventRepo   repository.EventRepository
+	tokenSigner *stamptoken.Signer
+	codeRotator *stamptoken.Rotator
 }
 
-func NewStampUseCase(stampRepo repository.StampRepository) StampUseCase {
+func NewStampUseCase(
+	stampRepo repository.StampRepository,
+	eventRepo repository.EventRepository,
+	tokenSigner *stamptoken.Signer,
+	codeRotator *stamptoken.Rotator,
+) StampUseCase {
 	return &stampUseCase{
-		stampRepo: stampRepo,
+		stampRepo:   stampRepo,
+		eventRepo:   eventRepo,
+		tokenSigner: tokenSigner,
+		codeRotator: codeRotator,
 	}
 }
 
-func (uc *stampUseCase) ListStamps(ctx context.Context, limit, offset int) ([]entity.Stamp, int64, error) {
-	stamps, err := uc.stampRepo.FindAll(ctx, limit, offset)
+func (uc *stampUseCase) ListStamps(ctx context.Context, eventID uint, limit, offset int) ([]entity.Stamp, int64, error) {
+	if _, err := findEvent(ctx, uc.eventRepo, eventID); err != nil {
+		return nil, 0, err
+	}
+
+	stamps, err := uc.stampRepo.FindAll(ctx, eventID, limit, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	total, err := uc.stampRepo.Count(ctx)
+	total, err := uc.stampRepo.Count(ctx, eventID)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -46,16 +67,33 @@ func (uc *stampUseCase) GetStamp(ctx context.Context, id uint) (*entity.Stamp, e
 	stamp, err := uc.stampRepo.FindByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("stamp not found")
+			return nil, apperr.ErrStampNotFound
 		}
 		return nil, err
 	}
 	return stamp, nil
 }
 
-func (uc *stampUseCase) CreateStamp(ctx context.Context, name string) (*entity.Stamp, error) {
+func (uc *stampUseCase) CreateStamp(ctx context.Context, eventID uint, name string, availableFrom, availableUntil *time.Time) (*entity.Stamp, error) {
+	if err := validateStampWindow(availableFrom, availableUntil); err != nil {
+		return nil, err
+	}
+
+	if _, err := findEvent(ctx, uc.eventRepo, eventID); err != nil {
+		return nil, err
+	}
+
+	secret, err := stamptoken.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
 	stamp := &entity.Stamp{
-		Name: name,
+		EventID:        eventID,
+		Name:           name,
+		Secret:         secret,
+		AvailableFrom:  availableFrom,
+		AvailableUntil: availableUntil,
 	}
 
 	if err := uc.stampRepo.Create(ctx, stamp); err != nil {
@@ -65,21 +103,26 @@ func (uc *stampUseCase) CreateStamp(ctx context.Context, name string) (*entity.S
 	return stamp, nil
 }
 
-func (uc *stampUseCase) UpdateStamp(ctx context.Context, id uint, name *string) (*entity.Stamp, error) {
+func (uc *stampUseCase) UpdateStamp(ctx context.Context, id uint, name *string, availableFrom, availableUntil *time.Time) (*entity.Stamp, error) {
 	// バリデーション: nameがnilの場合はエラー
 	if name == nil {
-		return nil, errors.New("name must be provided")
+		return nil, apperr.ErrStampNameRequired
+	}
+	if err := validateStampWindow(availableFrom, availableUntil); err != nil {
+		return nil, err
 	}
 
 	stamp, err := uc.stampRepo.FindByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("stamp not found")
+			return nil, apperr.ErrStampNotFound
 		}
 		return nil, err
 	}
 
 	stamp.Name = *name
+	stamp.AvailableFrom = availableFrom
+	stamp.AvailableUntil = availableUntil
 
 	if err := uc.stampRepo.Update(ctx, stamp); err != nil {
 		return nil, err
@@ -93,10 +136,44 @@ func (uc *stampUseCase) DeleteStamp(ctx context.Context, id uint) error {
 	_, err := uc.stampRepo.FindByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("stamp not found")
+			return apperr.ErrStampNotFound
 		}
 		return err
 	}
 
 	return uc.stampRepo.Delete(ctx, id)
 }
+
+// IssueStampToken returns a signed acquisition token for the stamp, to be embedded in its QR code.
+func (uc *stampUseCase) IssueStampToken(ctx context.Context, id uint) (string, time.Time, error) {
+	if _, err := uc.stampRepo.FindByID(ctx, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", time.Time{}, apperr.ErrStampNotFound
+		}
+		return "", time.Time{}, err
+	}
+
+	token, expiresAt := uc.tokenSigner.Issue(id)
+	return token, expiresAt, nil
+}
+
+// GetStampCode returns the rotating code currently shown on the stamp's booth screen and when it rotates.
+// Every stamp has a secret: it is generated on creation and seeding, and by a migration for older stamps.
+func (uc *stampUseCase) GetStampCode(ctx context.Context, id uint) (string, time.Time, error) {
+	stamp, err := uc.stampRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", time.Time{}, apperr.ErrStampNotFound
+		}
+		return "", time.Time{}, err
+	}
+
+	return uc.codeRotator.Current(stamp.Secret)
+}
+
+func validateStampWindow(availableFrom, availableUntil *time.Time) error {
+	if availableFrom != nil && availableUntil != nil && !availableUntil.After(*availableFrom) {
+		return apperr.ErrInvalidStampWindow
+	}
+	return nil
+}
@@ -5,8 +5,10 @@ import (
 	"testing"
 	"time"
 
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
 	mock "2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/mock_repository"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/stamptoken"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -18,7 +20,8 @@ func TestStampUseCase_ListStamps(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockStampRepository(ctrl)
-	usecase := NewStampUseCase(mockRepo)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	usecase := NewStampUseCase(mockRepo, mockEventRepo, stamptoken.NewSigner([]byte("test-secret"), time.Hour), stamptoken.NewRotator(30*time.Second))
 
 	now := time.Now()
 
@@ -36,14 +39,17 @@ func TestStampUseCase_ListStamps(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
 				mockRepo.EXPECT().
-					FindAll(gomock.Any(), 10, 0).
+					FindAll(gomock.Any(), uint(1), 10, 0).
 					Return([]entity.Stamp{
 						{ID: 1, Name: "Stamp 1", CreatedAt: now, UpdatedAt: now},
 						{ID: 2, Name: "Stamp 2", CreatedAt: now, UpdatedAt: now},
 					}, nil)
 				mockRepo.EXPECT().
-					Count(gomock.Any()).
+					Count(gomock.Any(), uint(1)).
 					Return(int64(2), nil)
 			},
 			wantStamps: []entity.Stamp{
@@ -58,13 +64,16 @@ func TestStampUseCase_ListStamps(t *testing.T) {
 			limit:  5,
 			offset: 5,
 			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
 				mockRepo.EXPECT().
-					FindAll(gomock.Any(), 5, 5).
+					FindAll(gomock.Any(), uint(1), 5, 5).
 					Return([]entity.Stamp{
 						{ID: 6, Name: "Stamp 6", CreatedAt: now, UpdatedAt: now},
 					}, nil)
 				mockRepo.EXPECT().
-					Count(gomock.Any()).
+					Count(gomock.Any(), uint(1)).
 					Return(int64(10), nil)
 			},
 			wantStamps: []entity.Stamp{
@@ -78,24 +87,43 @@ func TestStampUseCase_ListStamps(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
 				mockRepo.EXPECT().
-					FindAll(gomock.Any(), 10, 0).
+					FindAll(gomock.Any(), uint(1), 10, 0).
 					Return([]entity.Stamp{}, nil)
 				mockRepo.EXPECT().
-					Count(gomock.Any()).
+					Count(gomock.Any(), uint(1)).
 					Return(int64(0), nil)
 			},
 			wantStamps: []entity.Stamp{},
 			wantTotal:  0,
 			wantErr:    false,
 		},
+		{
+			name:   "event not found",
+			limit:  10,
+			offset: 0,
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantStamps: nil,
+			wantTotal:  0,
+			wantErr:    true,
+		},
 		{
 			name:   "FindAll error",
 			limit:  10,
 			offset: 0,
 			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
 				mockRepo.EXPECT().
-					FindAll(gomock.Any(), 10, 0).
+					FindAll(gomock.Any(), uint(1), 10, 0).
 					Return(nil, assert.AnError)
 			},
 			wantStamps: nil,
@@ -107,11 +135,14 @@ func TestStampUseCase_ListStamps(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
 				mockRepo.EXPECT().
-					FindAll(gomock.Any(), 10, 0).
+					FindAll(gomock.Any(), uint(1), 10, 0).
 					Return([]entity.Stamp{}, nil)
 				mockRepo.EXPECT().
-					Count(gomock.Any()).
+					Count(gomock.Any(), uint(1)).
 					Return(int64(0), assert.AnError)
 			},
 			wantStamps: nil,
@@ -123,7 +154,7 @@ func TestStampUseCase_ListStamps(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockFn()
-			stamps, total, err := usecase.ListStamps(context.Background(), tt.limit, tt.offset)
+			stamps, total, err := usecase.ListStamps(context.Background(), 1, tt.limit, tt.offset)
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Nil(t, stamps)
@@ -142,7 +173,7 @@ func TestStampUseCase_GetStamp(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockStampRepository(ctrl)
-	usecase := NewStampUseCase(mockRepo)
+	usecase := NewStampUseCase(mockRepo, mock.NewMockEventRepository(ctrl), stamptoken.NewSigner([]byte("test-secret"), time.Hour), stamptoken.NewRotator(30*time.Second))
 
 	now := time.Now()
 
@@ -152,7 +183,7 @@ func TestStampUseCase_GetStamp(t *testing.T) {
 		mockFn  func()
 		want    *entity.Stamp
 		wantErr bool
-		errMsg  string
+		errIs   error
 	}{
 		{
 			name: "success",
@@ -185,7 +216,7 @@ func TestStampUseCase_GetStamp(t *testing.T) {
 			},
 			want:    nil,
 			wantErr: true,
-			errMsg:  "stamp not found",
+			errIs:   apperr.ErrStampNotFound,
 		},
 		{
 			name: "database error",
@@ -207,8 +238,8 @@ func TestStampUseCase_GetStamp(t *testing.T) {
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Nil(t, got)
-				if tt.errMsg != "" {
-					assert.EqualError(t, err, tt.errMsg)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
 				}
 			} else {
 				assert.NoError(t, err)
@@ -223,18 +254,30 @@ func TestStampUseCase_CreateStamp(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockStampRepository(ctrl)
-	usecase := NewStampUseCase(mockRepo)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	usecase := NewStampUseCase(mockRepo, mockEventRepo, stamptoken.NewSigner([]byte("test-secret"), time.Hour), stamptoken.NewRotator(30*time.Second))
+
+	opensAt := time.Date(2025, 11, 22, 13, 0, 0, 0, time.UTC)
+	closesAt := opensAt.Add(4 * time.Hour)
 
 	tests := []struct {
 		name    string
 		inName  string
+		inFrom  *time.Time
+		inUntil *time.Time
 		mockFn  func()
 		wantErr bool
+		errIs   error
 	}{
 		{
-			name:   "success",
-			inName: "New Stamp",
+			name:    "success",
+			inName:  "New Stamp",
+			inFrom:  &opensAt,
+			inUntil: &closesAt,
 			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
 				mockRepo.EXPECT().
 					Create(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, stamp *entity.Stamp) error {
@@ -244,10 +287,33 @@ func TestStampUseCase_CreateStamp(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:    "window ends before it opens",
+			inName:  "Backwards Stamp",
+			inFrom:  &closesAt,
+			inUntil: &opensAt,
+			mockFn:  func() {},
+			wantErr: true,
+			errIs:   apperr.ErrInvalidStampWindow,
+		},
+		{
+			name:   "event not found",
+			inName: "Orphan Stamp",
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrEventNotFound,
+		},
 		{
 			name:   "create error",
 			inName: "Failed Stamp",
 			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
 				mockRepo.EXPECT().
 					Create(gomock.Any(), gomock.Any()).
 					Return(assert.AnError)
@@ -259,14 +325,21 @@ func TestStampUseCase_CreateStamp(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockFn()
-			stamp, err := usecase.CreateStamp(context.Background(), tt.inName)
+			stamp, err := usecase.CreateStamp(context.Background(), 1, tt.inName, tt.inFrom, tt.inUntil)
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Nil(t, stamp)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, stamp)
+				assert.Equal(t, uint(1), stamp.EventID)
 				assert.Equal(t, tt.inName, stamp.Name)
+				assert.Equal(t, tt.inFrom, stamp.AvailableFrom)
+				assert.Equal(t, tt.inUntil, stamp.AvailableUntil)
+				assert.NotEmpty(t, stamp.Secret)
 			}
 		})
 	}
@@ -277,24 +350,30 @@ func TestStampUseCase_UpdateStamp(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockStampRepository(ctrl)
-	usecase := NewStampUseCase(mockRepo)
+	usecase := NewStampUseCase(mockRepo, mock.NewMockEventRepository(ctrl), stamptoken.NewSigner([]byte("test-secret"), time.Hour), stamptoken.NewRotator(30*time.Second))
 
 	now := time.Now()
 	newName := "Updated Name"
+	opensAt := time.Date(2025, 11, 22, 13, 0, 0, 0, time.UTC)
+	closesAt := opensAt.Add(4 * time.Hour)
 
 	tests := []struct {
 		name    string
 		id      uint
 		inName  *string
+		inFrom  *time.Time
+		inUntil *time.Time
 		mockFn  func()
 		want    *entity.Stamp
 		wantErr bool
-		errMsg  string
+		errIs   error
 	}{
 		{
-			name:   "success - update name",
-			id:     1,
-			inName: &newName,
+			name:    "success - update name and window",
+			id:      1,
+			inName:  &newName,
+			inFrom:  &opensAt,
+			inUntil: &closesAt,
 			mockFn: func() {
 				mockRepo.EXPECT().
 					FindByID(gomock.Any(), uint(1)).
@@ -309,25 +388,29 @@ func TestStampUseCase_UpdateStamp(t *testing.T) {
 					Return(nil)
 			},
 			want: &entity.Stamp{
-				ID:        1,
-				Name:      "Updated Name",
-				CreatedAt: now,
-				UpdatedAt: now,
+				ID:             1,
+				Name:           "Updated Name",
+				AvailableFrom:  &opensAt,
+				AvailableUntil: &closesAt,
+				CreatedAt:      now,
+				UpdatedAt:      now,
 			},
 			wantErr: false,
 		},
 		{
-			name:   "success - update name only",
+			name:   "success - update name only clears window",
 			id:     1,
 			inName: &newName,
 			mockFn: func() {
 				mockRepo.EXPECT().
 					FindByID(gomock.Any(), uint(1)).
 					Return(&entity.Stamp{
-						ID:        1,
-						Name:      "Original Name",
-						CreatedAt: now,
-						UpdatedAt: now,
+						ID:             1,
+						Name:           "Original Name",
+						AvailableFrom:  &opensAt,
+						AvailableUntil: &closesAt,
+						CreatedAt:      now,
+						UpdatedAt:      now,
 					}, nil)
 				mockRepo.EXPECT().
 					Update(gomock.Any(), gomock.Any()).
@@ -352,7 +435,7 @@ func TestStampUseCase_UpdateStamp(t *testing.T) {
 			},
 			want:    nil,
 			wantErr: true,
-			errMsg:  "stamp not found",
+			errIs:   apperr.ErrStampNotFound,
 		},
 		{
 			name:   "update error",
@@ -382,7 +465,20 @@ func TestStampUseCase_UpdateStamp(t *testing.T) {
 			},
 			want:    nil,
 			wantErr: true,
-			errMsg:  "name must be provided",
+			errIs:   apperr.ErrStampNameRequired,
+		},
+		{
+			name:    "validation error - window ends before it opens",
+			id:      1,
+			inName:  &newName,
+			inFrom:  &closesAt,
+			inUntil: &opensAt,
+			mockFn: func() {
+				// バリデーションで早期リターンするため、Repoのモックは呼ばれない
+			},
+			want:    nil,
+			wantErr: true,
+			errIs:   apperr.ErrInvalidStampWindow,
 		},
 		{
 			name:   "database error on FindByID",
@@ -401,12 +497,12 @@ func TestStampUseCase_UpdateStamp(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockFn()
-			got, err := usecase.UpdateStamp(context.Background(), tt.id, tt.inName)
+			got, err := usecase.UpdateStamp(context.Background(), tt.id, tt.inName, tt.inFrom, tt.inUntil)
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Nil(t, got)
-				if tt.errMsg != "" {
-					assert.EqualError(t, err, tt.errMsg)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
 				}
 			} else {
 				assert.NoError(t, err)
@@ -421,7 +517,7 @@ func TestStampUseCase_DeleteStamp(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockStampRepository(ctrl)
-	usecase := NewStampUseCase(mockRepo)
+	usecase := NewStampUseCase(mockRepo, mock.NewMockEventRepository(ctrl), stamptoken.NewSigner([]byte("test-secret"), time.Hour), stamptoken.NewRotator(30*time.Second))
 
 	now := time.Now()
 
@@ -430,7 +526,7 @@ func TestStampUseCase_DeleteStamp(t *testing.T) {
 		id      uint
 		mockFn  func()
 		wantErr bool
-		errMsg  string
+		errIs   error
 	}{
 		{
 			name: "success",
@@ -459,7 +555,7 @@ func TestStampUseCase_DeleteStamp(t *testing.T) {
 					Return(nil, gorm.ErrRecordNotFound)
 			},
 			wantErr: true,
-			errMsg:  "stamp not found",
+			errIs:   apperr.ErrStampNotFound,
 		},
 		{
 			name: "database error on FindByID",
@@ -497,11 +593,149 @@ func TestStampUseCase_DeleteStamp(t *testing.T) {
 			err := usecase.DeleteStamp(context.Background(), tt.id)
 			if tt.wantErr {
 				assert.Error(t, err)
-				if tt.errMsg != "" {
-					assert.EqualError(t, err, tt.errMsg)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStampUseCase_IssueStampToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockStampRepository(ctrl)
+	signer := stamptoken.NewSigner([]byte("test-secret"), time.Hour)
+	usecase := NewStampUseCase(mockRepo, mock.NewMockEventRepository(ctrl), signer, stamptoken.NewRotator(30*time.Second))
+
+	tests := []struct {
+		name    string
+		id      uint
+		mockFn  func()
+		wantErr bool
+		errIs   error
+	}{
+		{
+			name: "success",
+			id:   1,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Stamp{ID: 1, Name: "Test Stamp"}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "stamp not found",
+			id:   999,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(999)).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrStampNotFound,
+		},
+		{
+			name: "database error",
+			id:   1,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+			token, expiresAt, err := usecase.IssueStampToken(context.Background(), tt.id)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Empty(t, token)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, expiresAt.After(time.Now()))
+				assert.NoError(t, signer.Verify(tt.id, token))
+			}
+		})
+	}
+}
+
+func TestStampUseCase_GetStampCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockStampRepository(ctrl)
+	rotator := stamptoken.NewRotator(30 * time.Second)
+	usecase := NewStampUseCase(mockRepo, mock.NewMockEventRepository(ctrl), stamptoken.NewSigner([]byte("test-secret"), time.Hour), rotator)
+
+	secret, err := stamptoken.GenerateSecret()
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		id      uint
+		mockFn  func()
+		wantErr bool
+		errIs   error
+	}{
+		{
+			name: "success",
+			id:   1,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Stamp{ID: 1, Name: "Test Stamp", Secret: secret}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "stamp not found",
+			id:   999,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(999)).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrStampNotFound,
+		},
+		{
+			// Reading the code never writes to the stamp
+			name: "stamp without secret",
+			id:   1,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Stamp{ID: 1, Name: "Test Stamp"}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+			code, expiresAt, err := usecase.GetStampCode(context.Background(), tt.id)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Empty(t, code)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
 				}
 			} else {
 				assert.NoError(t, err)
+				assert.Len(t, code, 6)
+				assert.WithinDuration(t, time.Now(), expiresAt, 30*time.Second)
 			}
 		})
 	}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+)
+
+type StatsUseCase interface {
+	// GetEventStats summarizes the event's registrations and stamp acquisitions for its organizers.
+	GetEventStats(ctx context.Context, eventID uint) (*entity.EventStats, error)
+}
+
+type statsUseCase struct {
+	userStampRepo repository.UserStampRepository
+	userRepo      repository.UserRepository
+	eventRepo     repository.EventRepository
+}
+
+func NewStatsUseCase(
+	userStampRepo repository.UserStampRepository,
+	userRepo repository.UserRepository,
+	eventRepo repository.EventRepository,
+) StatsUseCase {
+	return &statsUseCase{
+		userStampRepo: userStampRepo,
+		userRepo:      userRepo,
+		eventRepo:     eventRepo,
+	}
+}
+
+func (uc *statsUseCase) GetEventStats(ctx context.Context, eventID uint) (*entity.EventStats, error) {
+	if _, err := findEvent(ctx, uc.eventRepo, eventID); err != nil {
+		return nil, err
+	}
+
+	participants, err := uc.userRepo.Count(ctx, repository.UserFilter{EventID: eventID})
+	if err != nil {
+		return nil, err
+	}
+
+	stamps, err := uc.userStampRepo.CountByStamp(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	hourly, err := uc.userStampRepo.CountByHour(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	completions, err := uc.userStampRepo.FindCompletions(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &entity.EventStats{
+		EventID:          eventID,
+		Participants:     participants,
+		Completed:        int64(len(completions)),
+		Stamps:           stamps,
+		Hourly:           hourly,
+		MedianCompletion: medianCompletion(completions),
+	}
+	if participants > 0 {
+		stats.CompletionRate = float64(stats.Completed) / float64(participants)
+	}
+	return stats, nil
+}
+
+// medianCompletion returns the median time the participants took to complete the rally, or nil
+// when there are none. With an even number of participants it is the mean of the middle two.
+func medianCompletion(completions []entity.Completion) *time.Duration {
+	if len(completions) == 0 {
+		return nil
+	}
+
+	durations := make([]time.Duration, len(completions))
+	for i, c := range completions {
+		durations[i] = c.CompletedAt.Sub(c.RegisteredAt)
+	}
+	slices.Sort(durations)
+
+	mid := len(durations) / 2
+	median := durations[mid]
+	if len(durations)%2 == 0 {
+		median = (durations[mid-1] + durations[mid]) / 2
+	}
+	return &median
+}
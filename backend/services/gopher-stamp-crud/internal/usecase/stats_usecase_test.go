@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	mock "2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/mock_repository"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestStatsUseCase_GetEventStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	usecase := NewStatsUseCase(mockUserStampRepo, mockUserRepo, mockEventRepo)
+
+	opening := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	stamps := []entity.StampAcquisitionCount{
+		{StampID: 1, Name: "Stamp 1", Acquisitions: 3},
+		{StampID: 2, Name: "Stamp 2", Acquisitions: 0},
+	}
+	hourly := []entity.HourlyAcquisitionCount{
+		{Hour: opening, Acquisitions: 2},
+		{Hour: opening.Add(2 * time.Hour), Acquisitions: 1},
+	}
+	median := 90 * time.Minute
+
+	tests := []struct {
+		name    string
+		mockFn  func()
+		want    *entity.EventStats
+		wantErr error
+	}{
+		{
+			name: "success",
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
+				mockUserRepo.EXPECT().
+					Count(gomock.Any(), repository.UserFilter{EventID: 1}).
+					Return(int64(4), nil)
+				mockUserStampRepo.EXPECT().
+					CountByStamp(gomock.Any(), uint(1)).
+					Return(stamps, nil)
+				mockUserStampRepo.EXPECT().
+					CountByHour(gomock.Any(), uint(1)).
+					Return(hourly, nil)
+				mockUserStampRepo.EXPECT().
+					FindCompletions(gomock.Any(), uint(1)).
+					Return([]entity.Completion{
+						{UserID: 3, RegisteredAt: opening, CompletedAt: opening.Add(time.Hour)},
+						{UserID: 1, RegisteredAt: opening, CompletedAt: opening.Add(2 * time.Hour)},
+					}, nil)
+			},
+			want: &entity.EventStats{
+				EventID:          1,
+				Participants:     4,
+				Completed:        2,
+				CompletionRate:   0.5,
+				Stamps:           stamps,
+				Hourly:           hourly,
+				MedianCompletion: &median,
+			},
+		},
+		{
+			name: "no participants",
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
+				mockUserRepo.EXPECT().
+					Count(gomock.Any(), repository.UserFilter{EventID: 1}).
+					Return(int64(0), nil)
+				mockUserStampRepo.EXPECT().
+					CountByStamp(gomock.Any(), uint(1)).
+					Return([]entity.StampAcquisitionCount{}, nil)
+				mockUserStampRepo.EXPECT().
+					CountByHour(gomock.Any(), uint(1)).
+					Return([]entity.HourlyAcquisitionCount{}, nil)
+				mockUserStampRepo.EXPECT().
+					FindCompletions(gomock.Any(), uint(1)).
+					Return([]entity.Completion{}, nil)
+			},
+			want: &entity.EventStats{
+				EventID: 1,
+				Stamps:  []entity.StampAcquisitionCount{},
+				Hourly:  []entity.HourlyAcquisitionCount{},
+			},
+		},
+		{
+			name: "event not found",
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: apperr.ErrEventNotFound,
+		},
+		{
+			name: "infrastructure error",
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
+				mockUserRepo.EXPECT().
+					Count(gomock.Any(), repository.UserFilter{EventID: 1}).
+					Return(int64(4), nil)
+				mockUserStampRepo.EXPECT().
+					CountByStamp(gomock.Any(), uint(1)).
+					Return(nil, assert.AnError)
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+			got, err := usecase.GetEventStats(context.Background(), 1)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMedianCompletion(t *testing.T) {
+	registered := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	completions := func(minutes ...int) []entity.Completion {
+		cs := make([]entity.Completion, len(minutes))
+		for i, m := range minutes {
+			cs[i] = entity.Completion{RegisteredAt: registered, CompletedAt: registered.Add(time.Duration(m) * time.Minute)}
+		}
+		return cs
+	}
+
+	tests := []struct {
+		name        string
+		completions []entity.Completion
+		want        time.Duration
+	}{
+		{name: "single", completions: completions(42), want: 42 * time.Minute},
+		{name: "odd count", completions: completions(90, 30, 60), want: 60 * time.Minute},
+		{name: "even count", completions: completions(120, 30, 60, 10), want: 45 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := medianCompletion(tt.completions)
+			if assert.NotNil(t, got) {
+				assert.Equal(t, tt.want, *got)
+			}
+		})
+	}
+
+	assert.Nil(t, medianCompletion(nil))
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Limits of the profile fields, matching the column sizes of entity.User. Like MySQL's
+// VARCHAR, they count characters rather than bytes.
+const (
+	maxNameLength              = 100
+	maxFavoriteGoFeatureLength = 500
+)
+
+// twitterHandle matches a Twitter handle without its leading @.
+var twitterHandle = regexp.MustCompile(`^[A-Za-z0-9_]{1,15}$`)
+
+// userProfile holds the normalized profile fields of a create or update request.
+// A nil field was not part of the request, and an empty Twitter ID or favorite Go
+// feature clears the field.
+type userProfile struct {
+	name              *string
+	twitterID         *string
+	favoriteGoFeature *string
+}
+
+// validateUserProfile trims and normalizes the given profile fields and checks them against
+// the limits of the users table, so that bad input is reported to the client as
+// apperr.ErrInvalidUserProfile with the problem of each field instead of failing in the database.
+func validateUserProfile(name, twitterID, favoriteGoFeature *string) (userProfile, error) {
+	var profile userProfile
+	var problems fieldProblems
+
+	if name != nil {
+		n := norm.NFC.String(strings.TrimSpace(*name))
+		switch {
+		case n == "":
+			problems.add("name", "must not be empty")
+		case utf8.RuneCountInString(n) > maxNameLength:
+			problems.add("name", fmt.Sprintf("must be at most %d characters", maxNameLength))
+		case strings.ContainsFunc(n, unicode.IsControl):
+			problems.add("name", "must not contain control characters")
+		}
+		profile.name = &n
+	}
+
+	if twitterID != nil {
+		// NFKC folds full-width characters typed with a Japanese IME, such as "＠ｇｏｐｈｅｒ"
+		t := strings.TrimPrefix(norm.NFKC.String(strings.TrimSpace(*twitterID)), "@")
+		if t != "" && !twitterHandle.MatchString(t) {
+			problems.add("twitter_id", "must be 1 to 15 letters, digits or underscores")
+		}
+		profile.twitterID = &t
+	}
+
+	if favoriteGoFeature != nil {
+		f := norm.NFC.String(strings.TrimSpace(*favoriteGoFeature))
+		switch {
+		case utf8.RuneCountInString(f) > maxFavoriteGoFeatureLength:
+			problems.add("favorite_go_feature", fmt.Sprintf("must be at most %d characters", maxFavoriteGoFeatureLength))
+		case strings.ContainsFunc(f, unicode.IsControl):
+			problems.add("favorite_go_feature", "must not contain control characters")
+		}
+		profile.favoriteGoFeature = &f
+	}
+
+	if len(problems) > 0 {
+		return userProfile{}, apperr.ErrInvalidUserProfile.WithDetails(strings.Join(problems, "; "))
+	}
+	return profile, nil
+}
+
+// fieldProblems lists the invalid fields of a request as "field: problem".
+type fieldProblems []string
+
+func (p *fieldProblems) add(field, problem string) {
+	*p = append(*p, field+": "+problem)
+}
+
+// nilIfEmpty turns the empty value of an optional field into NULL.
+func nilIfEmpty(s *string) *string {
+	if s == nil || *s == "" {
+		return nil
+	}
+	return s
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateUserProfile(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name              string
+		inName            *string
+		twitterID         *string
+		favoriteGoFeature *string
+		want              userProfile
+		wantDetails       string
+	}{
+		{
+			name: "nothing provided",
+			want: userProfile{},
+		},
+		{
+			name:              "values are trimmed",
+			inName:            ptr("  Gopher  "),
+			twitterID:         ptr(" gopher_42 "),
+			favoriteGoFeature: ptr(" CONCURRENCY,GENERICS\t"),
+			want: userProfile{
+				name:              ptr("Gopher"),
+				twitterID:         ptr("gopher_42"),
+				favoriteGoFeature: ptr("CONCURRENCY,GENERICS"),
+			},
+		},
+		{
+			name:   "name is normalized to NFC",
+			inName: ptr("ko\u0301hi\u0304"), // combining accents, as typed on macOS
+			want:   userProfile{name: ptr("k\u00f3h\u012b")},
+		},
+		{
+			name:   "name of 100 characters",
+			inName: ptr(strings.Repeat("あ", 100)),
+			want:   userProfile{name: ptr(strings.Repeat("あ", 100))},
+		},
+		{
+			name:      "leading @ is removed from the Twitter ID",
+			twitterID: ptr("@gopher"),
+			want:      userProfile{twitterID: ptr("gopher")},
+		},
+		{
+			name:      "full-width Twitter ID",
+			twitterID: ptr("＠ｇｏｐｈｅｒ＿１"),
+			want:      userProfile{twitterID: ptr("gopher_1")},
+		},
+		{
+			name:              "empty optional fields clear them",
+			twitterID:         ptr(" "),
+			favoriteGoFeature: ptr(""),
+			want:              userProfile{twitterID: ptr(""), favoriteGoFeature: ptr("")},
+		},
+		{
+			name:        "empty name",
+			inName:      ptr("   "),
+			wantDetails: "name: must not be empty",
+		},
+		{
+			name:        "name too long",
+			inName:      ptr(strings.Repeat("a", 101)),
+			wantDetails: "name: must be at most 100 characters",
+		},
+		{
+			name:        "name with control characters",
+			inName:      ptr("Go\x00pher"),
+			wantDetails: "name: must not contain control characters",
+		},
+		{
+			name:        "Twitter ID with spaces",
+			twitterID:   ptr("go pher"),
+			wantDetails: "twitter_id: must be 1 to 15 letters, digits or underscores",
+		},
+		{
+			name:        "Twitter ID too long",
+			twitterID:   ptr(strings.Repeat("a", 16)),
+			wantDetails: "twitter_id: must be 1 to 15 letters, digits or underscores",
+		},
+		{
+			name:        "Twitter ID URL",
+			twitterID:   ptr("https://x.com/gopher"),
+			wantDetails: "twitter_id: must be 1 to 15 letters, digits or underscores",
+		},
+		{
+			name:              "favorite Go feature too long",
+			favoriteGoFeature: ptr(strings.Repeat("a", 501)),
+			wantDetails:       "favorite_go_feature: must be at most 500 characters",
+		},
+		{
+			name:              "favorite Go feature with a newline",
+			favoriteGoFeature: ptr("goroutine\nchannel"),
+			wantDetails:       "favorite_go_feature: must not contain control characters",
+		},
+		{
+			name:              "every invalid field is reported",
+			inName:            ptr(""),
+			twitterID:         ptr("@@gopher"),
+			favoriteGoFeature: ptr(strings.Repeat("a", 501)),
+			wantDetails:       "name: must not be empty; twitter_id: must be 1 to 15 letters, digits or underscores; favorite_go_feature: must be at most 500 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateUserProfile(tt.inName, tt.twitterID, tt.favoriteGoFeature)
+			if tt.wantDetails != "" {
+				require.ErrorIs(t, err, apperr.ErrInvalidUserProfile)
+				var appErr *apperr.Error
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantDetails, appErr.Details)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -3,33 +3,60 @@ package usecase
 import (
 	"context"
 	"errors"
+	"time"
 
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/stamptoken"
 
 	"gorm.io/gorm"
 )
 
 type UserStampUseCase interface {
 	ListUserStamps(ctx context.Context, userID uint) ([]entity.UserStamp, error)
-	AcquireStamp(ctx context.Context, userID, stampID uint) (*entity.UserStamp, error)
+	AcquireStamp(ctx context.Context, userID, stampID uint, token, code string) (*entity.UserStamp, error)
+	// GetLeaderboard returns a page of the event's standings and the number of participants.
+	GetLeaderboard(ctx context.Context, eventID uint, limit, offset int) ([]entity.LeaderboardEntry, int64, error)
 }
 
 type userStampUseCase struct {
 	userStampRepo repository.UserStampRepository
 	userRepo      repository.UserRepository
 	stampRepo     repository.StampRepository
+	eventRepo     repository.EventRepository
+	txManager     repository.TxManager
+	rewards       *rewardGranter
+	tokenSigner   *stamptoken.Signer
+	codeRotator   *stamptoken.Rotator
+	codeLimiter   *stamptoken.CodeLimiter
+	outboxRepo    repository.OutboxRepository
 }
 
 func NewUserStampUseCase(
 	userStampRepo repository.UserStampRepository,
 	userRepo repository.UserRepository,
 	stampRepo repository.StampRepository,
+	eventRepo repository.EventRepository,
+	ruleRepo repository.RewardRuleRepository,
+	userRewardRepo repository.UserRewardRepository,
+	txManager repository.TxManager,
+	tokenSigner *stamptoken.Signer,
+	codeRotator *stamptoken.Rotator,
+	codeLimiter *stamptoken.CodeLimiter,
+	outboxRepo repository.OutboxRepository,
 ) UserStampUseCase {
 	return &userStampUseCase{
 		userStampRepo: userStampRepo,
 		userRepo:      userRepo,
 		stampRepo:     stampRepo,
+		eventRepo:     eventRepo,
+		txManager:     txManager,
+		rewards:       newRewardGranter(ruleRepo, userRewardRepo, stampRepo, userStampRepo),
+		tokenSigner:   tokenSigner,
+		codeRotator:   codeRotator,
+		codeLimiter:   codeLimiter,
+		outboxRepo:    outboxRepo,
 	}
 }
 
@@ -38,7 +65,7 @@ func (uc *userStampUseCase) ListUserStamps(ctx context.Context, userID uint) ([]
 	_, err := uc.userRepo.FindByID(ctx, userID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("user not found")
+			return nil, apperr.ErrUserNotFound
 		}
 		return nil, err
 	}
@@ -51,56 +78,154 @@ func (uc *userStampUseCase) ListUserStamps(ctx context.Context, userID uint) ([]
 	return userStamps, nil
 }
 
-func (uc *userStampUseCase) AcquireStamp(ctx context.Context, userID, stampID uint) (*entity.UserStamp, error) {
+// AcquireStamp records that the user collected the stamp and grants any reward it completes. The request must prove
+// presence at the stamp location with either the rotating code from the booth screen or the signed token from a printed QR code.
+func (uc *userStampUseCase) AcquireStamp(ctx context.Context, userID, stampID uint, token, code string) (*entity.UserStamp, error) {
 	// Check if user exists
-	_, err := uc.userRepo.FindByID(ctx, userID)
+	user, err := uc.userRepo.FindByID(ctx, userID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("user not found")
+			return nil, apperr.ErrUserNotFound
 		}
 		return nil, err
 	}
 
-	// Check if stamp exists
-	_, err = uc.stampRepo.FindByID(ctx, stampID)
+	// Check if stamp exists. Stamps of other events do not exist as far as the participant is concerned.
+	stamp, err := uc.stampRepo.FindByID(ctx, stampID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("stamp not found")
+			return nil, apperr.ErrStampNotFound
 		}
 		return nil, err
 	}
+	if stamp.EventID != user.EventID {
+		return nil, apperr.ErrStampNotFound
+	}
 
-	// Check if already acquired
-	exists, err := uc.userStampRepo.ExistsByUserIDAndStampID(ctx, userID, stampID)
+	// Check that the stamp can be collected now, within both its own and its event's window
+	event, err := findEvent(ctx, uc.eventRepo, stamp.EventID)
 	if err != nil {
 		return nil, err
 	}
-	if exists {
-		return nil, errors.New("stamp already acquired")
+	if !stamp.CanBeAcquiredAt(event, time.Now()) {
+		return nil, apperr.ErrStampNotActive.WithDetails(describeWindow(stamp.AcquisitionWindow(event)))
+	}
+
+	// Check that the request carries a code or token issued for this stamp
+	if code != "" {
+		// Failures are limited so that the code cannot be guessed by trying them all
+		if err := uc.codeLimiter.Allow(userID, stampID); err != nil {
+			return nil, err
+		}
+		if err := uc.codeRotator.Verify(stamp.Secret, code); err != nil {
+			uc.codeLimiter.Fail(userID, stampID)
+			return nil, err
+		}
+	} else if err := uc.tokenSigner.Verify(stampID, token); err != nil {
+		return nil, err
+	}
+
+	// Needed to tell whether this acquisition completes the rally
+	eventStampCount, err := uc.stampRepo.Count(ctx, stamp.EventID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Create user stamp
+	// Create user stamp. There is no separate "already acquired" check: the (user_id, stamp_id)
+	// primary key rejects the second of two concurrent requests atomically.
 	userStamp := &entity.UserStamp{
 		UserID:  userID,
 		StampID: stampID,
 	}
 
-	if err := uc.userStampRepo.Create(ctx, userStamp); err != nil {
+	// The rewards the new stamp completes are granted in the same transaction, so a participant
+	// never holds a full card without the reward for it. The acquisition is recorded in the outbox
+	// in the same transaction too, so the feed and webhooks hear of it even if the server stops after the commit.
+	acquired := userStamp
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := uc.userStampRepo.Create(ctx, userStamp); err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return apperr.ErrStampAlreadyAcquired
+			}
+			return err
+		}
+
+		// Reload with associations
+		userStamps, err := uc.userStampRepo.FindByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		// Find the newly created stamp
+		for i := range userStamps {
+			if userStamps[i].StampID == stampID {
+				acquired = &userStamps[i]
+			}
+		}
+
+		stampCount := int64(len(userStamps))
+		if err := uc.recordAcquisition(ctx, user, stamp, acquired.AcquiredAt, stampCount, stampCount == eventStampCount); err != nil {
+			return err
+		}
+		_, err = uc.rewards.grant(ctx, user)
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	// Reload with associations
-	userStamps, err := uc.userStampRepo.FindByUserID(ctx, userID)
+	return acquired, nil
+}
+
+// recordAcquisition writes the acquisition to the outbox, from where it is published to the feed and webhooks.
+func (uc *userStampUseCase) recordAcquisition(ctx context.Context, user *entity.User, stamp *entity.Stamp, acquiredAt time.Time, stampCount int64, completesRally bool) error {
+	message, err := entity.NewOutboxMessage(entity.OutboxStampAcquired, user.ID, entity.StampAcquisition{
+		EventID:        user.EventID,
+		UserID:         user.ID,
+		UserName:       user.Name,
+		IconThumbnail:  user.IconThumbnail,
+		StampID:        stamp.ID,
+		StampName:      stamp.Name,
+		StampCount:     stampCount,
+		CompletesRally: completesRally,
+		AcquiredAt:     acquiredAt,
+	})
 	if err != nil {
-		return nil, err
+		return err
 	}
+	return uc.outboxRepo.Create(ctx, message)
+}
 
-	// Find the newly created stamp
-	for _, us := range userStamps {
-		if us.StampID == stampID {
-			return &us, nil
-		}
+func (uc *userStampUseCase) GetLeaderboard(ctx context.Context, eventID uint, limit, offset int) ([]entity.LeaderboardEntry, int64, error) {
+	if _, err := findEvent(ctx, uc.eventRepo, eventID); err != nil {
+		return nil, 0, err
 	}
 
-	return userStamp, nil
+	entries, err := uc.userStampRepo.FindLeaderboard(ctx, eventID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range entries {
+		entries[i].Rank = offset + i + 1
+	}
+
+	total, err := uc.userRepo.Count(ctx, repository.UserFilter{EventID: eventID})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return entries, total, nil
+}
+
+// describeWindow tells the client when the stamp can be acquired, e.g. so that it can show "opens at 13:00".
+func describeWindow(from, until *time.Time) string {
+	switch {
+	case from != nil && until != nil:
+		return "available from " + from.Format(time.RFC3339) + " until " + until.Format(time.RFC3339)
+	case from != nil:
+		return "available from " + from.Format(time.RFC3339)
+	case until != nil:
+		return "available until " + until.Format(time.RFC3339)
+	}
+	return ""
 }
@@ -5,8 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
 	mock "2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/mock_repository"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/stamptoken"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -20,7 +23,9 @@ func TestUserStampUseCase_ListUserStamps(t *testing.T) {
 	mockUserRepo := mock.NewMockUserRepository(ctrl)
 	mockStampRepo := mock.NewMockStampRepository(ctrl)
 	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
-	usecase := NewUserStampUseCase(mockUserStampRepo, mockUserRepo, mockStampRepo)
+	signer := stamptoken.NewSigner([]byte("test-secret"), time.Hour)
+	rotator := stamptoken.NewRotator(30 * time.Second)
+	usecase := NewUserStampUseCase(mockUserStampRepo, mockUserRepo, mockStampRepo, mock.NewMockEventRepository(ctrl), mock.NewMockRewardRuleRepository(ctrl), mock.NewMockUserRewardRepository(ctrl), mock.NewMockTxManager(ctrl), signer, rotator, stamptoken.NewCodeLimiter(), mock.NewMockOutboxRepository(ctrl))
 
 	now := time.Now()
 
@@ -30,7 +35,7 @@ func TestUserStampUseCase_ListUserStamps(t *testing.T) {
 		mockFn  func()
 		want    []entity.UserStamp
 		wantErr bool
-		errMsg  string
+		errIs   error
 	}{
 		{
 			name:   "success with stamps",
@@ -96,7 +101,7 @@ func TestUserStampUseCase_ListUserStamps(t *testing.T) {
 			},
 			want:    nil,
 			wantErr: true,
-			errMsg:  "user not found",
+			errIs:   apperr.ErrUserNotFound,
 		},
 		{
 			name:   "database error on user check",
@@ -132,8 +137,8 @@ func TestUserStampUseCase_ListUserStamps(t *testing.T) {
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Nil(t, got)
-				if tt.errMsg != "" {
-					assert.EqualError(t, err, tt.errMsg)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
 				}
 			} else {
 				assert.NoError(t, err)
@@ -149,18 +154,39 @@ func TestUserStampUseCase_AcquireStamp(t *testing.T) {
 
 	mockUserRepo := mock.NewMockUserRepository(ctrl)
 	mockStampRepo := mock.NewMockStampRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
 	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
-	usecase := NewUserStampUseCase(mockUserStampRepo, mockUserRepo, mockStampRepo)
+	signer := stamptoken.NewSigner([]byte("test-secret"), time.Hour)
+	rotator := stamptoken.NewRotator(30 * time.Second)
+	mockRuleRepo := mock.NewMockRewardRuleRepository(ctrl)
+	mockTxManager := mock.NewMockTxManager(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	usecase := NewUserStampUseCase(mockUserStampRepo, mockUserRepo, mockStampRepo, mockEventRepo, mockRuleRepo, mock.NewMockUserRewardRepository(ctrl), mockTxManager, signer, rotator, stamptoken.NewCodeLimiter(), mockOutboxRepo)
+
+	// Rewards are covered by TestUserStampUseCase_AcquireStamp_Rewards; the event has none here
+	expectNoRewards(mockRuleRepo, mockTxManager)
 
 	now := time.Now()
 
+	// Acquisition windows are covered by TestUserStampUseCase_AcquireStamp_Window
+	mockEventRepo.EXPECT().
+		FindByID(gomock.Any(), gomock.Any()).
+		Return(&entity.Event{}, nil).
+		AnyTimes()
+	secret, err := stamptoken.GenerateSecret()
+	assert.NoError(t, err)
+	currentCode, _, err := rotator.Current(secret)
+	assert.NoError(t, err)
+
 	tests := []struct {
 		name    string
 		userID  uint
 		stampID uint
+		token   string // a valid token for stampID is used when empty
+		code    string
 		mockFn  func()
 		wantErr bool
-		errMsg  string
+		errIs   error
 	}{
 		{
 			name:    "success - acquire new stamp",
@@ -177,10 +203,10 @@ func TestUserStampUseCase_AcquireStamp(t *testing.T) {
 					FindByID(gomock.Any(), uint(1)).
 					Return(&entity.Stamp{ID: 1, Name: "Test Stamp"}, nil)
 
-				// Not already acquired check
-				mockUserStampRepo.EXPECT().
-					ExistsByUserIDAndStampID(gomock.Any(), uint(1), uint(1)).
-					Return(false, nil)
+				// The event has more stamps, so the rally is not completed yet
+				mockStampRepo.EXPECT().
+					Count(gomock.Any(), uint(0)).
+					Return(int64(3), nil)
 
 				// Create user stamp
 				mockUserStampRepo.EXPECT().
@@ -201,6 +227,18 @@ func TestUserStampUseCase_AcquireStamp(t *testing.T) {
 							Stamp:      entity.Stamp{ID: 1, Name: "Test Stamp"},
 						},
 					}, nil)
+
+				// The feed and webhooks hear of the acquisition through the outbox
+				mockOutboxRepo.EXPECT().
+					Create(gomock.Any(), newOutboxMessage(t, entity.OutboxStampAcquired, 1, entity.StampAcquisition{
+						UserID:     1,
+						UserName:   "Test User",
+						StampID:    1,
+						StampName:  "Test Stamp",
+						StampCount: 1,
+						AcquiredAt: now,
+					})).
+					Return(nil)
 			},
 			wantErr: false,
 		},
@@ -214,7 +252,7 @@ func TestUserStampUseCase_AcquireStamp(t *testing.T) {
 					Return(nil, gorm.ErrRecordNotFound)
 			},
 			wantErr: true,
-			errMsg:  "user not found",
+			errIs:   apperr.ErrUserNotFound,
 		},
 		{
 			name:    "stamp not found",
@@ -229,7 +267,111 @@ func TestUserStampUseCase_AcquireStamp(t *testing.T) {
 					Return(nil, gorm.ErrRecordNotFound)
 			},
 			wantErr: true,
-			errMsg:  "stamp not found",
+			errIs:   apperr.ErrStampNotFound,
+		},
+		{
+			name:    "stamp of another event",
+			userID:  1,
+			stampID: 2,
+			mockFn: func() {
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, EventID: 1, Name: "Test User"}, nil)
+				mockStampRepo.EXPECT().
+					FindByID(gomock.Any(), uint(2)).
+					Return(&entity.Stamp{ID: 2, EventID: 2, Name: "Other Event Stamp"}, nil)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrStampNotFound,
+		},
+		{
+			name:    "success - acquire with rotating code",
+			userID:  1,
+			stampID: 1,
+			code:    currentCode,
+			mockFn: func() {
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, Name: "Test User"}, nil)
+				mockStampRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Stamp{ID: 1, Name: "Test Stamp", Secret: secret}, nil)
+				mockStampRepo.EXPECT().
+					Count(gomock.Any(), uint(0)).
+					Return(int64(3), nil)
+				mockUserStampRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil)
+				mockUserStampRepo.EXPECT().
+					FindByUserID(gomock.Any(), uint(1)).
+					Return([]entity.UserStamp{{UserID: 1, StampID: 1, AcquiredAt: now}}, nil)
+				mockOutboxRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "invalid rotating code",
+			userID:  1,
+			stampID: 1,
+			code:    "invalid",
+			mockFn: func() {
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, Name: "Test User"}, nil)
+				mockStampRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Stamp{ID: 1, Name: "Test Stamp", Secret: secret}, nil)
+			},
+			wantErr: true,
+			errIs:   stamptoken.ErrInvalidCode,
+		},
+		{
+			name:    "rotating code for stamp without secret",
+			userID:  1,
+			stampID: 1,
+			code:    currentCode,
+			mockFn: func() {
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, Name: "Test User"}, nil)
+				mockStampRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Stamp{ID: 1, Name: "Test Stamp"}, nil)
+			},
+			wantErr: true,
+			errIs:   stamptoken.ErrInvalidCode,
+		},
+		{
+			name:    "invalid token",
+			userID:  1,
+			stampID: 1,
+			token:   "1.forged",
+			mockFn: func() {
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, Name: "Test User"}, nil)
+				mockStampRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Stamp{ID: 1, Name: "Test Stamp"}, nil)
+			},
+			wantErr: true,
+			errIs:   stamptoken.ErrInvalidToken,
+		},
+		{
+			name:    "token issued for another stamp",
+			userID:  1,
+			stampID: 1,
+			token:   func() string { token, _ := signer.Issue(2); return token }(),
+			mockFn: func() {
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, Name: "Test User"}, nil)
+				mockStampRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Stamp{ID: 1, Name: "Test Stamp"}, nil)
+			},
+			wantErr: true,
+			errIs:   stamptoken.ErrInvalidToken,
 		},
 		{
 			name:    "stamp already acquired (duplicate prevention)",
@@ -242,12 +384,15 @@ func TestUserStampUseCase_AcquireStamp(t *testing.T) {
 				mockStampRepo.EXPECT().
 					FindByID(gomock.Any(), uint(1)).
 					Return(&entity.Stamp{ID: 1, Name: "Test Stamp"}, nil)
+				mockStampRepo.EXPECT().
+					Count(gomock.Any(), uint(0)).
+					Return(int64(3), nil)
 				mockUserStampRepo.EXPECT().
-					ExistsByUserIDAndStampID(gomock.Any(), uint(1), uint(1)).
-					Return(true, nil)
+					Create(gomock.Any(), gomock.Any()).
+					Return(gorm.ErrDuplicatedKey)
 			},
 			wantErr: true,
-			errMsg:  "stamp already acquired",
+			errIs:   apperr.ErrStampAlreadyAcquired,
 		},
 		{
 			name:    "database error on user check",
@@ -275,7 +420,48 @@ func TestUserStampUseCase_AcquireStamp(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name:    "database error on exists check",
+			name:    "success - last stamp completes the rally",
+			userID:  1,
+			stampID: 1,
+			mockFn: func() {
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, EventID: 1, Name: "Test User"}, nil)
+				mockStampRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Stamp{ID: 1, EventID: 1, Name: "Test Stamp"}, nil)
+				mockStampRepo.EXPECT().
+					Count(gomock.Any(), uint(1)).
+					Return(int64(2), nil)
+				mockUserStampRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, us *entity.UserStamp) error {
+						us.AcquiredAt = now
+						return nil
+					})
+				mockUserStampRepo.EXPECT().
+					FindByUserID(gomock.Any(), uint(1)).
+					Return([]entity.UserStamp{
+						{UserID: 1, StampID: 2, AcquiredAt: now.Add(-time.Hour)},
+						{UserID: 1, StampID: 1, AcquiredAt: now, Stamp: entity.Stamp{ID: 1, EventID: 1, Name: "Test Stamp"}},
+					}, nil)
+				mockOutboxRepo.EXPECT().
+					Create(gomock.Any(), newOutboxMessage(t, entity.OutboxStampAcquired, 1, entity.StampAcquisition{
+						EventID:        1,
+						UserID:         1,
+						UserName:       "Test User",
+						StampID:        1,
+						StampName:      "Test Stamp",
+						StampCount:     2,
+						CompletesRally: true,
+						AcquiredAt:     now,
+					})).
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "database error on stamp count",
 			userID:  1,
 			stampID: 1,
 			mockFn: func() {
@@ -285,9 +471,9 @@ func TestUserStampUseCase_AcquireStamp(t *testing.T) {
 				mockStampRepo.EXPECT().
 					FindByID(gomock.Any(), uint(1)).
 					Return(&entity.Stamp{ID: 1, Name: "Test Stamp"}, nil)
-				mockUserStampRepo.EXPECT().
-					ExistsByUserIDAndStampID(gomock.Any(), uint(1), uint(1)).
-					Return(false, assert.AnError)
+				mockStampRepo.EXPECT().
+					Count(gomock.Any(), uint(0)).
+					Return(int64(0), assert.AnError)
 			},
 			wantErr: true,
 		},
@@ -302,9 +488,9 @@ func TestUserStampUseCase_AcquireStamp(t *testing.T) {
 				mockStampRepo.EXPECT().
 					FindByID(gomock.Any(), uint(1)).
 					Return(&entity.Stamp{ID: 1, Name: "Test Stamp"}, nil)
-				mockUserStampRepo.EXPECT().
-					ExistsByUserIDAndStampID(gomock.Any(), uint(1), uint(1)).
-					Return(false, nil)
+				mockStampRepo.EXPECT().
+					Count(gomock.Any(), uint(0)).
+					Return(int64(3), nil)
 				mockUserStampRepo.EXPECT().
 					Create(gomock.Any(), gomock.Any()).
 					Return(assert.AnError)
@@ -322,9 +508,9 @@ func TestUserStampUseCase_AcquireStamp(t *testing.T) {
 				mockStampRepo.EXPECT().
 					FindByID(gomock.Any(), uint(1)).
 					Return(&entity.Stamp{ID: 1, Name: "Test Stamp"}, nil)
-				mockUserStampRepo.EXPECT().
-					ExistsByUserIDAndStampID(gomock.Any(), uint(1), uint(1)).
-					Return(false, nil)
+				mockStampRepo.EXPECT().
+					Count(gomock.Any(), uint(0)).
+					Return(int64(3), nil)
 				mockUserStampRepo.EXPECT().
 					Create(gomock.Any(), gomock.Any()).
 					Return(nil)
@@ -335,7 +521,7 @@ func TestUserStampUseCase_AcquireStamp(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name:    "success - stamp not found in reload (fallback path)",
+			name:    "database error on outbox write",
 			userID:  1,
 			stampID: 1,
 			mockFn: func() {
@@ -345,9 +531,36 @@ func TestUserStampUseCase_AcquireStamp(t *testing.T) {
 				mockStampRepo.EXPECT().
 					FindByID(gomock.Any(), uint(1)).
 					Return(&entity.Stamp{ID: 1, Name: "Test Stamp"}, nil)
+				mockStampRepo.EXPECT().
+					Count(gomock.Any(), uint(0)).
+					Return(int64(3), nil)
+				mockUserStampRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil)
 				mockUserStampRepo.EXPECT().
-					ExistsByUserIDAndStampID(gomock.Any(), uint(1), uint(1)).
-					Return(false, nil)
+					FindByUserID(gomock.Any(), uint(1)).
+					Return([]entity.UserStamp{{UserID: 1, StampID: 1, AcquiredAt: now}}, nil)
+				// The acquisition is rolled back rather than kept without its announcement
+				mockOutboxRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name:    "success - stamp not found in reload (fallback path)",
+			userID:  1,
+			stampID: 1,
+			mockFn: func() {
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, Name: "Test User"}, nil)
+				mockStampRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Stamp{ID: 1, Name: "Test Stamp"}, nil)
+				mockStampRepo.EXPECT().
+					Count(gomock.Any(), uint(0)).
+					Return(int64(3), nil)
 				mockUserStampRepo.EXPECT().
 					Create(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, us *entity.UserStamp) error {
@@ -364,6 +577,7 @@ func TestUserStampUseCase_AcquireStamp(t *testing.T) {
 							AcquiredAt: now,
 						},
 					}, nil)
+				mockOutboxRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			wantErr: false,
 		},
@@ -372,12 +586,16 @@ func TestUserStampUseCase_AcquireStamp(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockFn()
-			got, err := usecase.AcquireStamp(context.Background(), tt.userID, tt.stampID)
+			token := tt.token
+			if token == "" {
+				token, _ = signer.Issue(tt.stampID)
+			}
+			got, err := usecase.AcquireStamp(context.Background(), tt.userID, tt.stampID, token, tt.code)
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Nil(t, got)
-				if tt.errMsg != "" {
-					assert.EqualError(t, err, tt.errMsg)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
 				}
 			} else {
 				assert.NoError(t, err)
@@ -388,3 +606,385 @@ func TestUserStampUseCase_AcquireStamp(t *testing.T) {
 		})
 	}
 }
+
+func TestUserStampUseCase_AcquireStamp_CodeFailures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockStampRepo := mock.NewMockStampRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	signer := stamptoken.NewSigner([]byte("test-secret"), time.Hour)
+	rotator := stamptoken.NewRotator(30 * time.Second)
+	usecase := NewUserStampUseCase(mock.NewMockUserStampRepository(ctrl), mockUserRepo, mockStampRepo, mockEventRepo, mock.NewMockRewardRuleRepository(ctrl), mock.NewMockUserRewardRepository(ctrl), mock.NewMockTxManager(ctrl), signer, rotator, stamptoken.NewCodeLimiter(), mock.NewMockOutboxRepository(ctrl))
+
+	secret, err := stamptoken.GenerateSecret()
+	assert.NoError(t, err)
+	currentCode, _, err := rotator.Current(secret)
+	assert.NoError(t, err)
+
+	mockUserRepo.EXPECT().
+		FindByID(gomock.Any(), uint(1)).
+		Return(&entity.User{ID: 1, Name: "Test User"}, nil).
+		AnyTimes()
+	mockStampRepo.EXPECT().
+		FindByID(gomock.Any(), uint(1)).
+		Return(&entity.Stamp{ID: 1, Name: "Test Stamp", Secret: secret}, nil).
+		AnyTimes()
+	mockEventRepo.EXPECT().
+		FindByID(gomock.Any(), gomock.Any()).
+		Return(&entity.Event{}, nil).
+		AnyTimes()
+
+	// Guessing codes soon locks the participant out of the stamp
+	for range 5 {
+		_, err := usecase.AcquireStamp(context.Background(), 1, 1, "", "000000")
+		assert.ErrorIs(t, err, stamptoken.ErrInvalidCode)
+	}
+
+	// Even the right code is rejected then, without acquiring the stamp
+	got, err := usecase.AcquireStamp(context.Background(), 1, 1, "", currentCode)
+	assert.ErrorIs(t, err, stamptoken.ErrTooManyCodeFailures)
+	assert.Nil(t, got)
+}
+
+func TestUserStampUseCase_AcquireStamp_Window(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockStampRepo := mock.NewMockStampRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
+	signer := stamptoken.NewSigner([]byte("test-secret"), time.Hour)
+	rotator := stamptoken.NewRotator(30 * time.Second)
+	mockRuleRepo := mock.NewMockRewardRuleRepository(ctrl)
+	mockTxManager := mock.NewMockTxManager(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	usecase := NewUserStampUseCase(mockUserStampRepo, mockUserRepo, mockStampRepo, mockEventRepo, mockRuleRepo, mock.NewMockUserRewardRepository(ctrl), mockTxManager, signer, rotator, stamptoken.NewCodeLimiter(), mockOutboxRepo)
+
+	// Rewards are covered by TestUserStampUseCase_AcquireStamp_Rewards; the event has none here
+	expectNoRewards(mockRuleRepo, mockTxManager)
+	mockStampRepo.EXPECT().Count(gomock.Any(), gomock.Any()).Return(int64(3), nil).AnyTimes()
+	mockOutboxRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	now := time.Now()
+	hourAgo := now.Add(-time.Hour)
+	inHour := now.Add(time.Hour)
+	token, _ := signer.Issue(1)
+
+	tests := []struct {
+		name        string
+		stamp       entity.Stamp
+		event       entity.Event
+		wantErr     bool
+		wantDetails string
+	}{
+		{
+			name:  "within both windows",
+			stamp: entity.Stamp{ID: 1, AvailableFrom: &hourAgo, AvailableUntil: &inHour},
+			event: entity.Event{ID: 1, StartsAt: &hourAgo, EndsAt: &inHour},
+		},
+		{
+			name:  "no windows",
+			stamp: entity.Stamp{ID: 1},
+			event: entity.Event{ID: 1},
+		},
+		{
+			name:        "stamp not yet open",
+			stamp:       entity.Stamp{ID: 1, AvailableFrom: &inHour},
+			event:       entity.Event{ID: 1},
+			wantErr:     true,
+			wantDetails: "available from " + inHour.Format(time.RFC3339),
+		},
+		{
+			name:        "stamp closed",
+			stamp:       entity.Stamp{ID: 1, AvailableUntil: &hourAgo},
+			event:       entity.Event{ID: 1},
+			wantErr:     true,
+			wantDetails: "available until " + hourAgo.Format(time.RFC3339),
+		},
+		{
+			name:        "event over although the stamp is open",
+			stamp:       entity.Stamp{ID: 1, AvailableUntil: &inHour},
+			event:       entity.Event{ID: 1, StartsAt: &hourAgo, EndsAt: &hourAgo},
+			wantErr:     true,
+			wantDetails: "available from " + hourAgo.Format(time.RFC3339) + " until " + hourAgo.Format(time.RFC3339),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.stamp.EventID = tt.event.ID
+			mockUserRepo.EXPECT().
+				FindByID(gomock.Any(), uint(1)).
+				Return(&entity.User{ID: 1, EventID: tt.event.ID}, nil)
+			mockStampRepo.EXPECT().
+				FindByID(gomock.Any(), uint(1)).
+				Return(&tt.stamp, nil)
+			mockEventRepo.EXPECT().
+				FindByID(gomock.Any(), tt.event.ID).
+				Return(&tt.event, nil)
+			if !tt.wantErr {
+				mockUserStampRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil)
+				mockUserStampRepo.EXPECT().
+					FindByUserID(gomock.Any(), uint(1)).
+					Return([]entity.UserStamp{{UserID: 1, StampID: 1, AcquiredAt: now}}, nil)
+			}
+
+			got, err := usecase.AcquireStamp(context.Background(), 1, 1, token, "")
+			if tt.wantErr {
+				assert.ErrorIs(t, err, apperr.ErrStampNotActive)
+				assert.Nil(t, got)
+
+				var appErr *apperr.Error
+				if assert.ErrorAs(t, err, &appErr) {
+					assert.Equal(t, tt.wantDetails, appErr.Details)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, got)
+			}
+		})
+	}
+}
+
+func TestUserStampUseCase_AcquireStamp_Rewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockStampRepo := mock.NewMockStampRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
+	mockRuleRepo := mock.NewMockRewardRuleRepository(ctrl)
+	mockUserRewardRepo := mock.NewMockUserRewardRepository(ctrl)
+	mockTxManager := mock.NewMockTxManager(ctrl)
+	signer := stamptoken.NewSigner([]byte("test-secret"), time.Hour)
+	rotator := stamptoken.NewRotator(30 * time.Second)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	usecase := NewUserStampUseCase(mockUserStampRepo, mockUserRepo, mockStampRepo, mockEventRepo, mockRuleRepo, mockUserRewardRepo, mockTxManager, signer, rotator, stamptoken.NewCodeLimiter(), mockOutboxRepo)
+	mockOutboxRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	mockUserRepo.EXPECT().
+		FindByID(gomock.Any(), uint(1)).
+		Return(&entity.User{ID: 1, EventID: 1}, nil).
+		AnyTimes()
+	mockStampRepo.EXPECT().
+		FindByID(gomock.Any(), uint(2)).
+		Return(&entity.Stamp{ID: 2, EventID: 1}, nil).
+		AnyTimes()
+	mockEventRepo.EXPECT().
+		FindByID(gomock.Any(), uint(1)).
+		Return(&entity.Event{ID: 1}, nil).
+		AnyTimes()
+	mockTxManager.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+
+	// The event has 3 stamps and the user holds 1 and, after this acquisition, 2
+	rules := []entity.RewardRule{
+		{ID: 1, EventID: 1, Kind: entity.RewardKindCount, RequiredCount: 2},
+		{ID: 2, EventID: 1, Kind: entity.RewardKindAll},
+		{ID: 3, EventID: 1, Kind: entity.RewardKindStamps, Stamps: []entity.Stamp{{ID: 1}, {ID: 2}}},
+		{ID: 4, EventID: 1, Kind: entity.RewardKindStamps, Stamps: []entity.Stamp{{ID: 3}}},
+		{ID: 5, EventID: 1, Kind: entity.RewardKindCount, RequiredCount: 1},
+	}
+	userStamps := []entity.UserStamp{{UserID: 1, StampID: 1}, {UserID: 1, StampID: 2}}
+	token, _ := signer.Issue(2)
+
+	tests := []struct {
+		name    string
+		mockFn  func()
+		wantErr bool
+	}{
+		{
+			name: "grants the rewards the stamp completes",
+			mockFn: func() {
+				mockUserStampRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				mockRuleRepo.EXPECT().FindByEventID(gomock.Any(), uint(1)).Return(rules, nil)
+				mockUserRewardRepo.EXPECT().
+					FindByUserID(gomock.Any(), uint(1)).
+					Return([]entity.UserReward{{UserID: 1, RewardRuleID: 5}}, nil)
+				mockUserStampRepo.EXPECT().FindByUserID(gomock.Any(), uint(1)).Return(userStamps, nil).Times(2)
+				// Once to tell whether the rally is completed and once for the "all" rule
+				mockStampRepo.EXPECT().Count(gomock.Any(), uint(1)).Return(int64(3), nil).Times(2)
+				mockUserRewardRepo.EXPECT().
+					Create(gomock.Any(), &entity.UserReward{UserID: 1, RewardRuleID: 1}).
+					Return(nil)
+				mockUserRewardRepo.EXPECT().
+					Create(gomock.Any(), &entity.UserReward{UserID: 1, RewardRuleID: 3}).
+					Return(gorm.ErrDuplicatedKey)
+			},
+		},
+		{
+			name: "reward grant failure fails the acquisition",
+			mockFn: func() {
+				mockStampRepo.EXPECT().Count(gomock.Any(), uint(1)).Return(int64(3), nil)
+				mockUserStampRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				mockUserStampRepo.EXPECT().FindByUserID(gomock.Any(), uint(1)).Return(userStamps, nil)
+				mockRuleRepo.EXPECT().FindByEventID(gomock.Any(), uint(1)).Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+
+			got, err := usecase.AcquireStamp(context.Background(), 1, 2, token, "")
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(2), got.StampID)
+			}
+		})
+	}
+}
+
+func TestUserStampUseCase_GetLeaderboard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	signer := stamptoken.NewSigner([]byte("test-secret"), time.Hour)
+	rotator := stamptoken.NewRotator(30 * time.Second)
+	usecase := NewUserStampUseCase(mockUserStampRepo, mockUserRepo, mock.NewMockStampRepository(ctrl), mockEventRepo, mock.NewMockRewardRuleRepository(ctrl), mock.NewMockUserRewardRepository(ctrl), mock.NewMockTxManager(ctrl), signer, rotator, stamptoken.NewCodeLimiter(), mock.NewMockOutboxRepository(ctrl))
+
+	// The ordering itself is the repository's job; see TestUserStampRepository_FindLeaderboard
+	tests := []struct {
+		name      string
+		eventID   uint
+		limit     int
+		offset    int
+		mockFn    func()
+		wantUsers []uint
+		wantRanks []int
+		wantErr   bool
+		errIs     error
+	}{
+		{
+			name:    "first page",
+			eventID: 1,
+			limit:   2,
+			offset:  0,
+			mockFn: func() {
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&entity.Event{ID: 1}, nil)
+				mockUserStampRepo.EXPECT().
+					FindLeaderboard(gomock.Any(), uint(1), 2, 0).
+					Return([]entity.LeaderboardEntry{{UserID: 3}, {UserID: 2}}, nil)
+				mockUserRepo.EXPECT().Count(gomock.Any(), repository.UserFilter{EventID: 1}).Return(int64(3), nil)
+			},
+			wantUsers: []uint{3, 2},
+			wantRanks: []int{1, 2},
+		},
+		{
+			name:    "ranks continue on later pages",
+			eventID: 1,
+			limit:   2,
+			offset:  2,
+			mockFn: func() {
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&entity.Event{ID: 1}, nil)
+				mockUserStampRepo.EXPECT().
+					FindLeaderboard(gomock.Any(), uint(1), 2, 2).
+					Return([]entity.LeaderboardEntry{{UserID: 1}}, nil)
+				mockUserRepo.EXPECT().Count(gomock.Any(), repository.UserFilter{EventID: 1}).Return(int64(3), nil)
+			},
+			wantUsers: []uint{1},
+			wantRanks: []int{3},
+		},
+		{
+			name:    "event not found",
+			eventID: 999,
+			limit:   2,
+			mockFn: func() {
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), uint(999)).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrEventNotFound,
+		},
+		{
+			name:    "repository error",
+			eventID: 1,
+			limit:   2,
+			mockFn: func() {
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&entity.Event{ID: 1}, nil)
+				mockUserStampRepo.EXPECT().FindLeaderboard(gomock.Any(), uint(1), 2, 0).Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name:    "count error",
+			eventID: 1,
+			limit:   2,
+			mockFn: func() {
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&entity.Event{ID: 1}, nil)
+				mockUserStampRepo.EXPECT().
+					FindLeaderboard(gomock.Any(), uint(1), 2, 0).
+					Return([]entity.LeaderboardEntry{{UserID: 3}, {UserID: 2}}, nil)
+				mockUserRepo.EXPECT().Count(gomock.Any(), repository.UserFilter{EventID: 1}).Return(int64(0), assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+			entries, total, err := usecase.GetLeaderboard(context.Background(), tt.eventID, tt.limit, tt.offset)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, entries)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, int64(3), total)
+			var users []uint
+			var ranks []int
+			for _, e := range entries {
+				users = append(users, e.UserID)
+				ranks = append(ranks, e.Rank)
+			}
+			assert.Equal(t, tt.wantUsers, users)
+			assert.Equal(t, tt.wantRanks, ranks)
+		})
+	}
+}
+
+// newOutboxMessage returns the outbox message expected to be written with the payload.
+func newOutboxMessage(t *testing.T, messageType entity.OutboxMessageType, userID uint, payload any) *entity.OutboxMessage {
+	t.Helper()
+	message, err := entity.NewOutboxMessage(messageType, userID, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return message
+}
+
+// expectNoRewards runs units of work directly and reports no reward rules for any event.
+func expectNoRewards(ruleRepo *mock.MockRewardRuleRepository, txManager *mock.MockTxManager) {
+	ruleRepo.EXPECT().
+		FindByEventID(gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
+	txManager.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+}
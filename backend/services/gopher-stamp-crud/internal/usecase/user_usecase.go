@@ -2,92 +2,386 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"strings"
 
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"golang.org/x/text/unicode/norm"
+	"gorm.io/gorm"
 )
 
 type UserUsecase interface {
-	Create(ctx context.Context, name string, twitterID *string, favoriteGoFeature *string, icon *string) (*entity.User, error)
+	// Create registers a participant and returns them with the access token they use to update their
+	// profile and acquire stamps. goFeatures are codes of the Go feature catalogue; when they are
+	// not given, the features are taken from the codes in favoriteGoFeature.
+	Create(ctx context.Context, eventID uint, name string, twitterID *string, favoriteGoFeature *string, goFeatures *[]string, icon *string) (*entity.User, string, error)
 	GetByID(ctx context.Context, id uint) (*entity.User, error)
-	GetAll(ctx context.Context) ([]*entity.User, error)
-	GetAllWithStampCounts(ctx context.Context) ([]*entity.User, map[uint][]uint, error)
-	Update(ctx context.Context, id uint, name *string, twitterID *string, favoriteGoFeature *string, icon *string) (*entity.User, error)
+	// GetAll returns a page of the event's participants and the number of participants matching opts.Search.
+	GetAll(ctx context.Context, eventID uint, opts UserListOptions) ([]*entity.User, int64, error)
+	// GetAllWithStampCounts is GetAll plus the IDs of the stamps each participant on the page has acquired.
+	GetAllWithStampCounts(ctx context.Context, eventID uint, opts UserListOptions) ([]*entity.User, map[uint][]uint, int64, error)
+	Update(ctx context.Context, id uint, name *string, twitterID *string, favoriteGoFeature *string, goFeatures *[]string, icon *string) (*entity.User, error)
+	// Delete erases the user together with their acquired stamps, rewards and access tokens, and the
+	// feed messages and webhook deliveries about them, sent or not.
 	Delete(ctx context.Context, id uint) error
+	// IssueToken issues another access token for the user, for organizers to hand to a participant
+	// who has none, such as one registered before access tokens were issued. Earlier tokens stay valid.
+	IssueToken(ctx context.Context, id uint) (string, error)
+}
+
+// UserListOptions selects a page of participants. Sort is "created_at" (the default), "name" or
+// "stamp_count", and Order is "asc" or "desc"; when Order is empty, stamp_count sorts the most
+// stamps first and the others ascending. Search matches part of the name or Twitter ID.
+type UserListOptions struct {
+	Search string
+	Sort   string
+	Order  string
+	Limit  int
+	Offset int
 }
 
 type userUsecase struct {
-	userRepo      repository.UserRepository
-	userStampRepo repository.UserStampRepository
+	userRepo       repository.UserRepository
+	userStampRepo  repository.UserStampRepository
+	sessionRepo    repository.UserSessionRepository
+	userRewardRepo repository.UserRewardRepository
+	goFeatureRepo  repository.GoFeatureRepository
+	eventRepo      repository.EventRepository
+	outboxRepo     repository.OutboxRepository
+	webhookJobRepo repository.WebhookJobRepository
+	deliveryRepo   repository.WebhookDeliveryRepository
+	blobStore      repository.BlobStore
+	txManager      repository.TxManager
+	webhooks       repository.WebhookDispatcher
 }
 
-func NewUserUsecase(userRepo repository.UserRepository, userStampRepo repository.UserStampRepository) UserUsecase {
+func NewUserUsecase(
+	userRepo repository.UserRepository,
+	userStampRepo repository.UserStampRepository,
+	sessionRepo repository.UserSessionRepository,
+	userRewardRepo repository.UserRewardRepository,
+	goFeatureRepo repository.GoFeatureRepository,
+	eventRepo repository.EventRepository,
+	outboxRepo repository.OutboxRepository,
+	webhookJobRepo repository.WebhookJobRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+	blobStore repository.BlobStore,
+	txManager repository.TxManager,
+	webhooks repository.WebhookDispatcher,
+) UserUsecase {
 	return &userUsecase{
-		userRepo:      userRepo,
-		userStampRepo: userStampRepo,
+		userRepo:       userRepo,
+		userStampRepo:  userStampRepo,
+		sessionRepo:    sessionRepo,
+		userRewardRepo: userRewardRepo,
+		goFeatureRepo:  goFeatureRepo,
+		eventRepo:      eventRepo,
+		outboxRepo:     outboxRepo,
+		webhookJobRepo: webhookJobRepo,
+		deliveryRepo:   deliveryRepo,
+		blobStore:      blobStore,
+		txManager:      txManager,
+		webhooks:       webhooks,
 	}
 }
 
-func (u *userUsecase) Create(ctx context.Context, name string, twitterID *string, favoriteGoFeature *string, icon *string) (*entity.User, error) {
+func (u *userUsecase) Create(ctx context.Context, eventID uint, name string, twitterID *string, favoriteGoFeature *string, goFeatures *[]string, icon *string) (*entity.User, string, error) {
+	profile, err := validateUserProfile(&name, twitterID, favoriteGoFeature)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var normalized *normalizedIcon
+	if icon != nil && *icon != "" {
+		if normalized, err = normalizeInlineIcon(*icon); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if _, err := findEvent(ctx, u.eventRepo, eventID); err != nil {
+		return nil, "", err
+	}
+
+	features, chosen, err := chooseGoFeatures(ctx, u.goFeatureRepo, goFeatures, profile.favoriteGoFeature)
+	if err != nil {
+		return nil, "", err
+	}
+
 	user := &entity.User{
-		Name:              name,
-		TwitterID:         twitterID,
-		FavoriteGoFeature: favoriteGoFeature,
-		Icon:              icon,
+		EventID:           eventID,
+		Name:              *profile.name,
+		TwitterID:         nilIfEmpty(profile.twitterID),
+		FavoriteGoFeature: nilIfEmpty(profile.favoriteGoFeature),
 	}
-	if err := u.userRepo.Create(ctx, user); err != nil {
-		return nil, err
+	if goFeatures != nil && favoriteGoFeature == nil {
+		user.FavoriteGoFeature = joinGoFeatureCodes(features)
 	}
-	return user, nil
+
+	// The feature links, icon blob keys and session need the user's ID, so they are saved once the
+	// user exists and the user is rolled back if saving them fails
+	var (
+		accessToken string
+		stored      *storedIcon
+	)
+	err = u.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.userRepo.Create(ctx, user); err != nil {
+			return err
+		}
+		if chosen {
+			if err := u.goFeatureRepo.ReplaceUserFeatures(ctx, user.ID, goFeatureIDs(features)); err != nil {
+				return err
+			}
+			user.GoFeatures = features
+		}
+		if normalized != nil {
+			var err error
+			if stored, err = storeIcon(ctx, u.userRepo, u.blobStore, user, normalized); err != nil {
+				return err
+			}
+		}
+		// A participant left without a token could never update their profile or acquire stamps
+		token, err := issueToken(ctx, u.sessionRepo, user.ID)
+		if err != nil {
+			return err
+		}
+		accessToken = token
+		// Recorded with the user, so the feed and the webhooks hear of every registration that commits
+		if err := u.recordRegistration(ctx, user); err != nil {
+			return err
+		}
+		return u.webhooks.Dispatch(ctx, entity.WebhookEvent{
+			Type:       entity.WebhookUserRegistered,
+			EventID:    user.EventID,
+			UserID:     user.ID,
+			UserName:   user.Name,
+			OccurredAt: user.CreatedAt,
+		})
+	})
+	if err != nil {
+		stored.rolledBack(ctx)
+		return nil, "", err
+	}
+	stored.committed(ctx)
+
+	return user, accessToken, nil
+}
+
+// recordRegistration writes the registration to the outbox, from where it is published to the feed.
+func (u *userUsecase) recordRegistration(ctx context.Context, user *entity.User) error {
+	message, err := entity.NewOutboxMessage(entity.OutboxUserRegistered, user.ID, entity.UserRegistration{
+		EventID:       user.EventID,
+		UserID:        user.ID,
+		UserName:      user.Name,
+		IconThumbnail: user.IconThumbnail,
+		RegisteredAt:  user.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	return u.outboxRepo.Create(ctx, message)
 }
 
 func (u *userUsecase) GetByID(ctx context.Context, id uint) (*entity.User, error) {
-	return u.userRepo.FindByID(ctx, id)
+	user, err := u.userRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperr.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return user, nil
 }
 
-func (u *userUsecase) GetAll(ctx context.Context) ([]*entity.User, error) {
-	return u.userRepo.FindAll(ctx)
+func (u *userUsecase) GetAll(ctx context.Context, eventID uint, opts UserListOptions) ([]*entity.User, int64, error) {
+	filter := repository.UserFilter{
+		EventID: eventID,
+		// Names are stored in NFC and Twitter IDs without the leading @
+		Search: strings.TrimPrefix(norm.NFC.String(strings.TrimSpace(opts.Search)), "@"),
+	}
+
+	sort := repository.UserSort(opts.Sort)
+	switch sort {
+	case "":
+		sort = repository.UserSortCreatedAt
+	case repository.UserSortCreatedAt, repository.UserSortName, repository.UserSortStampCount:
+	default:
+		return nil, 0, apperr.ErrInvalidUserSort
+	}
+
+	var desc bool
+	switch opts.Order {
+	case "":
+		desc = sort == repository.UserSortStampCount
+	case "asc":
+	case "desc":
+		desc = true
+	default:
+		return nil, 0, apperr.ErrInvalidSortOrder
+	}
+
+	if _, err := findEvent(ctx, u.eventRepo, eventID); err != nil {
+		return nil, 0, err
+	}
+
+	users, err := u.userRepo.FindAll(ctx, filter, sort, desc, opts.Limit, opts.Offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.userRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
 }
 
-func (u *userUsecase) GetAllWithStampCounts(ctx context.Context) ([]*entity.User, map[uint][]uint, error) {
-	users, err := u.userRepo.FindAll(ctx)
+func (u *userUsecase) GetAllWithStampCounts(ctx context.Context, eventID uint, opts UserListOptions) ([]*entity.User, map[uint][]uint, int64, error) {
+	users, total, err := u.GetAll(ctx, eventID, opts)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, 0, err
 	}
 
-	userStampMap, err := u.userStampRepo.FindAllUserStampIDs(ctx)
+	// Only look up the stamps of the participants on this page
+	userIDs := make([]uint, len(users))
+	for i, user := range users {
+		userIDs[i] = user.ID
+	}
+	userStampMap, err := u.userStampRepo.FindStampIDsByUserIDs(ctx, userIDs)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, 0, err
 	}
 
-	return users, userStampMap, nil
+	return users, userStampMap, total, nil
 }
 
-func (u *userUsecase) Update(ctx context.Context, id uint, name *string, twitterID *string, favoriteGoFeature *string, icon *string) (*entity.User, error) {
+func (u *userUsecase) Update(ctx context.Context, id uint, name *string, twitterID *string, favoriteGoFeature *string, goFeatures *[]string, icon *string) (*entity.User, error) {
+	profile, err := validateUserProfile(name, twitterID, favoriteGoFeature)
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := u.userRepo.FindByID(ctx, id)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperr.ErrUserNotFound
+		}
 		return nil, err
 	}
 
-	if name != nil {
-		user.Name = *name
+	if profile.name != nil {
+		user.Name = *profile.name
+	}
+	if profile.twitterID != nil {
+		user.TwitterID = nilIfEmpty(profile.twitterID)
+	}
+	if profile.favoriteGoFeature != nil {
+		user.FavoriteGoFeature = nilIfEmpty(profile.favoriteGoFeature)
 	}
-	if twitterID != nil {
-		user.TwitterID = twitterID
+
+	features, chosen, err := chooseGoFeatures(ctx, u.goFeatureRepo, goFeatures, profile.favoriteGoFeature)
+	if err != nil {
+		return nil, err
 	}
-	if favoriteGoFeature != nil {
-		user.FavoriteGoFeature = favoriteGoFeature
+	if goFeatures != nil && favoriteGoFeature == nil {
+		user.FavoriteGoFeature = joinGoFeatureCodes(features)
 	}
-	if icon != nil {
-		user.Icon = icon
+
+	var normalized *normalizedIcon
+	var removedIcon, removedThumbnail *string
+	switch {
+	case icon == nil, user.Icon != nil && *icon == *user.Icon:
+		// Clients may send back the icon URL they received along with the rest of the profile
+	case *icon == "":
+		removedIcon, removedThumbnail = user.Icon, user.IconThumbnail
+		user.Icon, user.IconThumbnail = nil, nil
+	default:
+		if normalized, err = normalizeInlineIcon(*icon); err != nil {
+			return nil, err
+		}
 	}
 
-	if err := u.userRepo.Update(ctx, user); err != nil {
+	var stored *storedIcon
+	err = u.txManager.Do(ctx, func(ctx context.Context) error {
+		if chosen {
+			if err := u.goFeatureRepo.ReplaceUserFeatures(ctx, user.ID, goFeatureIDs(features)); err != nil {
+				return err
+			}
+			user.GoFeatures = features
+		}
+		if normalized != nil {
+			// Saves the other fields along with the new icon
+			var err error
+			stored, err = storeIcon(ctx, u.userRepo, u.blobStore, user, normalized)
+			return err
+		}
+		return u.userRepo.Update(ctx, user)
+	})
+	if err != nil {
+		stored.rolledBack(ctx)
 		return nil, err
 	}
+
+	// A replaced or removed icon's files are deleted only once the user no longer points at them
+	stored.committed(ctx)
+	deleteBlobs(ctx, u.blobStore, removedIcon, removedThumbnail)
 	return user, nil
 }
 
 func (u *userUsecase) Delete(ctx context.Context, id uint) error {
-	return u.userRepo.Delete(ctx, id)
+	// Check if user exists
+	user, err := u.userRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperr.ErrUserNotFound
+		}
+		return err
+	}
+
+	// Remove dependent rows first so nothing referencing the user is left behind,
+	// all in one transaction so a failure never leaves a half-erased account.
+	err = u.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.userStampRepo.DeleteByUserID(ctx, id); err != nil {
+			return err
+		}
+		if err := u.userRewardRepo.DeleteByUserID(ctx, id); err != nil {
+			return err
+		}
+		if err := u.goFeatureRepo.DeleteByUserID(ctx, id); err != nil {
+			return err
+		}
+		if err := u.sessionRepo.DeleteByUserID(ctx, id); err != nil {
+			return err
+		}
+		// Notifications carry the user's name and icon, so pending ones must not go out after
+		// the account is gone and sent ones must not outlive it in the logs
+		if err := u.outboxRepo.DeleteByUserID(ctx, id); err != nil {
+			return err
+		}
+		if err := u.webhookJobRepo.DeleteByUserID(ctx, id); err != nil {
+			return err
+		}
+		if err := u.deliveryRepo.DeleteByUserID(ctx, id); err != nil {
+			return err
+		}
+		return u.userRepo.Delete(ctx, id)
+	})
+	if err != nil {
+		return err
+	}
+
+	// The icon files are removed only once the account is gone, so a rolled back
+	// deletion never leaves the user pointing at missing images
+	deleteBlobs(ctx, u.blobStore, user.Icon, user.IconThumbnail)
+	return nil
+}
+
+func (u *userUsecase) IssueToken(ctx context.Context, id uint) (string, error) {
+	if _, err := u.GetByID(ctx, id); err != nil {
+		return "", err
+	}
+	return issueToken(ctx, u.sessionRepo, id)
 }
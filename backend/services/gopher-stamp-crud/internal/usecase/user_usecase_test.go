@@ -2,13 +2,18 @@ package usecase
 
 import (
 	"context"
+	"encoding/base64"
+	"strings"
 	"testing"
 
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
 	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
 	mock "2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/mock_repository"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
 )
 
 func TestUserUsecase_Create(t *testing.T) {
@@ -17,36 +22,375 @@ func TestUserUsecase_Create(t *testing.T) {
 
 	mockRepo := mock.NewMockUserRepository(ctrl)
 	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
-	usecase := NewUserUsecase(mockRepo, mockUserStampRepo)
+	mockSessionRepo := mock.NewMockUserSessionRepository(ctrl)
+	mockUserRewardRepo := mock.NewMockUserRewardRepository(ctrl)
+	mockGoFeatureRepo := mock.NewMockGoFeatureRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockWebhookJobRepo := mock.NewMockWebhookJobRepository(ctrl)
+	mockDeliveryRepo := mock.NewMockWebhookDeliveryRepository(ctrl)
+	mockBlobStore := mock.NewMockBlobStore(ctrl)
+	mockTxManager := mock.NewMockTxManager(ctrl)
+	mockWebhooks := mock.NewMockWebhookDispatcher(ctrl)
+	usecase := NewUserUsecase(mockRepo, mockUserStampRepo, mockSessionRepo, mockUserRewardRepo, mockGoFeatureRepo, mockEventRepo, mockOutboxRepo, mockWebhookJobRepo, mockDeliveryRepo, mockBlobStore, mockTxManager, mockWebhooks)
+
+	expectTx := func() *gomock.Call {
+		return mockTxManager.EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+	}
+	iconDataURL := "data:image/png;base64," + base64.StdEncoding.EncodeToString(testPNG(t))
+	invalidIcon := "data:image/svg+xml;base64," + base64.StdEncoding.EncodeToString([]byte("<svg/>"))
+	iconURL := "http://blobs/icon.png"
+	thumbnailURL := "http://blobs/icon_thumb.png"
+	goFeatures := []string{"TESTING", " CONCURRENCY"}
+	unknownGoFeatures := []string{"CONCURRENCY", "GENERICS"}
+	chosenGoFeatures := "CONCURRENCY,TESTING"
 
 	tests := []struct {
-		name     string
-		userName string
-		mockFn   func()
-		want     *entity.User
-		wantErr  bool
+		name       string
+		userName   string
+		goFeatures *[]string
+		icon       *string
+		mockFn     func()
+		want       *entity.User
+		wantErr    bool
+		errIs      error
 	}{
 		{
 			name:     "success",
 			userName: "Test User",
 			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
+				expectTx()
 				mockRepo.EXPECT().
 					Create(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, user *entity.User) error {
 						user.ID = 1 // リポジトリで設定されるIDをシミュレート
 						return nil
 					})
+				mockSessionRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, session *entity.UserSession) error {
+						assert.Equal(t, uint(1), session.UserID)
+						return nil
+					})
+				mockOutboxRepo.EXPECT().
+					Create(gomock.Any(), newOutboxMessage(t, entity.OutboxUserRegistered, 1, entity.UserRegistration{
+						EventID:  1,
+						UserID:   1,
+						UserName: "Test User",
+					})).
+					Return(nil)
+				mockWebhooks.EXPECT().
+					Dispatch(gomock.Any(), entity.WebhookEvent{
+						Type:     entity.WebhookUserRegistered,
+						EventID:  1,
+						UserID:   1,
+						UserName: "Test User",
+					}).
+					Return(nil)
 			},
 			want: &entity.User{
-				ID:   1,
-				Name: "Test User",
+				ID:      1,
+				EventID: 1,
+				Name:    "Test User",
+			},
+			wantErr: false,
+		},
+		{
+			name:     "success with icon",
+			userName: "Test User",
+			icon:     &iconDataURL,
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
+				gomock.InOrder(
+					expectTx(),
+					mockRepo.EXPECT().
+						Create(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, user *entity.User) error {
+							assert.Nil(t, user.Icon, "the inline icon must not be stored on the user")
+							user.ID = 1
+							return nil
+						}),
+					mockBlobStore.EXPECT().
+						Put(gomock.Any(), gomock.Any(), "image/png", gomock.Any()).
+						DoAndReturn(func(ctx context.Context, key, contentType string, data []byte) (string, error) {
+							if strings.HasSuffix(key, "_thumb.png") {
+								return thumbnailURL, nil
+							}
+							return iconURL, nil
+						}).
+						Times(2),
+					mockRepo.EXPECT().
+						Update(gomock.Any(), gomock.Any()).
+						Return(nil),
+					mockSessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil),
+					mockOutboxRepo.EXPECT().
+						Create(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, message *entity.OutboxMessage) error {
+							var registration entity.UserRegistration
+							assert.NoError(t, message.Decode(&registration))
+							assert.Equal(t, &thumbnailURL, registration.IconThumbnail)
+							return nil
+						}),
+					mockWebhooks.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Return(nil),
+				)
+			},
+			want: &entity.User{
+				ID:            1,
+				EventID:       1,
+				Name:          "Test User",
+				Icon:          &iconURL,
+				IconThumbnail: &thumbnailURL,
+			},
+			wantErr: false,
+		},
+		{
+			name:       "success with go features",
+			userName:   "Test User",
+			goFeatures: &goFeatures,
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
+				mockGoFeatureRepo.EXPECT().
+					FindAll(gomock.Any()).
+					Return(testGoFeatures, nil)
+				gomock.InOrder(
+					expectTx(),
+					mockRepo.EXPECT().
+						Create(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, user *entity.User) error {
+							user.ID = 1
+							return nil
+						}),
+					mockGoFeatureRepo.EXPECT().
+						ReplaceUserFeatures(gomock.Any(), uint(1), []uint{1, 6}).
+						Return(nil),
+					mockSessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil),
+					mockOutboxRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil),
+					mockWebhooks.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Return(nil),
+				)
+			},
+			want: &entity.User{
+				ID:                1,
+				EventID:           1,
+				Name:              "Test User",
+				FavoriteGoFeature: &chosenGoFeatures,
+				GoFeatures:        []entity.GoFeature{testGoFeatures[0], testGoFeatures[5]},
+			},
+			wantErr: false,
+		},
+		{
+			name:       "unknown go feature",
+			userName:   "Test User",
+			goFeatures: &unknownGoFeatures,
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
+				mockGoFeatureRepo.EXPECT().
+					FindAll(gomock.Any()).
+					Return(testGoFeatures, nil)
+			},
+			want:    nil,
+			wantErr: true,
+			errIs:   apperr.ErrInvalidUserProfile,
+		},
+		{
+			name:     "name is normalized",
+			userName: "  Test User ",
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
+				expectTx()
+				mockRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, user *entity.User) error {
+						user.ID = 2
+						return nil
+					})
+				mockSessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				mockOutboxRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				mockWebhooks.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			want: &entity.User{
+				ID:      2,
+				EventID: 1,
+				Name:    "Test User",
 			},
 			wantErr: false,
 		},
+		{
+			name:     "invalid profile",
+			userName: strings.Repeat("a", 101),
+			mockFn:   func() {},
+			want:     nil,
+			wantErr:  true,
+			errIs:    apperr.ErrInvalidUserProfile,
+		},
+		{
+			name:     "invalid icon",
+			userName: "Test User",
+			icon:     &invalidIcon,
+			mockFn:   func() {},
+			want:     nil,
+			wantErr:  true,
+			errIs:    apperr.ErrInvalidIcon,
+		},
+		{
+			name:     "icon store error",
+			userName: "Test User",
+			icon:     &iconDataURL,
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
+				expectTx()
+				mockRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil)
+				mockBlobStore.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", assert.AnError)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			// The registration is rolled back rather than committed without its webhook notification
+			name:     "webhook dispatch error",
+			userName: "Test User",
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
+				expectTx()
+				mockRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil)
+				mockSessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				mockOutboxRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				mockWebhooks.EXPECT().
+					Dispatch(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			// The registration is rolled back rather than committed without reaching the feed
+			name:     "outbox error",
+			userName: "Test User",
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
+				expectTx()
+				mockRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil)
+				mockSessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				mockOutboxRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			// The icon stored before the registration failed to commit is not left behind
+			name:     "commit error removes the stored icon",
+			userName: "Test User",
+			icon:     &iconDataURL,
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
+				gomock.InOrder(
+					mockTxManager.EXPECT().
+						Do(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+							if err := fn(ctx); err != nil {
+								return err
+							}
+							return assert.AnError
+						}),
+					mockRepo.EXPECT().
+						Create(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, user *entity.User) error {
+							user.ID = 1
+							return nil
+						}),
+					expectPut(mockBlobStore).Times(2),
+					mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil),
+					mockSessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil),
+					mockOutboxRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil),
+					mockWebhooks.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Return(nil),
+					mockBlobStore.EXPECT().
+						KeyForURL(gomock.Any()).
+						DoAndReturn(func(url string) (string, bool) {
+							return strings.TrimPrefix(url, "http://blobs/"), true
+						}).
+						Times(2),
+				)
+				mockBlobStore.EXPECT().
+					Delete(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key string) error {
+						assert.Regexp(t, `^icons/1/[0-9a-f]{32}(_thumb)?\.png$`, key)
+						return nil
+					}).
+					Times(2)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			// The registration is rolled back rather than committed without a way to sign in
+			name:     "session create error",
+			userName: "Test User",
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
+				expectTx()
+				mockRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil)
+				mockSessionRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:     "event not found",
+			userName: "Test User",
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name:     "infrastructure error",
 			userName: "Test User",
 			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
+				expectTx()
 				mockRepo.EXPECT().
 					Create(gomock.Any(), gomock.Any()).
 					Return(assert.AnError)
@@ -59,13 +403,18 @@ func TestUserUsecase_Create(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockFn()
-			got, err := usecase.Create(context.Background(), tt.userName, nil, nil, nil)
+			got, accessToken, err := usecase.Create(context.Background(), 1, tt.userName, nil, nil, tt.goFeatures, tt.icon)
 			if tt.wantErr {
 				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
 				assert.Nil(t, got)
+				assert.Empty(t, accessToken)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.want, got)
+				assert.NotEmpty(t, accessToken)
 			}
 		})
 	}
@@ -77,7 +426,17 @@ func TestUserUsecase_GetByID(t *testing.T) {
 
 	mockRepo := mock.NewMockUserRepository(ctrl)
 	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
-	usecase := NewUserUsecase(mockRepo, mockUserStampRepo)
+	mockSessionRepo := mock.NewMockUserSessionRepository(ctrl)
+	mockUserRewardRepo := mock.NewMockUserRewardRepository(ctrl)
+	mockGoFeatureRepo := mock.NewMockGoFeatureRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockWebhookJobRepo := mock.NewMockWebhookJobRepository(ctrl)
+	mockDeliveryRepo := mock.NewMockWebhookDeliveryRepository(ctrl)
+	mockBlobStore := mock.NewMockBlobStore(ctrl)
+	mockTxManager := mock.NewMockTxManager(ctrl)
+	mockWebhooks := mock.NewMockWebhookDispatcher(ctrl)
+	usecase := NewUserUsecase(mockRepo, mockUserStampRepo, mockSessionRepo, mockUserRewardRepo, mockGoFeatureRepo, mockEventRepo, mockOutboxRepo, mockWebhookJobRepo, mockDeliveryRepo, mockBlobStore, mockTxManager, mockWebhooks)
 
 	tests := []struct {
 		name    string
@@ -85,6 +444,7 @@ func TestUserUsecase_GetByID(t *testing.T) {
 		mockFn  func()
 		want    *entity.User
 		wantErr bool
+		errIs   error
 	}{
 		{
 			name: "success",
@@ -109,10 +469,23 @@ func TestUserUsecase_GetByID(t *testing.T) {
 			mockFn: func() {
 				mockRepo.EXPECT().
 					FindByID(gomock.Any(), uint(999)).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			want:    nil,
+			wantErr: true,
+			errIs:   apperr.ErrUserNotFound,
+		},
+		{
+			name: "database error",
+			id:   1,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
 					Return(nil, assert.AnError)
 			},
 			want:    nil,
 			wantErr: true,
+			errIs:   assert.AnError,
 		},
 	}
 
@@ -121,7 +494,7 @@ func TestUserUsecase_GetByID(t *testing.T) {
 			tt.mockFn()
 			got, err := usecase.GetByID(context.Background(), tt.id)
 			if tt.wantErr {
-				assert.Error(t, err)
+				assert.ErrorIs(t, err, tt.errIs)
 				assert.Nil(t, got)
 			} else {
 				assert.NoError(t, err)
@@ -137,79 +510,225 @@ func TestUserUsecase_GetAll(t *testing.T) {
 
 	mockRepo := mock.NewMockUserRepository(ctrl)
 	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
-	usecase := NewUserUsecase(mockRepo, mockUserStampRepo)
+	mockSessionRepo := mock.NewMockUserSessionRepository(ctrl)
+	mockUserRewardRepo := mock.NewMockUserRewardRepository(ctrl)
+	mockGoFeatureRepo := mock.NewMockGoFeatureRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockWebhookJobRepo := mock.NewMockWebhookJobRepository(ctrl)
+	mockDeliveryRepo := mock.NewMockWebhookDeliveryRepository(ctrl)
+	mockBlobStore := mock.NewMockBlobStore(ctrl)
+	mockTxManager := mock.NewMockTxManager(ctrl)
+	mockWebhooks := mock.NewMockWebhookDispatcher(ctrl)
+	usecase := NewUserUsecase(mockRepo, mockUserStampRepo, mockSessionRepo, mockUserRewardRepo, mockGoFeatureRepo, mockEventRepo, mockOutboxRepo, mockWebhookJobRepo, mockDeliveryRepo, mockBlobStore, mockTxManager, mockWebhooks)
 
 	tests := []struct {
-		name    string
-		mockFn  func()
-		want    []*entity.User
-		wantErr bool
+		name      string
+		opts      UserListOptions
+		mockFn    func()
+		want      []*entity.User
+		wantTotal int64
+		wantErr   error
 	}{
 		{
 			name: "success",
+			opts: UserListOptions{Limit: 2},
 			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
 				mockRepo.EXPECT().
-					FindAll(gomock.Any()).
+					FindAll(gomock.Any(), repository.UserFilter{EventID: 1}, repository.UserSortCreatedAt, false, 2, 0).
 					Return([]*entity.User{
 						{ID: 1, Name: "User 1"},
 						{ID: 2, Name: "User 2"},
 					}, nil)
+				mockRepo.EXPECT().
+					Count(gomock.Any(), repository.UserFilter{EventID: 1}).
+					Return(int64(3), nil)
 			},
 			want: []*entity.User{
 				{ID: 1, Name: "User 1"},
 				{ID: 2, Name: "User 2"},
 			},
-			wantErr: false,
+			wantTotal: 3,
 		},
 		{
 			name: "empty list",
+			opts: UserListOptions{Limit: 100},
 			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
 				mockRepo.EXPECT().
-					FindAll(gomock.Any()).
+					FindAll(gomock.Any(), repository.UserFilter{EventID: 1}, repository.UserSortCreatedAt, false, 100, 0).
 					Return([]*entity.User{}, nil)
+				mockRepo.EXPECT().
+					Count(gomock.Any(), repository.UserFilter{EventID: 1}).
+					Return(int64(0), nil)
 			},
-			want:    []*entity.User{},
-			wantErr: false,
+			want: []*entity.User{},
+		},
+		{
+			name: "stamp_count sorts descending by default",
+			opts: UserListOptions{Sort: "stamp_count", Limit: 10, Offset: 10},
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
+				mockRepo.EXPECT().
+					FindAll(gomock.Any(), repository.UserFilter{EventID: 1}, repository.UserSortStampCount, true, 10, 10).
+					Return([]*entity.User{{ID: 1}}, nil)
+				mockRepo.EXPECT().
+					Count(gomock.Any(), repository.UserFilter{EventID: 1}).
+					Return(int64(11), nil)
+			},
+			want:      []*entity.User{{ID: 1}},
+			wantTotal: 11,
+		},
+		{
+			name: "search by twitter id with order",
+			opts: UserListOptions{Search: " @gopher ", Sort: "name", Order: "desc", Limit: 100},
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
+				mockRepo.EXPECT().
+					FindAll(gomock.Any(), repository.UserFilter{EventID: 1, Search: "gopher"}, repository.UserSortName, true, 100, 0).
+					Return([]*entity.User{{ID: 2}}, nil)
+				mockRepo.EXPECT().
+					Count(gomock.Any(), repository.UserFilter{EventID: 1, Search: "gopher"}).
+					Return(int64(1), nil)
+			},
+			want:      []*entity.User{{ID: 2}},
+			wantTotal: 1,
+		},
+		{
+			name:    "invalid sort",
+			opts:    UserListOptions{Sort: "twitter_id"},
+			mockFn:  func() {},
+			wantErr: apperr.ErrInvalidUserSort,
+		},
+		{
+			name:    "invalid order",
+			opts:    UserListOptions{Order: "random"},
+			mockFn:  func() {},
+			wantErr: apperr.ErrInvalidSortOrder,
+		},
+		{
+			name: "event not found",
+			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: apperr.ErrEventNotFound,
 		},
 		{
 			name: "infrastructure error",
 			mockFn: func() {
+				mockEventRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.Event{ID: 1}, nil)
 				mockRepo.EXPECT().
-					FindAll(gomock.Any()).
+					FindAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, assert.AnError)
 			},
-			want:    nil,
-			wantErr: true,
+			wantErr: assert.AnError,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockFn()
-			got, err := usecase.GetAll(context.Background())
-			if tt.wantErr {
-				assert.Error(t, err)
+			got, total, err := usecase.GetAll(context.Background(), 1, tt.opts)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
 				assert.Nil(t, got)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.wantTotal, total)
 			}
 		})
 	}
 }
 
+func TestUserUsecase_GetAllWithStampCounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockUserRepository(ctrl)
+	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
+	mockSessionRepo := mock.NewMockUserSessionRepository(ctrl)
+	mockUserRewardRepo := mock.NewMockUserRewardRepository(ctrl)
+	mockGoFeatureRepo := mock.NewMockGoFeatureRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockWebhookJobRepo := mock.NewMockWebhookJobRepository(ctrl)
+	mockDeliveryRepo := mock.NewMockWebhookDeliveryRepository(ctrl)
+	mockBlobStore := mock.NewMockBlobStore(ctrl)
+	mockTxManager := mock.NewMockTxManager(ctrl)
+	mockWebhooks := mock.NewMockWebhookDispatcher(ctrl)
+	usecase := NewUserUsecase(mockRepo, mockUserStampRepo, mockSessionRepo, mockUserRewardRepo, mockGoFeatureRepo, mockEventRepo, mockOutboxRepo, mockWebhookJobRepo, mockDeliveryRepo, mockBlobStore, mockTxManager, mockWebhooks)
+
+	mockEventRepo.EXPECT().
+		FindByID(gomock.Any(), uint(1)).
+		Return(&entity.Event{ID: 1}, nil)
+	mockRepo.EXPECT().
+		FindAll(gomock.Any(), repository.UserFilter{EventID: 1}, repository.UserSortCreatedAt, false, 2, 0).
+		Return([]*entity.User{{ID: 3}, {ID: 5}}, nil)
+	mockRepo.EXPECT().
+		Count(gomock.Any(), repository.UserFilter{EventID: 1}).
+		Return(int64(4), nil)
+	// Only the stamps of the participants on the page are looked up
+	mockUserStampRepo.EXPECT().
+		FindStampIDsByUserIDs(gomock.Any(), []uint{3, 5}).
+		Return(map[uint][]uint{3: {1, 2}}, nil)
+
+	users, userStampMap, total, err := usecase.GetAllWithStampCounts(context.Background(), 1, UserListOptions{Limit: 2})
+	assert.NoError(t, err)
+	assert.Len(t, users, 2)
+	assert.Equal(t, map[uint][]uint{3: {1, 2}}, userStampMap)
+	assert.Equal(t, int64(4), total)
+}
+
 func TestUserUsecase_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockUserRepository(ctrl)
 	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
-	usecase := NewUserUsecase(mockRepo, mockUserStampRepo)
+	mockSessionRepo := mock.NewMockUserSessionRepository(ctrl)
+	mockUserRewardRepo := mock.NewMockUserRewardRepository(ctrl)
+	mockGoFeatureRepo := mock.NewMockGoFeatureRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockWebhookJobRepo := mock.NewMockWebhookJobRepository(ctrl)
+	mockDeliveryRepo := mock.NewMockWebhookDeliveryRepository(ctrl)
+	mockBlobStore := mock.NewMockBlobStore(ctrl)
+	mockTxManager := mock.NewMockTxManager(ctrl)
+	mockWebhooks := mock.NewMockWebhookDispatcher(ctrl)
+	usecase := NewUserUsecase(mockRepo, mockUserStampRepo, mockSessionRepo, mockUserRewardRepo, mockGoFeatureRepo, mockEventRepo, mockOutboxRepo, mockWebhookJobRepo, mockDeliveryRepo, mockBlobStore, mockTxManager, mockWebhooks)
 
 	updatedName := "Updated User"
 	updatedTwitterID := "updated_twitter"
 	updatedFavoriteGoFeature := "CONCURRENCY"
-	updatedIcon := "data:image/png;base64,..."
+	updatedIcon := "data:image/png;base64," + base64.StdEncoding.EncodeToString(testPNG(t))
+	invalidIcon := "data:image/png;base64,aGVsbG8="
+	currentIcon := "http://blobs/icons/1/current.png"
+	currentThumbnail := "http://blobs/icons/1/current_thumb.png"
+	emptyIcon := ""
+	invalidTwitterID := "not a handle"
+	noGoFeatures := []string{}
+
+	expectTx := func() *gomock.Call {
+		return mockTxManager.EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+	}
 
 	tests := []struct {
 		testName          string
@@ -217,6 +736,7 @@ func TestUserUsecase_Update(t *testing.T) {
 		name              *string
 		twitterID         *string
 		favoriteGoFeature *string
+		goFeatures        *[]string
 		icon              *string
 		mockFn            func()
 		want              *entity.User
@@ -233,6 +753,7 @@ func TestUserUsecase_Update(t *testing.T) {
 						ID:   1,
 						Name: "Original User",
 					}, nil)
+				expectTx()
 				mockRepo.EXPECT().
 					Update(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, user *entity.User) error {
@@ -256,24 +777,139 @@ func TestUserUsecase_Update(t *testing.T) {
 				mockRepo.EXPECT().
 					FindByID(gomock.Any(), uint(1)).
 					Return(&entity.User{
-						ID:   1,
-						Name: "Original User",
+						ID:            1,
+						Name:          "Original User",
+						Icon:          &currentIcon,
+						IconThumbnail: &currentThumbnail,
 					}, nil)
+				// Codes in the free-form text are chosen as well
+				mockGoFeatureRepo.EXPECT().
+					FindAll(gomock.Any()).
+					Return(testGoFeatures, nil)
+				expectTx()
+				mockGoFeatureRepo.EXPECT().
+					ReplaceUserFeatures(gomock.Any(), uint(1), []uint{1}).
+					Return(nil)
+				expectPut(mockBlobStore).Times(2)
 				mockRepo.EXPECT().
 					Update(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, user *entity.User) error {
+						assert.Equal(t, "Updated User", user.Name)
+						assert.Regexp(t, `^http://blobs/icons/1/[0-9a-f]{32}\.png$`, *user.Icon)
 						return nil
 					})
+				mockBlobStore.EXPECT().KeyForURL(currentIcon).Return("icons/1/current.png", true)
+				mockBlobStore.EXPECT().Delete(gomock.Any(), "icons/1/current.png").Return(nil)
+				mockBlobStore.EXPECT().KeyForURL(currentThumbnail).Return("icons/1/current_thumb.png", true)
+				mockBlobStore.EXPECT().Delete(gomock.Any(), "icons/1/current_thumb.png").Return(nil)
 			},
 			want: &entity.User{
 				ID:                1,
 				Name:              "Updated User",
 				TwitterID:         &updatedTwitterID,
 				FavoriteGoFeature: &updatedFavoriteGoFeature,
-				Icon:              &updatedIcon,
+				GoFeatures:        []entity.GoFeature{testGoFeatures[0]},
+			},
+			wantErr: false,
+		},
+		{
+			testName: "success - current icon URL is kept",
+			id:       1,
+			name:     &updatedName,
+			icon:     &currentIcon,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, Name: "Original User", Icon: &currentIcon}, nil)
+				expectTx()
+				mockRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			want: &entity.User{
+				ID:   1,
+				Name: "Updated User",
+				Icon: &currentIcon,
+			},
+			wantErr: false,
+		},
+		{
+			testName: "success - empty icon removes it",
+			id:       1,
+			icon:     &emptyIcon,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, Name: "Original User", Icon: &currentIcon, IconThumbnail: &currentThumbnail}, nil)
+				expectTx()
+				mockRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, user *entity.User) error {
+						assert.Nil(t, user.Icon)
+						assert.Nil(t, user.IconThumbnail)
+						return nil
+					})
+				mockBlobStore.EXPECT().KeyForURL(currentIcon).Return("icons/1/current.png", true)
+				mockBlobStore.EXPECT().Delete(gomock.Any(), "icons/1/current.png").Return(nil)
+				mockBlobStore.EXPECT().KeyForURL(currentThumbnail).Return("icons/1/current_thumb.png", true)
+				mockBlobStore.EXPECT().Delete(gomock.Any(), "icons/1/current_thumb.png").Return(nil)
+			},
+			want: &entity.User{
+				ID:   1,
+				Name: "Original User",
+			},
+			wantErr: false,
+		},
+		{
+			testName:   "success - empty go features clear the choice",
+			id:         1,
+			goFeatures: &noGoFeatures,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, Name: "Original User", FavoriteGoFeature: &updatedFavoriteGoFeature}, nil)
+				mockGoFeatureRepo.EXPECT().
+					FindAll(gomock.Any()).
+					Return(testGoFeatures, nil)
+				expectTx()
+				mockGoFeatureRepo.EXPECT().
+					ReplaceUserFeatures(gomock.Any(), uint(1), []uint{}).
+					Return(nil)
+				mockRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, user *entity.User) error {
+						assert.Nil(t, user.FavoriteGoFeature)
+						return nil
+					})
+			},
+			want: &entity.User{
+				ID:         1,
+				Name:       "Original User",
+				GoFeatures: []entity.GoFeature{},
 			},
 			wantErr: false,
 		},
+		{
+			testName:  "invalid profile",
+			id:        1,
+			twitterID: &invalidTwitterID,
+			mockFn:    func() {},
+			want:      nil,
+			wantErr:   true,
+		},
+		{
+			testName: "invalid icon",
+			id:       1,
+			name:     &updatedName,
+			icon:     &invalidIcon,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, Name: "Original User"}, nil)
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			testName: "user not found",
 			id:       999,
@@ -286,6 +922,43 @@ func TestUserUsecase_Update(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			// The user keeps pointing at the current icon, so only the new one's blobs are deleted
+			testName: "commit error keeps the current icon",
+			id:       1,
+			name:     &updatedName,
+			icon:     &updatedIcon,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{
+						ID:            1,
+						Name:          "Original User",
+						Icon:          &currentIcon,
+						IconThumbnail: &currentThumbnail,
+					}, nil)
+				mockTxManager.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						if err := fn(ctx); err != nil {
+							return err
+						}
+						return assert.AnError
+					})
+				expectPut(mockBlobStore).Times(2)
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				mockBlobStore.EXPECT().
+					KeyForURL(gomock.Any()).
+					DoAndReturn(func(url string) (string, bool) {
+						assert.NotContains(t, []string{currentIcon, currentThumbnail}, url)
+						return strings.TrimPrefix(url, "http://blobs/"), true
+					}).
+					Times(2)
+				mockBlobStore.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			testName: "update error",
 			id:       1,
@@ -297,6 +970,7 @@ func TestUserUsecase_Update(t *testing.T) {
 						ID:   1,
 						Name: "Original User",
 					}, nil)
+				expectTx()
 				mockRepo.EXPECT().
 					Update(gomock.Any(), gomock.Any()).
 					Return(assert.AnError)
@@ -309,7 +983,7 @@ func TestUserUsecase_Update(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
 			tt.mockFn()
-			got, err := usecase.Update(context.Background(), tt.id, tt.name, tt.twitterID, tt.favoriteGoFeature, tt.icon)
+			got, err := usecase.Update(context.Background(), tt.id, tt.name, tt.twitterID, tt.favoriteGoFeature, tt.goFeatures, tt.icon)
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Nil(t, got)
@@ -326,6 +1000,9 @@ func TestUserUsecase_Update(t *testing.T) {
 				if tt.want.Icon != nil {
 					assert.Equal(t, tt.want.Icon, got.Icon)
 				}
+				if tt.want.GoFeatures != nil {
+					assert.Equal(t, tt.want.GoFeatures, got.GoFeatures)
+				}
 			}
 		})
 	}
@@ -337,21 +1014,96 @@ func TestUserUsecase_Delete(t *testing.T) {
 
 	mockRepo := mock.NewMockUserRepository(ctrl)
 	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
-	usecase := NewUserUsecase(mockRepo, mockUserStampRepo)
+	mockSessionRepo := mock.NewMockUserSessionRepository(ctrl)
+	mockUserRewardRepo := mock.NewMockUserRewardRepository(ctrl)
+	mockGoFeatureRepo := mock.NewMockGoFeatureRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockWebhookJobRepo := mock.NewMockWebhookJobRepository(ctrl)
+	mockDeliveryRepo := mock.NewMockWebhookDeliveryRepository(ctrl)
+	mockBlobStore := mock.NewMockBlobStore(ctrl)
+	mockTxManager := mock.NewMockTxManager(ctrl)
+	mockWebhooks := mock.NewMockWebhookDispatcher(ctrl)
+	usecase := NewUserUsecase(mockRepo, mockUserStampRepo, mockSessionRepo, mockUserRewardRepo, mockGoFeatureRepo, mockEventRepo, mockOutboxRepo, mockWebhookJobRepo, mockDeliveryRepo, mockBlobStore, mockTxManager, mockWebhooks)
+
+	// Run the unit of work directly, as the MySQL implementation does inside a transaction
+	expectTx := func() *gomock.Call {
+		return mockTxManager.EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+	}
 
 	tests := []struct {
 		name    string
 		id      uint
 		mockFn  func()
 		wantErr bool
+		errIs   error
 	}{
 		{
 			name: "success",
 			id:   1,
 			mockFn: func() {
-				mockRepo.EXPECT().
-					Delete(gomock.Any(), uint(1)).
-					Return(nil)
+				gomock.InOrder(
+					mockRepo.EXPECT().
+						FindByID(gomock.Any(), uint(1)).
+						Return(&entity.User{ID: 1, Name: "Test User"}, nil),
+					expectTx(),
+					mockUserStampRepo.EXPECT().
+						DeleteByUserID(gomock.Any(), uint(1)).
+						Return(nil),
+					mockUserRewardRepo.EXPECT().
+						DeleteByUserID(gomock.Any(), uint(1)).
+						Return(nil),
+					mockGoFeatureRepo.EXPECT().
+						DeleteByUserID(gomock.Any(), uint(1)).
+						Return(nil),
+					mockSessionRepo.EXPECT().
+						DeleteByUserID(gomock.Any(), uint(1)).
+						Return(nil),
+					mockOutboxRepo.EXPECT().
+						DeleteByUserID(gomock.Any(), uint(1)).
+						Return(nil),
+					mockWebhookJobRepo.EXPECT().
+						DeleteByUserID(gomock.Any(), uint(1)).
+						Return(nil),
+					mockDeliveryRepo.EXPECT().
+						DeleteByUserID(gomock.Any(), uint(1)).
+						Return(nil),
+					mockRepo.EXPECT().
+						Delete(gomock.Any(), uint(1)).
+						Return(nil),
+				)
+			},
+			wantErr: false,
+		},
+		{
+			name: "icon blobs are removed after the user",
+			id:   1,
+			mockFn: func() {
+				iconURL := "http://localhost:8080/blobs/icons/1/a.png"
+				thumbnailURL := "http://localhost:8080/blobs/icons/1/a_thumb.png"
+				gomock.InOrder(
+					mockRepo.EXPECT().
+						FindByID(gomock.Any(), uint(1)).
+						Return(&entity.User{ID: 1, Icon: &iconURL, IconThumbnail: &thumbnailURL}, nil),
+					expectTx(),
+					mockUserStampRepo.EXPECT().DeleteByUserID(gomock.Any(), uint(1)).Return(nil),
+					mockUserRewardRepo.EXPECT().DeleteByUserID(gomock.Any(), uint(1)).Return(nil),
+					mockGoFeatureRepo.EXPECT().DeleteByUserID(gomock.Any(), uint(1)).Return(nil),
+					mockSessionRepo.EXPECT().DeleteByUserID(gomock.Any(), uint(1)).Return(nil),
+					mockOutboxRepo.EXPECT().DeleteByUserID(gomock.Any(), uint(1)).Return(nil),
+					mockWebhookJobRepo.EXPECT().DeleteByUserID(gomock.Any(), uint(1)).Return(nil),
+					mockDeliveryRepo.EXPECT().DeleteByUserID(gomock.Any(), uint(1)).Return(nil),
+					mockRepo.EXPECT().Delete(gomock.Any(), uint(1)).Return(nil),
+					mockBlobStore.EXPECT().KeyForURL(iconURL).Return("icons/1/a.png", true),
+					mockBlobStore.EXPECT().Delete(gomock.Any(), "icons/1/a.png").Return(nil),
+					mockBlobStore.EXPECT().KeyForURL(thumbnailURL).Return("icons/1/a_thumb.png", true),
+					// A failure only leaves an orphaned file behind
+					mockBlobStore.EXPECT().Delete(gomock.Any(), "icons/1/a_thumb.png").Return(assert.AnError),
+				)
 			},
 			wantErr: false,
 		},
@@ -360,7 +1112,137 @@ func TestUserUsecase_Delete(t *testing.T) {
 			id:   999,
 			mockFn: func() {
 				mockRepo.EXPECT().
-					Delete(gomock.Any(), uint(999)).
+					FindByID(gomock.Any(), uint(999)).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrUserNotFound,
+		},
+		{
+			name: "find error",
+			id:   1,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name: "user stamps delete error",
+			id:   1,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, Name: "Test User"}, nil)
+				expectTx()
+				mockUserStampRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name: "user rewards delete error",
+			id:   1,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, Name: "Test User"}, nil)
+				expectTx()
+				mockUserStampRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(nil)
+				mockUserRewardRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name: "sessions delete error",
+			id:   1,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, Name: "Test User"}, nil)
+				expectTx()
+				mockUserStampRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(nil)
+				mockUserRewardRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(nil)
+				mockGoFeatureRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(nil)
+				mockSessionRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			// The user is kept, so the notifications about them are too
+			name: "pending webhook deliveries delete error",
+			id:   1,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, Name: "Test User"}, nil)
+				expectTx()
+				mockUserStampRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(nil)
+				mockUserRewardRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(nil)
+				mockGoFeatureRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(nil)
+				mockSessionRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(nil)
+				mockOutboxRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(nil)
+				mockWebhookJobRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name: "user delete error",
+			id:   1,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1, Name: "Test User"}, nil)
+				expectTx()
+				mockUserStampRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(nil)
+				mockUserRewardRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(nil)
+				mockGoFeatureRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(nil)
+				mockSessionRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(nil)
+				mockOutboxRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(nil)
+				mockWebhookJobRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(nil)
+				mockDeliveryRepo.EXPECT().
+					DeleteByUserID(gomock.Any(), uint(1)).
+					Return(nil)
+				mockRepo.EXPECT().
+					Delete(gomock.Any(), uint(1)).
 					Return(assert.AnError)
 			},
 			wantErr: true,
@@ -373,8 +1255,93 @@ func TestUserUsecase_Delete(t *testing.T) {
 			err := usecase.Delete(context.Background(), tt.id)
 			if tt.wantErr {
 				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_IssueToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockUserRepository(ctrl)
+	mockUserStampRepo := mock.NewMockUserStampRepository(ctrl)
+	mockSessionRepo := mock.NewMockUserSessionRepository(ctrl)
+	mockUserRewardRepo := mock.NewMockUserRewardRepository(ctrl)
+	mockGoFeatureRepo := mock.NewMockGoFeatureRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	mockOutboxRepo := mock.NewMockOutboxRepository(ctrl)
+	mockWebhookJobRepo := mock.NewMockWebhookJobRepository(ctrl)
+	mockDeliveryRepo := mock.NewMockWebhookDeliveryRepository(ctrl)
+	mockBlobStore := mock.NewMockBlobStore(ctrl)
+	mockTxManager := mock.NewMockTxManager(ctrl)
+	mockWebhooks := mock.NewMockWebhookDispatcher(ctrl)
+	usecase := NewUserUsecase(mockRepo, mockUserStampRepo, mockSessionRepo, mockUserRewardRepo, mockGoFeatureRepo, mockEventRepo, mockOutboxRepo, mockWebhookJobRepo, mockDeliveryRepo, mockBlobStore, mockTxManager, mockWebhooks)
+
+	tests := []struct {
+		name    string
+		id      uint
+		mockFn  func()
+		wantErr bool
+		errIs   error
+	}{
+		{
+			name: "success",
+			id:   1,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1}, nil)
+				mockSessionRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, session *entity.UserSession) error {
+						assert.Equal(t, uint(1), session.UserID)
+						return nil
+					})
+			},
+		},
+		{
+			name: "user not found",
+			id:   999,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(999)).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrUserNotFound,
+		},
+		{
+			name: "session create error",
+			id:   1,
+			mockFn: func() {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1)).
+					Return(&entity.User{ID: 1}, nil)
+				mockSessionRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			wantErr: true,
+			errIs:   assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+			accessToken, err := usecase.IssueToken(context.Background(), tt.id)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.errIs)
+				assert.Empty(t, accessToken)
 			} else {
 				assert.NoError(t, err)
+				assert.NotEmpty(t, accessToken)
 			}
 		})
 	}
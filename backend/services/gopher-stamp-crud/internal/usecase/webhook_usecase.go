@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/repository"
+
+	"gorm.io/gorm"
+)
+
+// Organizer-chosen secrets must not be guessable and must fit webhooks.secret.
+const (
+	minWebhookSecretLength = 16
+	maxWebhookSecretLength = 128
+	maxWebhookURLLength    = 2048
+)
+
+type WebhookUseCase interface {
+	ListWebhooks(ctx context.Context, eventID uint) ([]entity.Webhook, error)
+	// CreateWebhook subscribes url to the event types of the event. A secret is generated when
+	// secret is empty; the returned webhook carries it so it can be shown to the organizer once.
+	CreateWebhook(ctx context.Context, eventID uint, url string, eventTypes []entity.WebhookEventType, secret string) (*entity.Webhook, error)
+	DeleteWebhook(ctx context.Context, id uint) error
+	// ListDeliveries returns the latest limit delivery attempts of the webhook, newest first.
+	ListDeliveries(ctx context.Context, webhookID uint, limit int) ([]entity.WebhookDelivery, error)
+}
+
+type webhookUseCase struct {
+	webhookRepo  repository.WebhookRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+	eventRepo    repository.EventRepository
+}
+
+func NewWebhookUseCase(
+	webhookRepo repository.WebhookRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+	eventRepo repository.EventRepository,
+) WebhookUseCase {
+	return &webhookUseCase{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		eventRepo:    eventRepo,
+	}
+}
+
+func (uc *webhookUseCase) ListWebhooks(ctx context.Context, eventID uint) ([]entity.Webhook, error) {
+	if _, err := findEvent(ctx, uc.eventRepo, eventID); err != nil {
+		return nil, err
+	}
+	return uc.webhookRepo.FindByEventID(ctx, eventID)
+}
+
+func (uc *webhookUseCase) CreateWebhook(ctx context.Context, eventID uint, rawURL string, eventTypes []entity.WebhookEventType, secret string) (*entity.Webhook, error) {
+	if _, err := findEvent(ctx, uc.eventRepo, eventID); err != nil {
+		return nil, err
+	}
+
+	webhook := &entity.Webhook{
+		EventID: eventID,
+		URL:     strings.TrimSpace(rawURL),
+		Secret:  secret,
+	}
+	u, err := url.Parse(webhook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || len(webhook.URL) > maxWebhookURLLength {
+		return nil, apperr.ErrInvalidWebhook.WithDetails("url must be an absolute http or https URL")
+	}
+
+	if len(eventTypes) == 0 {
+		return nil, apperr.ErrInvalidWebhook.WithDetails("event_types must not be empty")
+	}
+	for _, t := range eventTypes {
+		if !slices.Contains(entity.WebhookEventTypes, t) {
+			return nil, apperr.ErrInvalidWebhook.WithDetails(`event_types must be "user.registered", "stamp.acquired" or "rally.completed"`)
+		}
+		if !slices.Contains(webhook.EventTypes, t) {
+			webhook.EventTypes = append(webhook.EventTypes, t)
+		}
+	}
+
+	if webhook.Secret == "" {
+		if webhook.Secret, err = generateWebhookSecret(); err != nil {
+			return nil, err
+		}
+	} else if len(webhook.Secret) < minWebhookSecretLength || len(webhook.Secret) > maxWebhookSecretLength {
+		return nil, apperr.ErrInvalidWebhook.WithDetails("secret must be between 16 and 128 characters")
+	}
+
+	if err := uc.webhookRepo.Create(ctx, webhook); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func (uc *webhookUseCase) DeleteWebhook(ctx context.Context, id uint) error {
+	if _, err := uc.findWebhook(ctx, id); err != nil {
+		return err
+	}
+	return uc.webhookRepo.Delete(ctx, id)
+}
+
+func (uc *webhookUseCase) ListDeliveries(ctx context.Context, webhookID uint, limit int) ([]entity.WebhookDelivery, error) {
+	if _, err := uc.findWebhook(ctx, webhookID); err != nil {
+		return nil, err
+	}
+	return uc.deliveryRepo.FindByWebhookID(ctx, webhookID, limit)
+}
+
+func (uc *webhookUseCase) findWebhook(ctx context.Context, id uint) (*entity.Webhook, error) {
+	webhook, err := uc.webhookRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperr.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,246 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/apperr"
+	"2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/entity"
+	mock "2025_gopher_StampRally/services/gopher-stamp-crud/internal/domain/mock_repository"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestWebhookUseCase_CreateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWebhookRepo := mock.NewMockWebhookRepository(ctrl)
+	mockDeliveryRepo := mock.NewMockWebhookDeliveryRepository(ctrl)
+	mockEventRepo := mock.NewMockEventRepository(ctrl)
+	usecase := NewWebhookUseCase(mockWebhookRepo, mockDeliveryRepo, mockEventRepo)
+
+	mockEventRepo.EXPECT().
+		FindByID(gomock.Any(), uint(1)).
+		Return(&entity.Event{ID: 1}, nil).
+		AnyTimes()
+
+	allTypes := []entity.WebhookEventType{entity.WebhookStampAcquired, entity.WebhookRallyCompleted}
+
+	tests := []struct {
+		name           string
+		eventID        uint
+		url            string
+		eventTypes     []entity.WebhookEventType
+		secret         string
+		mockFn         func()
+		wantURL        string
+		wantEventTypes []entity.WebhookEventType
+		wantSecret     string // checked when not empty; a generated secret is 64 hex characters
+		wantErr        bool
+		errIs          error
+	}{
+		{
+			name:       "generated secret",
+			eventID:    1,
+			url:        " https://example.com/hooks/stamp-rally ",
+			eventTypes: allTypes,
+			mockFn: func() {
+				mockWebhookRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantURL:        "https://example.com/hooks/stamp-rally",
+			wantEventTypes: allTypes,
+		},
+		{
+			name:       "chosen secret and duplicate event types",
+			eventID:    1,
+			url:        "http://localhost:9000/hook",
+			eventTypes: []entity.WebhookEventType{entity.WebhookUserRegistered, entity.WebhookUserRegistered},
+			secret:     "0123456789abcdef",
+			mockFn: func() {
+				mockWebhookRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantURL:        "http://localhost:9000/hook",
+			wantEventTypes: []entity.WebhookEventType{entity.WebhookUserRegistered},
+			wantSecret:     "0123456789abcdef",
+		},
+		{
+			name:       "event not found",
+			eventID:    999,
+			url:        "https://example.com/hook",
+			eventTypes: allTypes,
+			mockFn: func() {
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), uint(999)).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrEventNotFound,
+		},
+		{
+			name:       "relative url",
+			eventID:    1,
+			url:        "/hook",
+			eventTypes: allTypes,
+			mockFn:     func() {},
+			wantErr:    true,
+			errIs:      apperr.ErrInvalidWebhook,
+		},
+		{
+			name:       "unsupported scheme",
+			eventID:    1,
+			url:        "ftp://example.com/hook",
+			eventTypes: allTypes,
+			mockFn:     func() {},
+			wantErr:    true,
+			errIs:      apperr.ErrInvalidWebhook,
+		},
+		{
+			name:       "too long url",
+			eventID:    1,
+			url:        "https://example.com/" + strings.Repeat("a", 2048),
+			eventTypes: allTypes,
+			mockFn:     func() {},
+			wantErr:    true,
+			errIs:      apperr.ErrInvalidWebhook,
+		},
+		{
+			name:    "no event types",
+			eventID: 1,
+			url:     "https://example.com/hook",
+			mockFn:  func() {},
+			wantErr: true,
+			errIs:   apperr.ErrInvalidWebhook,
+		},
+		{
+			name:       "unknown event type",
+			eventID:    1,
+			url:        "https://example.com/hook",
+			eventTypes: []entity.WebhookEventType{"stamp.deleted"},
+			mockFn:     func() {},
+			wantErr:    true,
+			errIs:      apperr.ErrInvalidWebhook,
+		},
+		{
+			name:       "short secret",
+			eventID:    1,
+			url:        "https://example.com/hook",
+			eventTypes: allTypes,
+			secret:     "secret",
+			mockFn:     func() {},
+			wantErr:    true,
+			errIs:      apperr.ErrInvalidWebhook,
+		},
+		{
+			name:       "create error",
+			eventID:    1,
+			url:        "https://example.com/hook",
+			eventTypes: allTypes,
+			mockFn: func() {
+				mockWebhookRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+			webhook, err := usecase.CreateWebhook(context.Background(), tt.eventID, tt.url, tt.eventTypes, tt.secret)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, webhook)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.eventID, webhook.EventID)
+				assert.Equal(t, tt.wantURL, webhook.URL)
+				assert.Equal(t, tt.wantEventTypes, webhook.EventTypes)
+				if tt.wantSecret != "" {
+					assert.Equal(t, tt.wantSecret, webhook.Secret)
+				} else {
+					assert.Len(t, webhook.Secret, 64)
+				}
+			}
+		})
+	}
+}
+
+func TestWebhookUseCase_DeleteWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWebhookRepo := mock.NewMockWebhookRepository(ctrl)
+	usecase := NewWebhookUseCase(mockWebhookRepo, mock.NewMockWebhookDeliveryRepository(ctrl), mock.NewMockEventRepository(ctrl))
+
+	tests := []struct {
+		name    string
+		id      uint
+		mockFn  func()
+		wantErr bool
+		errIs   error
+	}{
+		{
+			name: "success",
+			id:   1,
+			mockFn: func() {
+				mockWebhookRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&entity.Webhook{ID: 1}, nil)
+				mockWebhookRepo.EXPECT().Delete(gomock.Any(), uint(1)).Return(nil)
+			},
+		},
+		{
+			name: "webhook not found",
+			id:   999,
+			mockFn: func() {
+				mockWebhookRepo.EXPECT().FindByID(gomock.Any(), uint(999)).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+			errIs:   apperr.ErrWebhookNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFn()
+			err := usecase.DeleteWebhook(context.Background(), tt.id)
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebhookUseCase_ListDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWebhookRepo := mock.NewMockWebhookRepository(ctrl)
+	mockDeliveryRepo := mock.NewMockWebhookDeliveryRepository(ctrl)
+	usecase := NewWebhookUseCase(mockWebhookRepo, mockDeliveryRepo, mock.NewMockEventRepository(ctrl))
+
+	t.Run("success", func(t *testing.T) {
+		deliveries := []entity.WebhookDelivery{{ID: 2, WebhookID: 1, Attempt: 2}, {ID: 1, WebhookID: 1, Attempt: 1}}
+		mockWebhookRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(&entity.Webhook{ID: 1}, nil)
+		mockDeliveryRepo.EXPECT().FindByWebhookID(gomock.Any(), uint(1), 10).Return(deliveries, nil)
+
+		got, err := usecase.ListDeliveries(context.Background(), 1, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, deliveries, got)
+	})
+
+	t.Run("webhook not found", func(t *testing.T) {
+		mockWebhookRepo.EXPECT().FindByID(gomock.Any(), uint(999)).Return(nil, gorm.ErrRecordNotFound)
+
+		got, err := usecase.ListDeliveries(context.Background(), 999, 10)
+		assert.ErrorIs(t, err, apperr.ErrWebhookNotFound)
+		assert.Nil(t, got)
+	})
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS user_stamps;
+DROP TABLE IF EXISTS stamps;
+DROP TABLE IF EXISTS users;
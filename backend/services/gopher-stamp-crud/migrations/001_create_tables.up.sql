@@ -0,0 +1,38 @@
+-- Baseline schema, as created by the gorm AutoMigrate that ran at server startup before
+-- migrations existed. Databases created that way already have these tables, so the statements
+-- leave them untouched and later versions bring both kinds of database to the same schema.
+
+-- Create users table
+CREATE TABLE IF NOT EXISTS users (
+    id BIGINT UNSIGNED AUTO_INCREMENT,
+    -- ニックネーム（表示名）
+    name VARCHAR(100) NOT NULL,
+    -- Twitter ID（@なしで保存）
+    twitter_id VARCHAR(50),
+    -- 好きなGoのポイント（複数選択はカンマ区切りなどで保存）
+    favorite_go_feature VARCHAR(500),
+    -- プロフィール画像（base64エンコード）
+    icon LONGTEXT,
+    created_at DATETIME(3) NULL,
+    updated_at DATETIME(3) NULL,
+    PRIMARY KEY (id)
+);
+
+-- Create stamps table
+CREATE TABLE IF NOT EXISTS stamps (
+    id BIGINT UNSIGNED AUTO_INCREMENT,
+    name VARCHAR(100) NOT NULL,
+    created_at DATETIME(3) NULL,
+    updated_at DATETIME(3) NULL,
+    PRIMARY KEY (id)
+);
+
+-- Create user_stamps table (junction table)
+CREATE TABLE IF NOT EXISTS user_stamps (
+    user_id BIGINT UNSIGNED,
+    stamp_id BIGINT UNSIGNED,
+    acquired_at DATETIME(3) NULL,
+    PRIMARY KEY (user_id, stamp_id),
+    CONSTRAINT fk_user_stamps_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT fk_user_stamps_stamp FOREIGN KEY (stamp_id) REFERENCES stamps(id)
+);
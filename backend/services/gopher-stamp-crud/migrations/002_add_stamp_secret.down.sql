@@ -0,0 +1,2 @@
+ALTER TABLE stamps
+    DROP COLUMN secret;
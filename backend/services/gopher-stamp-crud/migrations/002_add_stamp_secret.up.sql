@@ -0,0 +1,22 @@
+-- Secret each booth screen derives its rotating acquisition code from.
+ALTER TABLE stamps
+    -- ブース画面のローテーションコード生成用の秘密鍵
+    ADD COLUMN secret VARCHAR(64) NOT NULL DEFAULT '' AFTER name;
+
+-- Stamps created before rotating codes existed have no secret. Each gets 20 random bytes in the
+-- unpadded base32 of stamptoken.GenerateSecret: 32 characters of 5 random bits each.
+UPDATE stamps
+JOIN (
+    WITH RECURSIVE positions (n) AS (
+        SELECT 1
+        UNION ALL
+        SELECT n + 1 FROM positions WHERE n < 32
+    )
+    SELECT s.id,
+        GROUP_CONCAT(SUBSTRING('ABCDEFGHIJKLMNOPQRSTUVWXYZ234567', 1 + (ORD(RANDOM_BYTES(1)) & 31), 1) SEPARATOR '') AS secret
+    FROM stamps s
+    CROSS JOIN positions
+    WHERE s.secret = ''
+    GROUP BY s.id
+) generated ON generated.id = stamps.id
+SET stamps.secret = generated.secret;
@@ -0,0 +1,8 @@
+-- Hashed access tokens issued to participants on registration.
+CREATE TABLE IF NOT EXISTS user_sessions (
+    token_hash VARCHAR(64) NOT NULL PRIMARY KEY,
+    user_id BIGINT UNSIGNED NOT NULL,
+    created_at DATETIME(3) NULL,
+    INDEX idx_user_sessions_user_id (user_id),
+    CONSTRAINT fk_user_sessions_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,13 @@
+DROP INDEX idx_stamps_created_at ON stamps;
+DROP INDEX idx_users_created_at ON users;
+
+ALTER TABLE user_stamps
+    DROP FOREIGN KEY fk_user_stamps_user,
+    DROP FOREIGN KEY fk_user_stamps_stamp;
+
+ALTER TABLE user_stamps
+    RENAME INDEX idx_user_stamps_stamp_id TO fk_user_stamps_stamp;
+
+ALTER TABLE user_stamps
+    ADD CONSTRAINT fk_user_stamps_user FOREIGN KEY (user_id) REFERENCES users(id),
+    ADD CONSTRAINT fk_user_stamps_stamp FOREIGN KEY (stamp_id) REFERENCES stamps(id);
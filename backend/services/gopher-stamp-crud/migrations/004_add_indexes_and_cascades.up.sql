@@ -0,0 +1,16 @@
+-- Deleting a participant or a stamp deletes its acquisitions too, and lists are ordered by creation time.
+-- The foreign keys have to be dropped before they can be added again with ON DELETE CASCADE.
+ALTER TABLE user_stamps
+    DROP FOREIGN KEY fk_user_stamps_user,
+    DROP FOREIGN KEY fk_user_stamps_stamp;
+
+-- MySQL named the index it created for fk_user_stamps_stamp after the constraint
+ALTER TABLE user_stamps
+    RENAME INDEX fk_user_stamps_stamp TO idx_user_stamps_stamp_id;
+
+ALTER TABLE user_stamps
+    ADD CONSTRAINT fk_user_stamps_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_user_stamps_stamp FOREIGN KEY (stamp_id) REFERENCES stamps(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_users_created_at ON users (created_at);
+CREATE INDEX idx_stamps_created_at ON stamps (created_at);
@@ -0,0 +1,3 @@
+ALTER TABLE stamps
+    DROP INDEX idx_stamps_seed_key,
+    DROP COLUMN seed_key;
@@ -0,0 +1,5 @@
+-- Identify stamps managed by the seed file so it can be re-applied without duplicating rows.
+-- Stamps created through the API have no key.
+ALTER TABLE stamps
+    ADD COLUMN seed_key VARCHAR(100) NULL AFTER id,
+    ADD UNIQUE INDEX idx_stamps_seed_key (seed_key);
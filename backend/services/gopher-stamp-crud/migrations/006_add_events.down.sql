@@ -0,0 +1,13 @@
+ALTER TABLE users
+    DROP FOREIGN KEY fk_users_event,
+    DROP INDEX idx_users_event_id,
+    DROP COLUMN event_id;
+
+-- Seed keys were globally unique before events existed
+ALTER TABLE stamps
+    DROP FOREIGN KEY fk_stamps_event,
+    DROP INDEX idx_stamps_event_seed_key,
+    ADD UNIQUE INDEX idx_stamps_seed_key (seed_key),
+    DROP COLUMN event_id;
+
+DROP TABLE IF EXISTS events;
@@ -0,0 +1,31 @@
+-- Create events table. One deployment hosts several stamp rallies; stamps and
+-- participants belong to exactly one of them.
+CREATE TABLE IF NOT EXISTS events (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    -- 開催期間（既存データ用のデフォルトイベントでは未設定）
+    starts_at DATETIME(3) NULL,
+    ends_at DATETIME(3) NULL,
+    created_at DATETIME(3) NULL,
+    updated_at DATETIME(3) NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Existing stamps and participants move to the default event (ID 1),
+-- which the routes without an event prefix keep operating on.
+INSERT INTO events (id, name, created_at, updated_at)
+VALUES (1, 'Go Workshop Conference 2025', NOW(3), NOW(3));
+
+ALTER TABLE stamps
+    ADD COLUMN event_id BIGINT UNSIGNED NOT NULL DEFAULT 1 AFTER id,
+    DROP INDEX idx_stamps_seed_key,
+    ADD UNIQUE INDEX idx_stamps_event_seed_key (event_id, seed_key),
+    ADD CONSTRAINT fk_stamps_event FOREIGN KEY (event_id) REFERENCES events(id);
+
+ALTER TABLE stamps ALTER COLUMN event_id DROP DEFAULT;
+
+ALTER TABLE users
+    ADD COLUMN event_id BIGINT UNSIGNED NOT NULL DEFAULT 1 AFTER id,
+    ADD INDEX idx_users_event_id (event_id),
+    ADD CONSTRAINT fk_users_event FOREIGN KEY (event_id) REFERENCES events(id);
+
+ALTER TABLE users ALTER COLUMN event_id DROP DEFAULT;
@@ -0,0 +1,3 @@
+ALTER TABLE stamps
+    DROP COLUMN available_until,
+    DROP COLUMN available_from;
@@ -0,0 +1,5 @@
+-- Optional acquisition window per stamp, e.g. a workshop stamp that opens at 13:00.
+-- NULL means no limit on that side.
+ALTER TABLE stamps
+    ADD COLUMN available_from DATETIME(3) NULL AFTER secret,
+    ADD COLUMN available_until DATETIME(3) NULL AFTER available_from;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS user_rewards;
+DROP TABLE IF EXISTS reward_rule_stamps;
+DROP TABLE IF EXISTS reward_rules;
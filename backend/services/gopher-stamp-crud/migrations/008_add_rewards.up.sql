@@ -0,0 +1,36 @@
+-- Prizes of an event and the condition for earning them.
+CREATE TABLE IF NOT EXISTS reward_rules (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    event_id BIGINT UNSIGNED NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    -- all: イベントの全スタンプ, count: 任意のrequired_count個, stamps: reward_rule_stampsの全スタンプ
+    kind VARCHAR(20) NOT NULL,
+    required_count INT NOT NULL DEFAULT 0,
+    created_at DATETIME(3) NULL,
+    updated_at DATETIME(3) NULL,
+    INDEX idx_reward_rules_event_id (event_id),
+    CONSTRAINT fk_reward_rules_event FOREIGN KEY (event_id) REFERENCES events(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Stamps required by rules of kind "stamps", e.g. both workshops.
+CREATE TABLE IF NOT EXISTS reward_rule_stamps (
+    reward_rule_id BIGINT UNSIGNED NOT NULL,
+    stamp_id BIGINT UNSIGNED NOT NULL,
+    PRIMARY KEY (reward_rule_id, stamp_id),
+    INDEX idx_reward_rule_stamps_stamp_id (stamp_id),
+    CONSTRAINT fk_reward_rule_stamps_rule FOREIGN KEY (reward_rule_id) REFERENCES reward_rules(id) ON DELETE CASCADE,
+    CONSTRAINT fk_reward_rule_stamps_stamp FOREIGN KEY (stamp_id) REFERENCES stamps(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Rewards earned by participants. The primary key lets a reward be earned only once,
+-- and redeemed_at records that staff handed the prize out.
+CREATE TABLE IF NOT EXISTS user_rewards (
+    user_id BIGINT UNSIGNED NOT NULL,
+    reward_rule_id BIGINT UNSIGNED NOT NULL,
+    earned_at DATETIME(3) NULL,
+    redeemed_at DATETIME(3) NULL,
+    PRIMARY KEY (user_id, reward_rule_id),
+    INDEX idx_user_rewards_reward_rule_id (reward_rule_id),
+    CONSTRAINT fk_user_rewards_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_user_rewards_reward_rule FOREIGN KEY (reward_rule_id) REFERENCES reward_rules(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
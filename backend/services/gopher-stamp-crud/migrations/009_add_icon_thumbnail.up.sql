@@ -0,0 +1,4 @@
+-- Icons are stored in the blob store and users.icon holds their URL.
+-- The column stays LONGTEXT until "server migrate-icons" has moved the remaining base64 icons out.
+ALTER TABLE users
+    ADD COLUMN icon_thumbnail VARCHAR(2048) NULL AFTER icon;
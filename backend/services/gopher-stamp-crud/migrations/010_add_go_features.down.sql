@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS user_go_features;
+DROP TABLE IF EXISTS go_features;
@@ -0,0 +1,45 @@
+-- Catalogue of the Go features participants choose their favorites from.
+CREATE TABLE IF NOT EXISTS go_features (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    -- クライアントが送受信する識別子（例: CONCURRENCY）
+    code VARCHAR(50) NOT NULL,
+    -- 表示名
+    label VARCHAR(100) NOT NULL,
+    -- 選択肢の表示順
+    sort_order INT NOT NULL DEFAULT 0,
+    created_at DATETIME(3) NULL,
+    updated_at DATETIME(3) NULL,
+    UNIQUE INDEX idx_go_features_code (code)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- The choices the frontend has offered so far, under the codes it already stores.
+INSERT INTO go_features (code, label, sort_order, created_at, updated_at) VALUES
+    ('CONCURRENCY', '並行処理（goroutines/channels）', 1, NOW(3), NOW(3)),
+    ('INTERFACES', 'インターフェース', 2, NOW(3), NOW(3)),
+    ('ERROR_HANDLING', 'エラーハンドリング', 3, NOW(3), NOW(3)),
+    ('PERFORMANCE', 'パフォーマンス', 4, NOW(3), NOW(3)),
+    ('STD_LIB', '標準ライブラリ', 5, NOW(3), NOW(3)),
+    ('TESTING', 'テスト', 6, NOW(3), NOW(3)),
+    ('DEPLOYMENT', 'デプロイ', 7, NOW(3), NOW(3)),
+    ('MIDDLEWARE', 'ミドルウェア', 8, NOW(3), NOW(3)),
+    ('TYPE_SYSTEM', '型システム', 9, NOW(3), NOW(3)),
+    ('PACKAGE_MANAGEMENT', 'パッケージ管理', 10, NOW(3), NOW(3)),
+    ('BEST_PRACTICES', 'ベストプラクティス', 11, NOW(3), NOW(3)),
+    ('MEMORY_MANAGEMENT', 'メモリ管理', 12, NOW(3), NOW(3));
+
+-- Go features chosen by each participant.
+CREATE TABLE IF NOT EXISTS user_go_features (
+    user_id BIGINT UNSIGNED NOT NULL,
+    go_feature_id BIGINT UNSIGNED NOT NULL,
+    PRIMARY KEY (user_id, go_feature_id),
+    INDEX idx_user_go_features_go_feature_id (go_feature_id),
+    CONSTRAINT fk_user_go_features_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_user_go_features_go_feature FOREIGN KEY (go_feature_id) REFERENCES go_features(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Link the choices stored so far as comma-separated codes in users.favorite_go_feature.
+-- Free text that matches no code is left in the column only.
+INSERT INTO user_go_features (user_id, go_feature_id)
+SELECT users.id, go_features.id
+FROM users
+JOIN go_features ON FIND_IN_SET(go_features.code, REPLACE(users.favorite_go_feature, ' ', '')) > 0;
@@ -0,0 +1,30 @@
+-- Organizers' subscriptions to the events of a stamp rally.
+CREATE TABLE IF NOT EXISTS webhooks (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    event_id BIGINT UNSIGNED NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    -- 配信するリクエストのHMAC署名に使う鍵
+    secret VARCHAR(128) NOT NULL,
+    -- 購読するイベント種別のJSON配列（例: ["stamp.acquired", "rally.completed"]）
+    event_types JSON NOT NULL,
+    created_at DATETIME(3) NULL,
+    updated_at DATETIME(3) NULL,
+    INDEX idx_webhooks_event_id (event_id),
+    CONSTRAINT fk_webhooks_event FOREIGN KEY (event_id) REFERENCES events(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Every attempt to deliver an event to a webhook, kept for troubleshooting receivers.
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    webhook_id BIGINT UNSIGNED NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload TEXT NOT NULL,
+    attempt INT NOT NULL,
+    -- 応答がなかった場合はNULL
+    status_code INT NULL,
+    error VARCHAR(500) NULL,
+    succeeded BOOLEAN NOT NULL,
+    attempted_at DATETIME(3) NOT NULL,
+    INDEX idx_webhook_deliveries_webhook_id (webhook_id),
+    CONSTRAINT fk_webhook_deliveries_webhook FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
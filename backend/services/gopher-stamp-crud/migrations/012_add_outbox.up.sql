@@ -0,0 +1,10 @@
+-- Domain events written in the same transaction as the change they describe and published by the relay.
+CREATE TABLE IF NOT EXISTS outbox (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    type VARCHAR(50) NOT NULL,
+    payload TEXT NOT NULL,
+    created_at DATETIME(3) NULL,
+    -- 未配信の場合はNULL
+    delivered_at DATETIME(3) NULL,
+    INDEX idx_outbox_delivered_at (delivered_at, id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
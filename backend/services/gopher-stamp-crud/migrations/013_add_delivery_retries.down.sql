@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS webhook_jobs;
+ALTER TABLE outbox
+    DROP COLUMN attempts,
+    DROP COLUMN last_error,
+    DROP COLUMN retry_at,
+    DROP COLUMN failed_at;
@@ -0,0 +1,26 @@
+-- Outbox messages that fail are retried with backoff and set aside after too many attempts,
+-- so that one bad message cannot hold back the others.
+ALTER TABLE outbox
+    ADD COLUMN attempts INT NOT NULL DEFAULT 0 AFTER payload,
+    ADD COLUMN last_error VARCHAR(500) NULL AFTER attempts,
+    -- 再試行を待っている場合は次に配信する時刻
+    ADD COLUMN retry_at DATETIME(3) NULL AFTER last_error,
+    -- 配信をあきらめた場合はその時刻
+    ADD COLUMN failed_at DATETIME(3) NULL AFTER delivered_at;
+
+-- Deliveries waiting to be made to a webhook, so that they survive a restart.
+-- A job is removed once its delivery succeeds or is given up; every attempt is recorded in webhook_deliveries.
+CREATE TABLE IF NOT EXISTS webhook_jobs (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    webhook_id BIGINT UNSIGNED NOT NULL,
+    -- X-Webhook-Idとして送るイベントのID
+    message_id VARCHAR(64) NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload TEXT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at DATETIME(3) NOT NULL,
+    created_at DATETIME(3) NULL,
+    UNIQUE KEY uk_webhook_jobs_webhook_message (webhook_id, message_id),
+    INDEX idx_webhook_jobs_next_attempt_at (next_attempt_at),
+    CONSTRAINT fk_webhook_jobs_webhook FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
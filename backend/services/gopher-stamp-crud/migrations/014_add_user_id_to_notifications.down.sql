@@ -0,0 +1,10 @@
+ALTER TABLE webhook_deliveries
+    DROP INDEX idx_webhook_deliveries_attempted_at,
+    DROP INDEX idx_webhook_deliveries_user_id,
+    DROP COLUMN user_id;
+ALTER TABLE webhook_jobs
+    DROP INDEX idx_webhook_jobs_user_id,
+    DROP COLUMN user_id;
+ALTER TABLE outbox
+    DROP INDEX idx_outbox_user_id,
+    DROP COLUMN user_id;
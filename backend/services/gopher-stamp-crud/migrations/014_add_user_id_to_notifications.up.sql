@@ -0,0 +1,19 @@
+-- Outbox messages and webhook deliveries name the participant they are about, so that deleting
+-- the participant erases them too. Rows written before are filled in from their JSON payload.
+ALTER TABLE outbox
+    ADD COLUMN user_id BIGINT UNSIGNED NOT NULL DEFAULT 0 AFTER type;
+UPDATE outbox SET user_id = COALESCE(JSON_UNQUOTE(JSON_EXTRACT(payload, '$.user_id')), 0);
+CREATE INDEX idx_outbox_user_id ON outbox (user_id);
+
+ALTER TABLE webhook_jobs
+    ADD COLUMN user_id BIGINT UNSIGNED NOT NULL DEFAULT 0 AFTER event_type;
+UPDATE webhook_jobs SET user_id = COALESCE(JSON_UNQUOTE(JSON_EXTRACT(payload, '$.user_id')), 0);
+CREATE INDEX idx_webhook_jobs_user_id ON webhook_jobs (user_id);
+
+ALTER TABLE webhook_deliveries
+    ADD COLUMN user_id BIGINT UNSIGNED NOT NULL DEFAULT 0 AFTER event_type;
+UPDATE webhook_deliveries SET user_id = COALESCE(JSON_UNQUOTE(JSON_EXTRACT(payload, '$.user_id')), 0);
+CREATE INDEX idx_webhook_deliveries_user_id ON webhook_deliveries (user_id);
+
+-- Finished rows are purged after a retention period, by when they were finished
+CREATE INDEX idx_webhook_deliveries_attempted_at ON webhook_deliveries (attempted_at);
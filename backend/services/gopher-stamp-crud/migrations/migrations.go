@@ -0,0 +1,11 @@
+// Package migrations embeds the versioned SQL migrations applied by the server's migrate subcommand.
+//
+// Files are named <version>_<name>.up.sql and <version>_<name>.down.sql, where version is
+// a zero-padded number. Migrations are applied in ascending version order and never edited
+// once released; schema changes are made by adding a new version.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,9 @@
+// Package seeds embeds the default master data loaded when no seed file is configured.
+package seeds
+
+import _ "embed"
+
+// Stamps is the default stamp seed in the format read by mysql.LoadStampSeedFile.
+//
+//go:embed stamps.yaml
+var Stamps []byte
@@ -10,12 +10,51 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-// AcquireStampRequest defines model for AcquireStampRequest.
+const (
+	AdminApiKeyScopes = "adminApiKey.Scopes"
+	BearerAuthScopes  = "bearerAuth.Scopes"
+)
+
+// Defines values for RewardKind.
+const (
+	All    RewardKind = "all"
+	Count  RewardKind = "count"
+	Stamps RewardKind = "stamps"
+)
+
+// Defines values for SortOrder.
+const (
+	Asc  SortOrder = "asc"
+	Desc SortOrder = "desc"
+)
+
+// Defines values for UserSort.
+const (
+	CreatedAt  UserSort = "created_at"
+	Name       UserSort = "name"
+	StampCount UserSort = "stamp_count"
+)
+
+// Defines values for WebhookEventType.
+const (
+	RallyCompleted WebhookEventType = "rally.completed"
+	StampAcquired  WebhookEventType = "stamp.acquired"
+	UserRegistered WebhookEventType = "user.registered"
+)
+
+// AcquireStampRequest スタンプ取得リクエスト。tokenまたはcodeのいずれかが必要（codeが優先される）。
 type AcquireStampRequest struct {
+	// Code ブース画面に表示されたローテーションコード
+	Code *string `json:"code,omitempty"`
+
 	// StampId 取得するスタンプのID
 	StampId int64 `json:"stamp_id"`
+
+	// Token QRコードに埋め込まれたスタンプ取得トークン
+	Token *string `json:"token,omitempty"`
 }
 
 // Error defines model for Error.
@@ -30,11 +69,256 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// Event defines model for Event.
+type Event struct {
+	// CreatedAt 作成日時
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// EndsAt 開催終了日時。これ以降はイベントのスタンプを取得できない（デフォルトイベントでは未設定）
+	EndsAt *time.Time `json:"ends_at,omitempty"`
+
+	// Id イベントID
+	Id int64 `json:"id"`
+
+	// Name イベント名
+	Name string `json:"name"`
+
+	// StartsAt 開催開始日時。これより前はイベントのスタンプを取得できない（デフォルトイベントでは未設定）
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// EventCreateRequest defines model for EventCreateRequest.
+type EventCreateRequest struct {
+	// EndsAt 開催終了日時（開始日時より後）
+	EndsAt time.Time `json:"ends_at"`
+
+	// Name イベント名
+	Name string `json:"name"`
+
+	// StartsAt 開催開始日時
+	StartsAt time.Time `json:"starts_at"`
+}
+
+// EventStats defines model for EventStats.
+type EventStats struct {
+	// Completed イベントの全スタンプを取得した参加者数
+	Completed int64 `json:"completed"`
+
+	// CompletionRate コンプリート率（completed / participants、参加者がいない場合は0）
+	CompletionRate float64 `json:"completion_rate"`
+
+	// EventId イベントID
+	EventId int64 `json:"event_id"`
+
+	// HourlyAcquisitions 1時間ごとのスタンプ取得数（時刻順、取得がなかった時間帯は省略）
+	HourlyAcquisitions []HourlyAcquisitions `json:"hourly_acquisitions"`
+
+	// MedianCompletionSeconds 登録から最後のスタンプ取得までにかかった時間（秒）の、コンプリートした参加者での中央値。コンプリートした参加者がいない場合は省略
+	MedianCompletionSeconds *int64 `json:"median_completion_seconds,omitempty"`
+
+	// Participants 登録した参加者数
+	Participants int64 `json:"participants"`
+
+	// Stamps スタンプごとの取得数（スタンプID順）
+	Stamps []StampStats `json:"stamps"`
+}
+
+// FeedLeaderboardChange defines model for FeedLeaderboardChange.
+type FeedLeaderboardChange struct {
+	// LastAcquiredAt 最後にスタンプを取得した日時
+	LastAcquiredAt time.Time `json:"last_acquired_at"`
+
+	// Name ユーザー名
+	Name string `json:"name"`
+
+	// StampCount 取得済みスタンプ数
+	StampCount int64 `json:"stamp_count"`
+
+	// UserId ユーザーID
+	UserId int64 `json:"user_id"`
+}
+
+// FeedStampAcquired defines model for FeedStampAcquired.
+type FeedStampAcquired struct {
+	// AcquiredAt スタンプ取得日時
+	AcquiredAt time.Time `json:"acquired_at"`
+
+	// IconThumbnail 一覧表示用のアイコン縮小画像URL
+	IconThumbnail *string `json:"icon_thumbnail,omitempty"`
+
+	// Name ユーザー名
+	Name string `json:"name"`
+
+	// StampId スタンプID
+	StampId int64 `json:"stamp_id"`
+
+	// StampName スタンプ名
+	StampName string `json:"stamp_name"`
+
+	// UserId ユーザーID
+	UserId int64 `json:"user_id"`
+}
+
+// FeedUserRegistered defines model for FeedUserRegistered.
+type FeedUserRegistered struct {
+	// IconThumbnail 一覧表示用のアイコン縮小画像URL
+	IconThumbnail *string `json:"icon_thumbnail,omitempty"`
+
+	// Name ユーザー名
+	Name string `json:"name"`
+
+	// RegisteredAt 登録日時
+	RegisteredAt time.Time `json:"registered_at"`
+
+	// UserId ユーザーID
+	UserId int64 `json:"user_id"`
+}
+
+// GoFeature defines model for GoFeature.
+type GoFeature struct {
+	// Code 選択肢のコード。ユーザーのgo_featuresで使用する
+	Code string `json:"code"`
+
+	// Label 表示名
+	Label string `json:"label"`
+}
+
+// GoFeatureCount defines model for GoFeatureCount.
+type GoFeatureCount struct {
+	// Code 選択肢のコード
+	Code string `json:"code"`
+
+	// Label 表示名
+	Label string `json:"label"`
+
+	// UserCount この特徴を選んだ参加者数
+	UserCount int64 `json:"user_count"`
+}
+
+// GoFeatureCounts defines model for GoFeatureCounts.
+type GoFeatureCounts struct {
+	// GoFeatures 選んだ参加者の多い順（同数の場合は表示順）の全選択肢
+	GoFeatures []GoFeatureCount `json:"go_features"`
+
+	// Participants イベントの参加者の総数
+	Participants int64 `json:"participants"`
+}
+
+// HourlyAcquisitions defines model for HourlyAcquisitions.
+type HourlyAcquisitions struct {
+	// Acquisitions この1時間に取得されたスタンプ数
+	Acquisitions int64 `json:"acquisitions"`
+
+	// Hour 集計した1時間の開始時刻
+	Hour time.Time `json:"hour"`
+}
+
+// Leaderboard defines model for Leaderboard.
+type Leaderboard struct {
+	Entries []LeaderboardEntry `json:"entries"`
+
+	// Total イベントの参加者の総数
+	Total int64 `json:"total"`
+}
+
+// LeaderboardEntry defines model for LeaderboardEntry.
+type LeaderboardEntry struct {
+	// LastAcquiredAt 最後にスタンプを取得した日時（未取得の場合は省略）
+	LastAcquiredAt *time.Time `json:"last_acquired_at,omitempty"`
+
+	// Name ユーザー名
+	Name string `json:"name"`
+
+	// Rank 順位（1始まり）
+	Rank int `json:"rank"`
+
+	// StampCount 取得済みスタンプ数
+	StampCount int64 `json:"stamp_count"`
+
+	// UserId ユーザーID
+	UserId int64 `json:"user_id"`
+}
+
+// RewardGrantResult defines model for RewardGrantResult.
+type RewardGrantResult struct {
+	// Granted 新たに付与した景品の数
+	Granted int `json:"granted"`
+}
+
+// RewardKind 景品の獲得条件
+// - all: イベントの全スタンプ
+// - count: 任意のrequired_count個のスタンプ
+// - stamps: stamp_idsの全スタンプ
+type RewardKind string
+
+// RewardRule defines model for RewardRule.
+type RewardRule struct {
+	// CreatedAt 作成日時
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// EventId 景品が属するイベントのID
+	EventId int64 `json:"event_id"`
+
+	// Id 景品ID
+	Id int64 `json:"id"`
+
+	// Kind 景品の獲得条件
+	// - all: イベントの全スタンプ
+	// - count: 任意のrequired_count個のスタンプ
+	// - stamps: stamp_idsの全スタンプ
+	Kind RewardKind `json:"kind"`
+
+	// Name 景品名
+	Name string `json:"name"`
+
+	// RequiredCount 必要なスタンプ数（kindがcountの場合）
+	RequiredCount *int `json:"required_count,omitempty"`
+
+	// StampIds 必要なスタンプのID（kindがstampsの場合）
+	StampIds *[]int64 `json:"stamp_ids,omitempty"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// RewardRuleCreateRequest defines model for RewardRuleCreateRequest.
+type RewardRuleCreateRequest struct {
+	// Kind 景品の獲得条件
+	// - all: イベントの全スタンプ
+	// - count: 任意のrequired_count個のスタンプ
+	// - stamps: stamp_idsの全スタンプ
+	Kind RewardKind `json:"kind"`
+
+	// Name 景品名
+	Name string `json:"name"`
+
+	// RequiredCount 必要なスタンプ数（kindがcountの場合は必須、1以上）
+	RequiredCount *int `json:"required_count,omitempty"`
+
+	// StampIds 必要なスタンプのID（kindがstampsの場合は必須。同じイベントのスタンプに限る）
+	StampIds *[]int64 `json:"stamp_ids,omitempty"`
+}
+
+// SortOrder 昇順（asc）または降順（desc）
+type SortOrder string
+
 // Stamp defines model for Stamp.
 type Stamp struct {
+	// AvailableFrom この日時以降に取得可能（省略時は制限なし）
+	AvailableFrom *time.Time `json:"available_from,omitempty"`
+
+	// AvailableUntil この日時より前まで取得可能（省略時は制限なし）
+	AvailableUntil *time.Time `json:"available_until,omitempty"`
+
 	// CreatedAt 作成日時
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 
+	// EventId スタンプが属するイベントのID
+	EventId int64 `json:"event_id"`
+
 	// Id スタンプID
 	Id int64 `json:"id"`
 
@@ -45,14 +329,62 @@ type Stamp struct {
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
+// StampCode defines model for StampCode.
+type StampCode struct {
+	// Code 現在のローテーションコード
+	Code string `json:"code"`
+
+	// ExpiresAt 次にコードが切り替わる日時
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// StampId スタンプID
+	StampId int64 `json:"stamp_id"`
+}
+
 // StampCreateRequest defines model for StampCreateRequest.
 type StampCreateRequest struct {
+	// AvailableFrom この日時以降に取得可能（省略時は制限なし）
+	AvailableFrom *time.Time `json:"available_from,omitempty"`
+
+	// AvailableUntil この日時より前まで取得可能（省略時は制限なし）
+	AvailableUntil *time.Time `json:"available_until,omitempty"`
+
+	// Name スタンプ名
+	Name string `json:"name"`
+}
+
+// StampStats defines model for StampStats.
+type StampStats struct {
+	// Acquisitions このスタンプを取得した参加者数
+	Acquisitions int64 `json:"acquisitions"`
+
 	// Name スタンプ名
 	Name string `json:"name"`
+
+	// StampId スタンプID
+	StampId int64 `json:"stamp_id"`
+}
+
+// StampToken defines model for StampToken.
+type StampToken struct {
+	// ExpiresAt トークンの有効期限
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// StampId スタンプID
+	StampId int64 `json:"stamp_id"`
+
+	// Token スタンプ取得トークン
+	Token string `json:"token"`
 }
 
-// StampUpdateRequest スタンプ更新リクエスト。
+// StampUpdateRequest スタンプ更新リクエスト。取得可能期間は指定した値で置き換えられる（省略すると制限なし）。
 type StampUpdateRequest struct {
+	// AvailableFrom この日時以降に取得可能（省略時は制限なし）
+	AvailableFrom *time.Time `json:"available_from,omitempty"`
+
+	// AvailableUntil この日時より前まで取得可能（省略時は制限なし）
+	AvailableUntil *time.Time `json:"available_until,omitempty"`
+
 	// Name スタンプ名
 	Name *string `json:"name,omitempty"`
 }
@@ -62,12 +394,21 @@ type User struct {
 	// CreatedAt 作成日時
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 
-	// FavoriteGoFeature 好きなGoの特徴
+	// EventId 参加しているイベントのID
+	EventId int64 `json:"event_id"`
+
+	// FavoriteGoFeature 好きなGoの特徴（自由記述。選択肢から選んだ場合はそのコードのカンマ区切り）
 	FavoriteGoFeature *string `json:"favorite_go_feature,omitempty"`
 
+	// GoFeatures 好きなGoの特徴として選んだ選択肢のコード（表示順）
+	GoFeatures *[]string `json:"go_features,omitempty"`
+
 	// Icon アイコン画像URL
 	Icon *string `json:"icon,omitempty"`
 
+	// IconThumbnail 一覧表示用のアイコン縮小画像URL
+	IconThumbnail *string `json:"icon_thumbnail,omitempty"`
+
 	// Id ユーザーID
 	Id int64 `json:"id"`
 
@@ -81,19 +422,65 @@ type User struct {
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
+// UserAccessToken defines model for UserAccessToken.
+type UserAccessToken struct {
+	// AccessToken ユーザー本人であることを示すBearerトークン。ユーザー更新・スタンプ取得時にAuthorizationヘッダーで送信する
+	AccessToken string `json:"access_token"`
+}
+
 // UserCreateRequest defines model for UserCreateRequest.
 type UserCreateRequest struct {
-	// FavoriteGoFeature 好きなGoの特徴
+	// FavoriteGoFeature 好きなGoの特徴。500文字まで（改行などの制御文字は不可）。go_featuresを省略した場合は、含まれる選択肢のコード（カンマ区切り）を選択したものとして扱う
+	FavoriteGoFeature *string `json:"favorite_go_feature,omitempty"`
+
+	// GoFeatures 好きなGoの特徴として選ぶ選択肢のコード（GET /go-features）。選択肢にないコードは不正なリクエストとなる。favorite_go_featureを省略した場合は、コードのカンマ区切りがfavorite_go_featureにも設定される
+	GoFeatures *[]string `json:"go_features,omitempty"`
+
+	// Icon アイコン画像（PNG・JPEG・WebP・GIF、5MBまで）のdata URLまたはbase64。
+	// 画像は検証後に正方形のアイコンとサムネイルに変換して保存され、ユーザーにはそのURLが設定される
+	Icon *string `json:"icon,omitempty"`
+
+	// Name ユーザー名。前後の空白を除いて1〜100文字
+	Name string `json:"name"`
+
+	// TwitterId TwitterID。英数字とアンダースコアの15文字まで。先頭の@は取り除いて保存する
+	TwitterId *string `json:"twitter_id,omitempty"`
+}
+
+// UserCreateResponse defines model for UserCreateResponse.
+type UserCreateResponse struct {
+	// AccessToken ユーザー本人であることを示すBearerトークン。ユーザー更新・スタンプ取得時にAuthorizationヘッダーで送信する
+	AccessToken string `json:"access_token"`
+
+	// CreatedAt 作成日時
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// EventId 参加しているイベントのID
+	EventId int64 `json:"event_id"`
+
+	// FavoriteGoFeature 好きなGoの特徴（自由記述。選択肢から選んだ場合はそのコードのカンマ区切り）
 	FavoriteGoFeature *string `json:"favorite_go_feature,omitempty"`
 
+	// GoFeatures 好きなGoの特徴として選んだ選択肢のコード（表示順）
+	GoFeatures *[]string `json:"go_features,omitempty"`
+
 	// Icon アイコン画像URL
 	Icon *string `json:"icon,omitempty"`
 
+	// IconThumbnail 一覧表示用のアイコン縮小画像URL
+	IconThumbnail *string `json:"icon_thumbnail,omitempty"`
+
+	// Id ユーザーID
+	Id int64 `json:"id"`
+
 	// Name ユーザー名
 	Name string `json:"name"`
 
 	// TwitterId TwitterID
 	TwitterId *string `json:"twitter_id,omitempty"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
 // UserDetail defines model for UserDetail.
@@ -104,12 +491,21 @@ type UserDetail struct {
 	// CreatedAt 作成日時
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 
-	// FavoriteGoFeature 好きなGoの特徴
+	// EventId 参加しているイベントのID
+	EventId int64 `json:"event_id"`
+
+	// FavoriteGoFeature 好きなGoの特徴（自由記述。選択肢から選んだ場合はそのコードのカンマ区切り）
 	FavoriteGoFeature *string `json:"favorite_go_feature,omitempty"`
 
+	// GoFeatures 好きなGoの特徴として選んだ選択肢のコード（表示順）
+	GoFeatures *[]string `json:"go_features,omitempty"`
+
 	// Icon アイコン画像URL
 	Icon *string `json:"icon,omitempty"`
 
+	// IconThumbnail 一覧表示用のアイコン縮小画像URL
+	IconThumbnail *string `json:"icon_thumbnail,omitempty"`
+
 	// Id ユーザーID
 	Id int64 `json:"id"`
 
@@ -123,6 +519,30 @@ type UserDetail struct {
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
+// UserReward defines model for UserReward.
+type UserReward struct {
+	// EarnedAt 景品の条件を満たした日時
+	EarnedAt time.Time `json:"earned_at"`
+
+	// Name 景品名
+	Name string `json:"name"`
+
+	// RedeemedAt 景品を受け渡した日時（未受け取りの場合は省略）
+	RedeemedAt *time.Time `json:"redeemed_at,omitempty"`
+
+	// RewardId 景品ID
+	RewardId int64 `json:"reward_id"`
+
+	// UserId ユーザーID
+	UserId int64 `json:"user_id"`
+}
+
+// UserSort ユーザー一覧の並び順のキー
+// - created_at: 登録日時
+// - name: 名前
+// - stamp_count: 取得スタンプ数
+type UserSort string
+
 // UserStamp defines model for UserStamp.
 type UserStamp struct {
 	// AcquiredAt スタンプ取得日時
@@ -137,21 +557,148 @@ type UserStamp struct {
 
 // UserUpdateRequest defines model for UserUpdateRequest.
 type UserUpdateRequest struct {
-	// FavoriteGoFeature 好きなGoの特徴
+	// FavoriteGoFeature 好きなGoの特徴。500文字まで（改行などの制御文字は不可）。空文字を送ると削除する。go_featuresを省略した場合は、含まれる選択肢のコード（カンマ区切り）を選択したものとして扱う
 	FavoriteGoFeature *string `json:"favorite_go_feature,omitempty"`
 
-	// Icon アイコン画像URL
+	// GoFeatures 好きなGoの特徴として選ぶ選択肢のコード（GET /go-features）。選択肢にないコードは不正なリクエストとなる。空配列を送ると選択を解除する。favorite_go_featureを省略した場合は、コードのカンマ区切りがfavorite_go_featureにも設定される
+	GoFeatures *[]string `json:"go_features,omitempty"`
+
+	// Icon アイコン画像（PNG・JPEG・WebP・GIF、5MBまで）のdata URLまたはbase64。
+	// 現在のiconのURLを送った場合は変更せず、空文字を送るとアイコンを削除する
 	Icon *string `json:"icon,omitempty"`
 
-	// Name ユーザー名
+	// Name ユーザー名。前後の空白を除いて1〜100文字
 	Name *string `json:"name,omitempty"`
 
-	// TwitterId TwitterID
+	// TwitterId TwitterID。英数字とアンダースコアの15文字まで。先頭の@は取り除いて保存する。空文字を送ると削除する
 	TwitterId *string `json:"twitter_id,omitempty"`
 }
 
-// ListStampsParams defines parameters for ListStamps.
-type ListStampsParams struct {
+// Webhook defines model for Webhook.
+type Webhook struct {
+	// CreatedAt 登録日時
+	CreatedAt time.Time `json:"created_at"`
+
+	// EventId 通知するイベントのID
+	EventId int64 `json:"event_id"`
+
+	// EventTypes 通知する出来事の種別
+	EventTypes []WebhookEventType `json:"event_types"`
+
+	// Id WebhookのID
+	Id int64 `json:"id"`
+
+	// Secret 署名用のシークレット（登録時の応答にのみ含まれる）
+	Secret *string `json:"secret,omitempty"`
+
+	// Url 通知先のURL
+	Url string `json:"url"`
+}
+
+// WebhookCreateRequest defines model for WebhookCreateRequest.
+type WebhookCreateRequest struct {
+	// EventTypes 通知する出来事の種別（1つ以上）
+	EventTypes []WebhookEventType `json:"event_types"`
+
+	// Secret 署名用のシークレット（16〜128文字。省略時は生成される）
+	Secret *string `json:"secret,omitempty"`
+
+	// Url 通知先のURL（httpまたはhttps）
+	Url string `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempt 何回目の送信か（1から始まる）
+	Attempt int `json:"attempt"`
+
+	// AttemptedAt 送信日時
+	AttemptedAt time.Time `json:"attempted_at"`
+
+	// Error 失敗の理由（成功した場合は省略）
+	Error *string `json:"error,omitempty"`
+
+	// EventType Webhookで通知する出来事の種別
+	// - user.registered: 参加者の登録
+	// - stamp.acquired: スタンプの取得
+	// - rally.completed: イベントの全スタンプの取得（stamp.acquiredに続いて送られる）
+	EventType WebhookEventType `json:"event_type"`
+
+	// Id 配信履歴のID
+	Id int64 `json:"id"`
+
+	// Payload 送信した本文（WebhookPayloadのJSON）
+	Payload string `json:"payload"`
+
+	// StatusCode 応答のHTTPステータスコード（応答がなかった場合は省略）
+	StatusCode *int `json:"status_code,omitempty"`
+
+	// Succeeded 2xxの応答を受け取ったか
+	Succeeded bool `json:"succeeded"`
+
+	// WebhookId WebhookのID
+	WebhookId int64 `json:"webhook_id"`
+}
+
+// WebhookEventType Webhookで通知する出来事の種別
+// - user.registered: 参加者の登録
+// - stamp.acquired: スタンプの取得
+// - rally.completed: イベントの全スタンプの取得（stamp.acquiredに続いて送られる）
+type WebhookEventType string
+
+// WebhookPayload Webhookに送られる本文。再送や複数のWebhookでもidは同じになる
+type WebhookPayload struct {
+	// EventId イベントID
+	EventId int64 `json:"event_id"`
+
+	// Id 通知のID（重複した通知の除外に使う）
+	Id string `json:"id"`
+
+	// OccurredAt 出来事の日時
+	OccurredAt time.Time `json:"occurred_at"`
+
+	// StampCount 取得済みスタンプ数（stamp.acquired, rally.completed）
+	StampCount *int64 `json:"stamp_count,omitempty"`
+
+	// StampId 取得したスタンプのID（stamp.acquired, rally.completed）
+	StampId *int64 `json:"stamp_id,omitempty"`
+
+	// StampName 取得したスタンプ名（stamp.acquired, rally.completed）
+	StampName *string `json:"stamp_name,omitempty"`
+
+	// Type Webhookで通知する出来事の種別
+	// - user.registered: 参加者の登録
+	// - stamp.acquired: スタンプの取得
+	// - rally.completed: イベントの全スタンプの取得（stamp.acquiredに続いて送られる）
+	Type WebhookEventType `json:"type"`
+
+	// UserId ユーザーID
+	UserId int64 `json:"user_id"`
+
+	// UserName ユーザー名
+	UserName string `json:"user_name"`
+}
+
+// EventId defines model for EventId.
+type EventId = int64
+
+// WebhookId defines model for WebhookId.
+type WebhookId = int64
+
+// GetAdminStatsParams defines parameters for GetAdminStats.
+type GetAdminStatsParams struct {
+	// EventId 集計するイベントのID（省略時はデフォルトイベント）
+	EventId *int64 `form:"event_id,omitempty" json:"event_id,omitempty"`
+}
+
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	// EventId 配信するイベントのID（省略時はデフォルトイベント）
+	EventId *int64 `form:"event_id,omitempty" json:"event_id,omitempty"`
+}
+
+// GetEventLeaderboardParams defines parameters for GetEventLeaderboard.
+type GetEventLeaderboardParams struct {
 	// Limit 取得する件数の上限
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
@@ -159,23 +706,179 @@ type ListStampsParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
-// CreateStampJSONRequestBody defines body for CreateStamp for application/json ContentType.
-type CreateStampJSONRequestBody = StampCreateRequest
-
-// UpdateStampJSONRequestBody defines body for UpdateStamp for application/json ContentType.
-type UpdateStampJSONRequestBody = StampUpdateRequest
+// ListEventStampsParams defines parameters for ListEventStamps.
+type ListEventStampsParams struct {
+	// Limit 取得する件数の上限
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
-type CreateUserJSONRequestBody = UserCreateRequest
+	// Offset スキップする件数
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
 
-// UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
-type UpdateUserJSONRequestBody = UserUpdateRequest
+// ListEventUsersParams defines parameters for ListEventUsers.
+type ListEventUsersParams struct {
+	// Limit 取得する件数の上限
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset スキップする件数
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Sort 並び順のキー。同じ値の場合はユーザーID順
+	Sort *UserSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order 昇順・降順。省略時はstamp_countのみ降順、それ以外は昇順
+	Order *SortOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Q 名前またはTwitter IDの部分一致で絞り込む（先頭の@は無視する）
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// IncludeStampCounts trueの場合、各ユーザーの取得済みスタンプIDをstamp_idsに含める
+	IncludeStampCounts *bool `form:"include_stamp_counts,omitempty" json:"include_stamp_counts,omitempty"`
+}
+
+// GetLeaderboardParams defines parameters for GetLeaderboard.
+type GetLeaderboardParams struct {
+	// Limit 取得する件数の上限
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset スキップする件数
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListStampsParams defines parameters for ListStamps.
+type ListStampsParams struct {
+	// Limit 取得する件数の上限
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset スキップする件数
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListUsersParams defines parameters for ListUsers.
+type ListUsersParams struct {
+	// Limit 取得する件数の上限
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset スキップする件数
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Sort 並び順のキー。同じ値の場合はユーザーID順
+	Sort *UserSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order 昇順・降順。省略時はstamp_countのみ降順、それ以外は昇順
+	Order *SortOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Q 名前またはTwitter IDの部分一致で絞り込む（先頭の@は無視する）
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// IncludeStampCounts trueの場合、各ユーザーの取得済みスタンプIDをstamp_idsに含める
+	IncludeStampCounts *bool `form:"include_stamp_counts,omitempty" json:"include_stamp_counts,omitempty"`
+}
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	// Limit 取得する件数の上限
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// UploadUserIconMultipartBody defines parameters for UploadUserIcon.
+type UploadUserIconMultipartBody struct {
+	// Icon アイコン画像
+	Icon openapi_types.File `json:"icon"`
+}
+
+// CreateEventJSONRequestBody defines body for CreateEvent for application/json ContentType.
+type CreateEventJSONRequestBody = EventCreateRequest
+
+// CreateEventRewardJSONRequestBody defines body for CreateEventReward for application/json ContentType.
+type CreateEventRewardJSONRequestBody = RewardRuleCreateRequest
+
+// CreateEventStampJSONRequestBody defines body for CreateEventStamp for application/json ContentType.
+type CreateEventStampJSONRequestBody = StampCreateRequest
+
+// CreateEventUserJSONRequestBody defines body for CreateEventUser for application/json ContentType.
+type CreateEventUserJSONRequestBody = UserCreateRequest
+
+// CreateEventWebhookJSONRequestBody defines body for CreateEventWebhook for application/json ContentType.
+type CreateEventWebhookJSONRequestBody = WebhookCreateRequest
+
+// CreateStampJSONRequestBody defines body for CreateStamp for application/json ContentType.
+type CreateStampJSONRequestBody = StampCreateRequest
+
+// UpdateStampJSONRequestBody defines body for UpdateStamp for application/json ContentType.
+type UpdateStampJSONRequestBody = StampUpdateRequest
+
+// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
+type CreateUserJSONRequestBody = UserCreateRequest
+
+// UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
+type UpdateUserJSONRequestBody = UserUpdateRequest
+
+// UploadUserIconMultipartRequestBody defines body for UploadUserIcon for multipart/form-data ContentType.
+type UploadUserIconMultipartRequestBody UploadUserIconMultipartBody
 
 // AcquireStampJSONRequestBody defines body for AcquireStamp for application/json ContentType.
 type AcquireStampJSONRequestBody = AcquireStampRequest
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// イベントの統計取得
+	// (GET /admin/stats)
+	GetAdminStats(c *gin.Context, params GetAdminStatsParams)
+	// イベント一覧取得
+	// (GET /events)
+	ListEvents(c *gin.Context)
+	// イベント作成
+	// (POST /events)
+	CreateEvent(c *gin.Context)
+	// スタンプラリーのリアルタイム配信
+	// (GET /events/stream)
+	StreamEvents(c *gin.Context, params StreamEventsParams)
+	// イベント詳細取得
+	// (GET /events/{event_id})
+	GetEvent(c *gin.Context, eventId EventId)
+	// イベントの好きなGoの特徴の集計取得
+	// (GET /events/{event_id}/go-features/counts)
+	GetEventGoFeatureCounts(c *gin.Context, eventId EventId)
+	// イベントのスタンプ取得ランキング取得
+	// (GET /events/{event_id}/leaderboard)
+	GetEventLeaderboard(c *gin.Context, eventId EventId, params GetEventLeaderboardParams)
+	// イベントの景品一覧取得
+	// (GET /events/{event_id}/rewards)
+	ListEventRewards(c *gin.Context, eventId EventId)
+	// イベントの景品作成
+	// (POST /events/{event_id}/rewards)
+	CreateEventReward(c *gin.Context, eventId EventId)
+	// イベントの景品の一括付与
+	// (POST /events/{event_id}/rewards/grant)
+	GrantEventRewards(c *gin.Context, eventId EventId)
+	// イベントのスタンプ一覧取得
+	// (GET /events/{event_id}/stamps)
+	ListEventStamps(c *gin.Context, eventId EventId, params ListEventStampsParams)
+	// イベントのスタンプ作成
+	// (POST /events/{event_id}/stamps)
+	CreateEventStamp(c *gin.Context, eventId EventId)
+	// イベントのユーザー一覧取得
+	// (GET /events/{event_id}/users)
+	ListEventUsers(c *gin.Context, eventId EventId, params ListEventUsersParams)
+	// イベントのユーザー作成
+	// (POST /events/{event_id}/users)
+	CreateEventUser(c *gin.Context, eventId EventId)
+	// イベントのWebhook一覧取得
+	// (GET /events/{event_id}/webhooks)
+	ListEventWebhooks(c *gin.Context, eventId EventId)
+	// イベントのWebhook登録
+	// (POST /events/{event_id}/webhooks)
+	CreateEventWebhook(c *gin.Context, eventId EventId)
+	// 好きなGoの特徴の選択肢一覧取得
+	// (GET /go-features)
+	ListGoFeatures(c *gin.Context)
+	// 好きなGoの特徴の集計取得
+	// (GET /go-features/counts)
+	GetGoFeatureCounts(c *gin.Context)
+	// スタンプ取得ランキング取得
+	// (GET /leaderboard)
+	GetLeaderboard(c *gin.Context, params GetLeaderboardParams)
 	// スタンプ一覧取得
 	// (GET /stamps)
 	ListStamps(c *gin.Context, params ListStampsParams)
@@ -191,35 +894,591 @@ type ServerInterface interface {
 	// スタンプ更新
 	// (PUT /stamps/{id})
 	UpdateStamp(c *gin.Context, id int64)
+	// スタンプのローテーションコード取得
+	// (GET /stamps/{id}/code)
+	GetStampCode(c *gin.Context, id int64)
+	// スタンプ取得トークン発行
+	// (GET /stamps/{id}/token)
+	IssueStampToken(c *gin.Context, id int64)
 	// ユーザー一覧取得
 	// (GET /users)
-	ListUsers(c *gin.Context)
+	ListUsers(c *gin.Context, params ListUsersParams)
 	// ユーザー作成
 	// (POST /users)
 	CreateUser(c *gin.Context)
+	// ユーザー削除
+	// (DELETE /users/{id})
+	DeleteUser(c *gin.Context, id int64)
 	// ユーザー詳細取得
 	// (GET /users/{id})
 	GetUser(c *gin.Context, id int64)
 	// ユーザー更新
 	// (PUT /users/{id})
 	UpdateUser(c *gin.Context, id int64)
+	// ユーザーアイコンのアップロード
+	// (PUT /users/{id}/icon)
+	UploadUserIcon(c *gin.Context, id int64)
+	// ユーザーの獲得済み景品一覧取得
+	// (GET /users/{id}/rewards)
+	ListUserRewards(c *gin.Context, id int64)
+	// 景品の受け渡し
+	// (POST /users/{id}/rewards/{reward_id}/redeem)
+	RedeemUserReward(c *gin.Context, id int64, rewardId int64)
 	// ユーザーの取得済みスタンプ一覧取得
 	// (GET /users/{id}/stamps)
 	ListUserStamps(c *gin.Context, id int64)
 	// ユーザーがスタンプを取得
 	// (POST /users/{id}/stamps)
 	AcquireStamp(c *gin.Context, id int64)
+	// ユーザーのアクセストークン再発行
+	// (POST /users/{id}/token)
+	IssueUserToken(c *gin.Context, id int64)
+	// Webhook削除
+	// (DELETE /webhooks/{webhook_id})
+	DeleteWebhook(c *gin.Context, webhookId WebhookId)
+	// Webhookの配信履歴取得
+	// (GET /webhooks/{webhook_id}/deliveries)
+	ListWebhookDeliveries(c *gin.Context, webhookId WebhookId, params ListWebhookDeliveriesParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// GetAdminStats operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminStats(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminStatsParams
+
+	// ------------- Optional query parameter "event_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "event_id", c.Request.URL.Query(), &params.EventId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminStats(c, params)
+}
+
+// ListEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListEvents(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListEvents(c)
+}
+
+// CreateEvent operation middleware
+func (siw *ServerInterfaceWrapper) CreateEvent(c *gin.Context) {
+
+	c.Set(AdminApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateEvent(c)
+}
+
+// StreamEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamEvents(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamEventsParams
+
+	// ------------- Optional query parameter "event_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "event_id", c.Request.URL.Query(), &params.EventId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StreamEvents(c, params)
+}
+
+// GetEvent operation middleware
+func (siw *ServerInterfaceWrapper) GetEvent(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "event_id" -------------
+	var eventId EventId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "event_id", c.Param("event_id"), &eventId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEvent(c, eventId)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+// GetEventGoFeatureCounts operation middleware
+func (siw *ServerInterfaceWrapper) GetEventGoFeatureCounts(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "event_id" -------------
+	var eventId EventId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "event_id", c.Param("event_id"), &eventId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEventGoFeatureCounts(c, eventId)
+}
+
+// GetEventLeaderboard operation middleware
+func (siw *ServerInterfaceWrapper) GetEventLeaderboard(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "event_id" -------------
+	var eventId EventId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "event_id", c.Param("event_id"), &eventId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventLeaderboardParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEventLeaderboard(c, eventId, params)
+}
+
+// ListEventRewards operation middleware
+func (siw *ServerInterfaceWrapper) ListEventRewards(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "event_id" -------------
+	var eventId EventId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "event_id", c.Param("event_id"), &eventId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListEventRewards(c, eventId)
+}
+
+// CreateEventReward operation middleware
+func (siw *ServerInterfaceWrapper) CreateEventReward(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "event_id" -------------
+	var eventId EventId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "event_id", c.Param("event_id"), &eventId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateEventReward(c, eventId)
+}
+
+// GrantEventRewards operation middleware
+func (siw *ServerInterfaceWrapper) GrantEventRewards(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "event_id" -------------
+	var eventId EventId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "event_id", c.Param("event_id"), &eventId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GrantEventRewards(c, eventId)
+}
+
+// ListEventStamps operation middleware
+func (siw *ServerInterfaceWrapper) ListEventStamps(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "event_id" -------------
+	var eventId EventId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "event_id", c.Param("event_id"), &eventId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEventStampsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListEventStamps(c, eventId, params)
+}
+
+// CreateEventStamp operation middleware
+func (siw *ServerInterfaceWrapper) CreateEventStamp(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "event_id" -------------
+	var eventId EventId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "event_id", c.Param("event_id"), &eventId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateEventStamp(c, eventId)
+}
+
+// ListEventUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListEventUsers(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "event_id" -------------
+	var eventId EventId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "event_id", c.Param("event_id"), &eventId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEventUsersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_stamp_counts" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_stamp_counts", c.Request.URL.Query(), &params.IncludeStampCounts)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_stamp_counts: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListEventUsers(c, eventId, params)
+}
+
+// CreateEventUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateEventUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "event_id" -------------
+	var eventId EventId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "event_id", c.Param("event_id"), &eventId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateEventUser(c, eventId)
+}
+
+// ListEventWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListEventWebhooks(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "event_id" -------------
+	var eventId EventId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "event_id", c.Param("event_id"), &eventId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListEventWebhooks(c, eventId)
+}
+
+// CreateEventWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateEventWebhook(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "event_id" -------------
+	var eventId EventId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "event_id", c.Param("event_id"), &eventId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateEventWebhook(c, eventId)
+}
+
+// ListGoFeatures operation middleware
+func (siw *ServerInterfaceWrapper) ListGoFeatures(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListGoFeatures(c)
+}
+
+// GetGoFeatureCounts operation middleware
+func (siw *ServerInterfaceWrapper) GetGoFeatureCounts(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetGoFeatureCounts(c)
+}
+
+// GetLeaderboard operation middleware
+func (siw *ServerInterfaceWrapper) GetLeaderboard(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLeaderboardParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLeaderboard(c, params)
 }
 
-type MiddlewareFunc func(c *gin.Context)
-
 // ListStamps operation middleware
 func (siw *ServerInterfaceWrapper) ListStamps(c *gin.Context) {
 
@@ -257,6 +1516,8 @@ func (siw *ServerInterfaceWrapper) ListStamps(c *gin.Context) {
 // CreateStamp operation middleware
 func (siw *ServerInterfaceWrapper) CreateStamp(c *gin.Context) {
 
+	c.Set(AdminApiKeyScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -281,6 +1542,8 @@ func (siw *ServerInterfaceWrapper) DeleteStamp(c *gin.Context) {
 		return
 	}
 
+	c.Set(AdminApiKeyScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -329,6 +1592,8 @@ func (siw *ServerInterfaceWrapper) UpdateStamp(c *gin.Context) {
 		return
 	}
 
+	c.Set(AdminApiKeyScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -339,9 +1604,114 @@ func (siw *ServerInterfaceWrapper) UpdateStamp(c *gin.Context) {
 	siw.Handler.UpdateStamp(c, id)
 }
 
+// GetStampCode operation middleware
+func (siw *ServerInterfaceWrapper) GetStampCode(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStampCode(c, id)
+}
+
+// IssueStampToken operation middleware
+func (siw *ServerInterfaceWrapper) IssueStampToken(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.IssueStampToken(c, id)
+}
+
 // ListUsers operation middleware
 func (siw *ServerInterfaceWrapper) ListUsers(c *gin.Context) {
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUsersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_stamp_counts" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_stamp_counts", c.Request.URL.Query(), &params.IncludeStampCounts)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_stamp_counts: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -349,7 +1719,7 @@ func (siw *ServerInterfaceWrapper) ListUsers(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListUsers(c)
+	siw.Handler.ListUsers(c, params)
 }
 
 // CreateUser operation middleware
@@ -365,6 +1735,32 @@ func (siw *ServerInterfaceWrapper) CreateUser(c *gin.Context) {
 	siw.Handler.CreateUser(c)
 }
 
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteUser(c, id)
+}
+
 // GetUser operation middleware
 func (siw *ServerInterfaceWrapper) GetUser(c *gin.Context) {
 
@@ -403,6 +1799,8 @@ func (siw *ServerInterfaceWrapper) UpdateUser(c *gin.Context) {
 		return
 	}
 
+	c.Set(BearerAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -413,6 +1811,91 @@ func (siw *ServerInterfaceWrapper) UpdateUser(c *gin.Context) {
 	siw.Handler.UpdateUser(c, id)
 }
 
+// UploadUserIcon operation middleware
+func (siw *ServerInterfaceWrapper) UploadUserIcon(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UploadUserIcon(c, id)
+}
+
+// ListUserRewards operation middleware
+func (siw *ServerInterfaceWrapper) ListUserRewards(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListUserRewards(c, id)
+}
+
+// RedeemUserReward operation middleware
+func (siw *ServerInterfaceWrapper) RedeemUserReward(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "reward_id" -------------
+	var rewardId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reward_id", c.Param("reward_id"), &rewardId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reward_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RedeemUserReward(c, id, rewardId)
+}
+
 // ListUserStamps operation middleware
 func (siw *ServerInterfaceWrapper) ListUserStamps(c *gin.Context) {
 
@@ -451,6 +1934,8 @@ func (siw *ServerInterfaceWrapper) AcquireStamp(c *gin.Context) {
 		return
 	}
 
+	c.Set(BearerAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -461,6 +1946,95 @@ func (siw *ServerInterfaceWrapper) AcquireStamp(c *gin.Context) {
 	siw.Handler.AcquireStamp(c, id)
 }
 
+// IssueUserToken operation middleware
+func (siw *ServerInterfaceWrapper) IssueUserToken(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.IssueUserToken(c, id)
+}
+
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhook_id" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_id", c.Param("webhook_id"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhook_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteWebhook(c, webhookId)
+}
+
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhook_id" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_id", c.Param("webhook_id"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhook_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListWebhookDeliveries(c, webhookId, params)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -488,15 +2062,43 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/admin/stats", wrapper.GetAdminStats)
+	router.GET(options.BaseURL+"/events", wrapper.ListEvents)
+	router.POST(options.BaseURL+"/events", wrapper.CreateEvent)
+	router.GET(options.BaseURL+"/events/stream", wrapper.StreamEvents)
+	router.GET(options.BaseURL+"/events/:event_id", wrapper.GetEvent)
+	router.GET(options.BaseURL+"/events/:event_id/go-features/counts", wrapper.GetEventGoFeatureCounts)
+	router.GET(options.BaseURL+"/events/:event_id/leaderboard", wrapper.GetEventLeaderboard)
+	router.GET(options.BaseURL+"/events/:event_id/rewards", wrapper.ListEventRewards)
+	router.POST(options.BaseURL+"/events/:event_id/rewards", wrapper.CreateEventReward)
+	router.POST(options.BaseURL+"/events/:event_id/rewards/grant", wrapper.GrantEventRewards)
+	router.GET(options.BaseURL+"/events/:event_id/stamps", wrapper.ListEventStamps)
+	router.POST(options.BaseURL+"/events/:event_id/stamps", wrapper.CreateEventStamp)
+	router.GET(options.BaseURL+"/events/:event_id/users", wrapper.ListEventUsers)
+	router.POST(options.BaseURL+"/events/:event_id/users", wrapper.CreateEventUser)
+	router.GET(options.BaseURL+"/events/:event_id/webhooks", wrapper.ListEventWebhooks)
+	router.POST(options.BaseURL+"/events/:event_id/webhooks", wrapper.CreateEventWebhook)
+	router.GET(options.BaseURL+"/go-features", wrapper.ListGoFeatures)
+	router.GET(options.BaseURL+"/go-features/counts", wrapper.GetGoFeatureCounts)
+	router.GET(options.BaseURL+"/leaderboard", wrapper.GetLeaderboard)
 	router.GET(options.BaseURL+"/stamps", wrapper.ListStamps)
 	router.POST(options.BaseURL+"/stamps", wrapper.CreateStamp)
 	router.DELETE(options.BaseURL+"/stamps/:id", wrapper.DeleteStamp)
 	router.GET(options.BaseURL+"/stamps/:id", wrapper.GetStamp)
 	router.PUT(options.BaseURL+"/stamps/:id", wrapper.UpdateStamp)
+	router.GET(options.BaseURL+"/stamps/:id/code", wrapper.GetStampCode)
+	router.GET(options.BaseURL+"/stamps/:id/token", wrapper.IssueStampToken)
 	router.GET(options.BaseURL+"/users", wrapper.ListUsers)
 	router.POST(options.BaseURL+"/users", wrapper.CreateUser)
+	router.DELETE(options.BaseURL+"/users/:id", wrapper.DeleteUser)
 	router.GET(options.BaseURL+"/users/:id", wrapper.GetUser)
 	router.PUT(options.BaseURL+"/users/:id", wrapper.UpdateUser)
+	router.PUT(options.BaseURL+"/users/:id/icon", wrapper.UploadUserIcon)
+	router.GET(options.BaseURL+"/users/:id/rewards", wrapper.ListUserRewards)
+	router.POST(options.BaseURL+"/users/:id/rewards/:reward_id/redeem", wrapper.RedeemUserReward)
 	router.GET(options.BaseURL+"/users/:id/stamps", wrapper.ListUserStamps)
 	router.POST(options.BaseURL+"/users/:id/stamps", wrapper.AcquireStamp)
+	router.POST(options.BaseURL+"/users/:id/token", wrapper.IssueUserToken)
+	router.DELETE(options.BaseURL+"/webhooks/:webhook_id", wrapper.DeleteWebhook)
+	router.GET(options.BaseURL+"/webhooks/:webhook_id/deliveries", wrapper.ListWebhookDeliveries)
 }
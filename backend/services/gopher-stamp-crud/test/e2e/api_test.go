@@ -1,12 +1,20 @@
 package e2e
 
 import (
+	"bufio"
 	"bytes"
+	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"image"
+	"image/png"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,8 +24,19 @@ import (
 
 const (
 	baseURL = "http://localhost:8080"
+
+	// defaultAdminAPIKey matches ADMIN_API_KEY in backend/docker-compose.yml
+	defaultAdminAPIKey = "local-admin-api-key"
 )
 
+// adminAPIKey returns the organizer API key configured for the server under test.
+func adminAPIKey() string {
+	if key := os.Getenv("E2E_ADMIN_API_KEY"); key != "" {
+		return key
+	}
+	return defaultAdminAPIKey
+}
+
 // skipIfCI skips the test if running in CI environment
 func skipIfCI(t *testing.T) {
 	if os.Getenv("CI") != "" {
@@ -49,6 +68,26 @@ func skipIfServerNotAvailable(t *testing.T) {
 // Helper functions
 
 func makeRequest(t *testing.T, method, path string, body interface{}) (*http.Response, []byte) {
+	return sendRequest(t, method, path, http.Header{}, body)
+}
+
+// makeAuthedRequest sends the request with the participant's access token, if any.
+func makeAuthedRequest(t *testing.T, method, path, accessToken string, body interface{}) (*http.Response, []byte) {
+	header := http.Header{}
+	if accessToken != "" {
+		header.Set("Authorization", "Bearer "+accessToken)
+	}
+	return sendRequest(t, method, path, header, body)
+}
+
+// makeAdminRequest sends the request with the organizer API key.
+func makeAdminRequest(t *testing.T, method, path string, body interface{}) (*http.Response, []byte) {
+	header := http.Header{}
+	header.Set("X-Admin-Key", adminAPIKey())
+	return sendRequest(t, method, path, header, body)
+}
+
+func sendRequest(t *testing.T, method, path string, header http.Header, body interface{}) (*http.Response, []byte) {
 	var reqBody io.Reader
 	if body != nil {
 		jsonData, err := json.Marshal(body)
@@ -59,6 +98,7 @@ func makeRequest(t *testing.T, method, path string, body interface{}) (*http.Res
 	req, err := http.NewRequest(method, baseURL+path, reqBody)
 	require.NoError(t, err)
 
+	req.Header = header
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
@@ -78,13 +118,59 @@ func makeRequest(t *testing.T, method, path string, body interface{}) (*http.Res
 	return resp, respBody
 }
 
+// uploadIcon sends data as the icon field of a multipart form, as the profile page does.
+func uploadIcon(t *testing.T, userID int64, accessToken string, data []byte) (*http.Response, []byte) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("icon", "icon.png")
+	require.NoError(t, err)
+	_, err = part.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/users/%d/icon", baseURL, userID), &body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, respBody
+}
+
+// issueStampToken fetches the signed acquisition token for a stamp, as printed in its QR code.
+func issueStampToken(t *testing.T, stampID int64) string {
+	resp, body := makeAdminRequest(t, http.MethodGet, fmt.Sprintf("/stamps/%d/token", stampID), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var token StampToken
+	require.NoError(t, json.Unmarshal(body, &token))
+	return token.Token
+}
+
 // Test Data Structures
 
-type User struct {
+type Event struct {
 	ID   int64  `json:"id"`
 	Name string `json:"name"`
 }
 
+type User struct {
+	ID            int64    `json:"id"`
+	EventID       int64    `json:"event_id"`
+	Name          string   `json:"name"`
+	TwitterID     *string  `json:"twitter_id,omitempty"`
+	GoFeatures    []string `json:"go_features,omitempty"`
+	Icon          *string  `json:"icon,omitempty"`
+	IconThumbnail *string  `json:"icon_thumbnail,omitempty"`
+	AccessToken   string   `json:"access_token,omitempty"`
+}
+
 type UserDetail struct {
 	ID             int64       `json:"id"`
 	Name           string      `json:"name"`
@@ -92,8 +178,23 @@ type UserDetail struct {
 }
 
 type Stamp struct {
-	ID   int64  `json:"id"`
-	Name string `json:"name"`
+	ID             int64      `json:"id"`
+	EventID        int64      `json:"event_id"`
+	Name           string     `json:"name"`
+	AvailableFrom  *time.Time `json:"available_from,omitempty"`
+	AvailableUntil *time.Time `json:"available_until,omitempty"`
+}
+
+type StampToken struct {
+	StampID   int64     `json:"stamp_id"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type StampCode struct {
+	StampID   int64     `json:"stamp_id"`
+	Code      string    `json:"code"`
+	ExpiresAt time.Time `json:"expires_at"`
 }
 
 type UserStamp struct {
@@ -102,6 +203,49 @@ type UserStamp struct {
 	AcquiredAt time.Time `json:"acquired_at"`
 }
 
+type RewardRule struct {
+	ID            int64   `json:"id"`
+	EventID       int64   `json:"event_id"`
+	Name          string  `json:"name"`
+	Kind          string  `json:"kind"`
+	RequiredCount *int    `json:"required_count,omitempty"`
+	StampIDs      []int64 `json:"stamp_ids,omitempty"`
+}
+
+type UserReward struct {
+	UserID     int64      `json:"user_id"`
+	RewardID   int64      `json:"reward_id"`
+	Name       string     `json:"name"`
+	EarnedAt   time.Time  `json:"earned_at"`
+	RedeemedAt *time.Time `json:"redeemed_at,omitempty"`
+}
+
+type LeaderboardEntry struct {
+	Rank           int        `json:"rank"`
+	UserID         int64      `json:"user_id"`
+	Name           string     `json:"name"`
+	StampCount     int64      `json:"stamp_count"`
+	LastAcquiredAt *time.Time `json:"last_acquired_at,omitempty"`
+}
+
+type Webhook struct {
+	ID         int64    `json:"id"`
+	EventID    int64    `json:"event_id"`
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	Secret     *string  `json:"secret,omitempty"`
+}
+
+type WebhookDelivery struct {
+	ID         int64  `json:"id"`
+	WebhookID  int64  `json:"webhook_id"`
+	EventType  string `json:"event_type"`
+	Payload    string `json:"payload"`
+	Attempt    int    `json:"attempt"`
+	StatusCode *int   `json:"status_code,omitempty"`
+	Succeeded  bool   `json:"succeeded"`
+}
+
 // Test Cases
 
 func TestE2E_UserCRUD(t *testing.T) {
@@ -121,6 +265,7 @@ func TestE2E_UserCRUD(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotZero(t, user.ID)
 		assert.Equal(t, "Test User", user.Name)
+		assert.NotEmpty(t, user.AccessToken)
 	})
 
 	t.Run("Get User", func(t *testing.T) {
@@ -154,6 +299,9 @@ func TestE2E_UserCRUD(t *testing.T) {
 		err := json.Unmarshal(body, &users)
 		require.NoError(t, err)
 		assert.NotEmpty(t, users)
+		total, err := strconv.Atoi(resp.Header.Get("X-Total-Count"))
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, total, len(users))
 	})
 
 	t.Run("Update User", func(t *testing.T) {
@@ -172,7 +320,7 @@ func TestE2E_UserCRUD(t *testing.T) {
 		updateBody := map[string]string{
 			"name": "Updated Name",
 		}
-		resp, body = makeRequest(t, http.MethodPut, fmt.Sprintf("/users/%d", createdUser.ID), updateBody)
+		resp, body = makeAuthedRequest(t, http.MethodPut, fmt.Sprintf("/users/%d", createdUser.ID), createdUser.AccessToken, updateBody)
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 
 		var updatedUser User
@@ -180,6 +328,144 @@ func TestE2E_UserCRUD(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "Updated Name", updatedUser.Name)
 	})
+
+	t.Run("Validate Profile", func(t *testing.T) {
+		resp, body := makeRequest(t, http.MethodPost, "/users", map[string]string{
+			"name":       "  Validated User ",
+			"twitter_id": "@validated_user",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+
+		var user User
+		require.NoError(t, json.Unmarshal(body, &user))
+		assert.Equal(t, "Validated User", user.Name)
+		require.NotNil(t, user.TwitterID)
+		assert.Equal(t, "validated_user", *user.TwitterID)
+
+		resp, body = makeRequest(t, http.MethodPost, "/users", map[string]string{
+			"name":       strings.Repeat("a", 101),
+			"twitter_id": "not a handle",
+		})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var errResp struct {
+			Code    string `json:"code"`
+			Details string `json:"details"`
+		}
+		require.NoError(t, json.Unmarshal(body, &errResp))
+		assert.Equal(t, "INVALID_REQUEST", errResp.Code)
+		assert.Contains(t, errResp.Details, "name: ")
+		assert.Contains(t, errResp.Details, "twitter_id: ")
+
+		resp, _ = makeAuthedRequest(t, http.MethodPut, fmt.Sprintf("/users/%d", user.ID), user.AccessToken, map[string]string{"name": " "})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Reject Update Without Ownership", func(t *testing.T) {
+		// Create two users
+		var users [2]User
+		for i := range users {
+			resp, body := makeRequest(t, http.MethodPost, "/users", map[string]string{
+				"name": fmt.Sprintf("Owner %d", i),
+			})
+			require.Equal(t, http.StatusCreated, resp.StatusCode)
+			require.NoError(t, json.Unmarshal(body, &users[i]))
+		}
+
+		path := fmt.Sprintf("/users/%d", users[0].ID)
+		updateBody := map[string]string{
+			"name": "Hijacked",
+		}
+
+		// No credential
+		resp, _ := makeRequest(t, http.MethodPut, path, updateBody)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		// Unknown credential
+		resp, _ = makeAuthedRequest(t, http.MethodPut, path, "unknown-token", updateBody)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		// Another participant's credential
+		resp, _ = makeAuthedRequest(t, http.MethodPut, path, users[1].AccessToken, updateBody)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		// Another participant's stamp card
+		resp, _ = makeAuthedRequest(t, http.MethodPost, path+"/stamps", users[1].AccessToken, map[string]interface{}{
+			"stamp_id": 1,
+		})
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("Reissue Access Token", func(t *testing.T) {
+		resp, body := makeRequest(t, http.MethodPost, "/users", map[string]string{
+			"name": "Token Lost",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var user User
+		require.NoError(t, json.Unmarshal(body, &user))
+		path := fmt.Sprintf("/users/%d/token", user.ID)
+
+		// Only organizers can issue a token for a participant
+		resp, _ = makeRequest(t, http.MethodPost, path, nil)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		resp, _ = makeAdminRequest(t, http.MethodPost, "/users/999999999/token", nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp, body = makeAdminRequest(t, http.MethodPost, path, nil)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var issued struct {
+			AccessToken string `json:"access_token"`
+		}
+		require.NoError(t, json.Unmarshal(body, &issued))
+		assert.NotEqual(t, user.AccessToken, issued.AccessToken)
+
+		// Both the new and the earlier token act for the participant
+		for _, token := range []string{issued.AccessToken, user.AccessToken} {
+			resp, _ = makeAuthedRequest(t, http.MethodPut, fmt.Sprintf("/users/%d", user.ID), token, map[string]string{"name": "Token Found"})
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		}
+	})
+
+	t.Run("Delete User", func(t *testing.T) {
+		var users [2]User
+		for i := range users {
+			resp, body := makeRequest(t, http.MethodPost, "/users", map[string]string{
+				"name": fmt.Sprintf("Leaving %d", i),
+			})
+			require.Equal(t, http.StatusCreated, resp.StatusCode)
+			require.NoError(t, json.Unmarshal(body, &users[i]))
+		}
+		user := users[0]
+		path := fmt.Sprintf("/users/%d", user.ID)
+
+		// Acquire a stamp so the erasure has dependent rows to remove
+		resp, _ := makeAuthedRequest(t, http.MethodPost, path+"/stamps", user.AccessToken, map[string]interface{}{
+			"stamp_id": 1,
+			"token":    issueStampToken(t, 1),
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		// Only the owner can delete the account
+		resp, _ = makeRequest(t, http.MethodDelete, path, nil)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		resp, _ = makeAuthedRequest(t, http.MethodDelete, path, users[1].AccessToken, nil)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		resp, _ = makeAuthedRequest(t, http.MethodDelete, path, user.AccessToken, nil)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		// The user is gone
+		resp, _ = makeRequest(t, http.MethodGet, path, nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		// And so is their access token
+		resp, _ = makeAuthedRequest(t, http.MethodDelete, path, user.AccessToken, nil)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
 }
 
 func TestE2E_StampCRUD(t *testing.T) {
@@ -191,7 +477,7 @@ func TestE2E_StampCRUD(t *testing.T) {
 			"name": "Gopher Basic",
 		}
 
-		resp, body := makeRequest(t, http.MethodPost, "/stamps", reqBody)
+		resp, body := makeAdminRequest(t, http.MethodPost, "/stamps", reqBody)
 		assert.Equal(t, http.StatusCreated, resp.StatusCode)
 
 		var stamp Stamp
@@ -207,7 +493,7 @@ func TestE2E_StampCRUD(t *testing.T) {
 		reqBody := map[string]string{
 			"name": "Get Test Stamp",
 		}
-		resp, body := makeRequest(t, http.MethodPost, "/stamps", reqBody)
+		resp, body := makeAdminRequest(t, http.MethodPost, "/stamps", reqBody)
 		require.Equal(t, http.StatusCreated, resp.StatusCode)
 
 		var createdStamp Stamp
@@ -234,6 +520,9 @@ func TestE2E_StampCRUD(t *testing.T) {
 		require.NoError(t, err)
 		assert.Contains(t, response, "stamps")
 		assert.Contains(t, response, "total")
+
+		resp, _ = makeRequest(t, http.MethodGet, "/stamps?limit=1001", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
 
 	t.Run("Update Stamp", func(t *testing.T) {
@@ -241,7 +530,7 @@ func TestE2E_StampCRUD(t *testing.T) {
 		reqBody := map[string]string{
 			"name": "Original Stamp",
 		}
-		resp, body := makeRequest(t, http.MethodPost, "/stamps", reqBody)
+		resp, body := makeAdminRequest(t, http.MethodPost, "/stamps", reqBody)
 		require.Equal(t, http.StatusCreated, resp.StatusCode)
 
 		var createdStamp Stamp
@@ -252,7 +541,7 @@ func TestE2E_StampCRUD(t *testing.T) {
 		updateBody := map[string]string{
 			"name": "Updated Stamp",
 		}
-		resp, body = makeRequest(t, http.MethodPut, fmt.Sprintf("/stamps/%d", createdStamp.ID), updateBody)
+		resp, body = makeAdminRequest(t, http.MethodPut, fmt.Sprintf("/stamps/%d", createdStamp.ID), updateBody)
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 
 		var updatedStamp Stamp
@@ -262,12 +551,35 @@ func TestE2E_StampCRUD(t *testing.T) {
 		// Image field removed - frontend handles images
 	})
 
+	t.Run("Reject Stamp Management Without Admin Key", func(t *testing.T) {
+		reqBody := map[string]string{
+			"name": "Unauthorized Stamp",
+		}
+
+		resp, _ := makeRequest(t, http.MethodPost, "/stamps", reqBody)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		resp, _ = makeRequest(t, http.MethodPut, "/stamps/1", reqBody)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		resp, _ = makeRequest(t, http.MethodDelete, "/stamps/1", nil)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		resp, _ = makeRequest(t, http.MethodGet, "/stamps/1/token", nil)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		header := http.Header{}
+		header.Set("X-Admin-Key", "wrong-key")
+		resp, _ = sendRequest(t, http.MethodPost, "/stamps", header, reqBody)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
 	t.Run("Delete Stamp", func(t *testing.T) {
 		// First create a stamp
 		reqBody := map[string]string{
 			"name": "Delete Test Stamp",
 		}
-		resp, body := makeRequest(t, http.MethodPost, "/stamps", reqBody)
+		resp, body := makeAdminRequest(t, http.MethodPost, "/stamps", reqBody)
 		require.Equal(t, http.StatusCreated, resp.StatusCode)
 
 		var createdStamp Stamp
@@ -275,7 +587,7 @@ func TestE2E_StampCRUD(t *testing.T) {
 		require.NoError(t, err)
 
 		// Delete the stamp
-		resp, _ = makeRequest(t, http.MethodDelete, fmt.Sprintf("/stamps/%d", createdStamp.ID), nil)
+		resp, _ = makeAdminRequest(t, http.MethodDelete, fmt.Sprintf("/stamps/%d", createdStamp.ID), nil)
 		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
 
 		// Verify deletion
@@ -304,7 +616,7 @@ func TestE2E_UserStampAcquisition(t *testing.T) {
 		stampReq := map[string]string{
 			"name": "Collectible Stamp",
 		}
-		resp, body = makeRequest(t, http.MethodPost, "/stamps", stampReq)
+		resp, body = makeAdminRequest(t, http.MethodPost, "/stamps", stampReq)
 		require.Equal(t, http.StatusCreated, resp.StatusCode)
 
 		var stamp Stamp
@@ -312,10 +624,11 @@ func TestE2E_UserStampAcquisition(t *testing.T) {
 		require.NoError(t, err)
 
 		// Acquire the stamp
-		acquireReq := map[string]int64{
+		acquireReq := map[string]interface{}{
 			"stamp_id": stamp.ID,
+			"token":    issueStampToken(t, stamp.ID),
 		}
-		resp, body = makeRequest(t, http.MethodPost, fmt.Sprintf("/users/%d/stamps", user.ID), acquireReq)
+		resp, body = makeAuthedRequest(t, http.MethodPost, fmt.Sprintf("/users/%d/stamps", user.ID), user.AccessToken, acquireReq)
 		assert.Equal(t, http.StatusCreated, resp.StatusCode)
 
 		var userStamp UserStamp
@@ -342,17 +655,18 @@ func TestE2E_UserStampAcquisition(t *testing.T) {
 		stampReq := map[string]string{
 			"name": "View Test Stamp",
 		}
-		resp, body = makeRequest(t, http.MethodPost, "/stamps", stampReq)
+		resp, body = makeAdminRequest(t, http.MethodPost, "/stamps", stampReq)
 		require.Equal(t, http.StatusCreated, resp.StatusCode)
 
 		var stamp Stamp
 		err = json.Unmarshal(body, &stamp)
 		require.NoError(t, err)
 
-		acquireReq := map[string]int64{
+		acquireReq := map[string]interface{}{
 			"stamp_id": stamp.ID,
+			"token":    issueStampToken(t, stamp.ID),
 		}
-		resp, _ = makeRequest(t, http.MethodPost, fmt.Sprintf("/users/%d/stamps", user.ID), acquireReq)
+		resp, _ = makeAuthedRequest(t, http.MethodPost, fmt.Sprintf("/users/%d/stamps", user.ID), user.AccessToken, acquireReq)
 		require.Equal(t, http.StatusCreated, resp.StatusCode)
 
 		// List user stamps
@@ -383,17 +697,18 @@ func TestE2E_UserStampAcquisition(t *testing.T) {
 			stampReq := map[string]string{
 				"name": fmt.Sprintf("Test Stamp %d", i),
 			}
-			resp, body = makeRequest(t, http.MethodPost, "/stamps", stampReq)
+			resp, body = makeAdminRequest(t, http.MethodPost, "/stamps", stampReq)
 			require.Equal(t, http.StatusCreated, resp.StatusCode)
 
 			var stamp Stamp
 			err = json.Unmarshal(body, &stamp)
 			require.NoError(t, err)
 
-			acquireReq := map[string]int64{
+			acquireReq := map[string]interface{}{
 				"stamp_id": stamp.ID,
+				"token":    issueStampToken(t, stamp.ID),
 			}
-			resp, _ = makeRequest(t, http.MethodPost, fmt.Sprintf("/users/%d/stamps", user.ID), acquireReq)
+			resp, _ = makeAuthedRequest(t, http.MethodPost, fmt.Sprintf("/users/%d/stamps", user.ID), user.AccessToken, acquireReq)
 			require.Equal(t, http.StatusCreated, resp.StatusCode)
 		}
 
@@ -425,7 +740,7 @@ func TestE2E_UserStampAcquisition(t *testing.T) {
 		stampReq := map[string]string{
 			"name": "Unique Stamp",
 		}
-		resp, body = makeRequest(t, http.MethodPost, "/stamps", stampReq)
+		resp, body = makeAdminRequest(t, http.MethodPost, "/stamps", stampReq)
 		require.Equal(t, http.StatusCreated, resp.StatusCode)
 
 		var stamp Stamp
@@ -433,14 +748,1135 @@ func TestE2E_UserStampAcquisition(t *testing.T) {
 		require.NoError(t, err)
 
 		// Acquire the stamp first time
-		acquireReq := map[string]int64{
+		acquireReq := map[string]interface{}{
 			"stamp_id": stamp.ID,
+			"token":    issueStampToken(t, stamp.ID),
 		}
-		resp, _ = makeRequest(t, http.MethodPost, fmt.Sprintf("/users/%d/stamps", user.ID), acquireReq)
+		resp, _ = makeAuthedRequest(t, http.MethodPost, fmt.Sprintf("/users/%d/stamps", user.ID), user.AccessToken, acquireReq)
 		assert.Equal(t, http.StatusCreated, resp.StatusCode)
 
 		// Try to acquire the same stamp again
-		resp, _ = makeRequest(t, http.MethodPost, fmt.Sprintf("/users/%d/stamps", user.ID), acquireReq)
+		resp, _ = makeAuthedRequest(t, http.MethodPost, fmt.Sprintf("/users/%d/stamps", user.ID), user.AccessToken, acquireReq)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("Concurrent Acquisition Succeeds Once", func(t *testing.T) {
+		resp, body := makeRequest(t, http.MethodPost, "/users", map[string]string{
+			"name": "Rapid Tapper",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var user User
+		require.NoError(t, json.Unmarshal(body, &user))
+
+		resp, body = makeAdminRequest(t, http.MethodPost, "/stamps", map[string]string{
+			"name": "Contended Stamp",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var stamp Stamp
+		require.NoError(t, json.Unmarshal(body, &stamp))
+
+		acquireReq, err := json.Marshal(map[string]interface{}{
+			"stamp_id": stamp.ID,
+			"token":    issueStampToken(t, stamp.ID),
+		})
+		require.NoError(t, err)
+		url := fmt.Sprintf("%s/users/%d/stamps", baseURL, user.ID)
+
+		// The goroutines only report back; require and assert must run on the test goroutine
+		type result struct {
+			status int
+			err    error
+		}
+		const attempts = 10
+		results := make(chan result, attempts)
+		client := &http.Client{Timeout: 10 * time.Second}
+		for range attempts {
+			go func() {
+				req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(acquireReq))
+				if err != nil {
+					results <- result{err: err}
+					return
+				}
+				req.Header.Set("Content-Type", "application/json")
+				req.Header.Set("Authorization", "Bearer "+user.AccessToken)
+				resp, err := client.Do(req)
+				if err != nil {
+					results <- result{err: err}
+					return
+				}
+				_ = resp.Body.Close()
+				results <- result{status: resp.StatusCode}
+			}()
+		}
+
+		created := 0
+		for range attempts {
+			r := <-results
+			require.NoError(t, r.err)
+			if r.status == http.StatusCreated {
+				created++
+				continue
+			}
+			assert.Equal(t, http.StatusConflict, r.status)
+		}
+		assert.Equal(t, 1, created)
+	})
+
+	t.Run("Reject Acquisition Without Valid Token", func(t *testing.T) {
+		// Create a user
+		userReq := map[string]string{
+			"name": "Token Tester",
+		}
+		resp, body := makeRequest(t, http.MethodPost, "/users", userReq)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var user User
+		err := json.Unmarshal(body, &user)
+		require.NoError(t, err)
+
+		// Create two stamps
+		var stamps [2]Stamp
+		for i := range stamps {
+			stampReq := map[string]string{
+				"name": fmt.Sprintf("Token Stamp %d", i),
+			}
+			resp, body = makeAdminRequest(t, http.MethodPost, "/stamps", stampReq)
+			require.Equal(t, http.StatusCreated, resp.StatusCode)
+			require.NoError(t, json.Unmarshal(body, &stamps[i]))
+		}
+
+		path := fmt.Sprintf("/users/%d/stamps", user.ID)
+
+		// Missing token
+		resp, _ = makeAuthedRequest(t, http.MethodPost, path, user.AccessToken, map[string]interface{}{
+			"stamp_id": stamps[0].ID,
+		})
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		// Forged token
+		resp, body = makeAuthedRequest(t, http.MethodPost, path, user.AccessToken, map[string]interface{}{
+			"stamp_id": stamps[0].ID,
+			"token":    "4102444800.forged",
+		})
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		var apiErr map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &apiErr))
+		assert.Equal(t, "INVALID_STAMP_TOKEN", apiErr["code"])
+
+		// Token issued for another stamp
+		resp, _ = makeAuthedRequest(t, http.MethodPost, path, user.AccessToken, map[string]interface{}{
+			"stamp_id": stamps[0].ID,
+			"token":    issueStampToken(t, stamps[1].ID),
+		})
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+	t.Run("Acquire Stamp With Rotating Code", func(t *testing.T) {
+		// Create a user
+		userReq := map[string]string{
+			"name": "Booth Visitor",
+		}
+		resp, body := makeRequest(t, http.MethodPost, "/users", userReq)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var user User
+		err := json.Unmarshal(body, &user)
+		require.NoError(t, err)
+
+		// Create a stamp
+		stampReq := map[string]string{
+			"name": "Booth Stamp",
+		}
+		resp, body = makeAdminRequest(t, http.MethodPost, "/stamps", stampReq)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var stamp Stamp
+		err = json.Unmarshal(body, &stamp)
+		require.NoError(t, err)
+
+		// Read the code shown on the booth screen
+		resp, body = makeAdminRequest(t, http.MethodGet, fmt.Sprintf("/stamps/%d/code", stamp.ID), nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var code StampCode
+		err = json.Unmarshal(body, &code)
+		require.NoError(t, err)
+		assert.Len(t, code.Code, 6)
+		assert.True(t, code.ExpiresAt.After(time.Now()))
+
+		path := fmt.Sprintf("/users/%d/stamps", user.ID)
+
+		// Wrong code
+		resp, body = makeAuthedRequest(t, http.MethodPost, path, user.AccessToken, map[string]interface{}{
+			"stamp_id": stamp.ID,
+			"code":     "invalid",
+		})
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		var apiErr map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &apiErr))
+		assert.Equal(t, "INVALID_STAMP_CODE", apiErr["code"])
+
+		// Current code
+		resp, _ = makeAuthedRequest(t, http.MethodPost, path, user.AccessToken, map[string]interface{}{
+			"stamp_id": stamp.ID,
+			"code":     code.Code,
+		})
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+}
+
+func TestE2E_StampAvailability(t *testing.T) {
+	skipIfCI(t)
+	skipIfServerNotAvailable(t)
+
+	resp, body := makeRequest(t, http.MethodPost, "/users", map[string]string{
+		"name": "Early Bird",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var user User
+	require.NoError(t, json.Unmarshal(body, &user))
+
+	// The stamp opens in an hour
+	opensAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	resp, body = makeAdminRequest(t, http.MethodPost, "/stamps", map[string]interface{}{
+		"name":           "Afternoon Stamp",
+		"available_from": opensAt,
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var stamp Stamp
+	require.NoError(t, json.Unmarshal(body, &stamp))
+	require.NotNil(t, stamp.AvailableFrom)
+	assert.True(t, opensAt.Equal(*stamp.AvailableFrom))
+
+	path := fmt.Sprintf("/users/%d/stamps", user.ID)
+	acquireReq := map[string]interface{}{
+		"stamp_id": stamp.ID,
+		"token":    issueStampToken(t, stamp.ID),
+	}
+
+	t.Run("Reject Acquisition Before Opening", func(t *testing.T) {
+		resp, body := makeAuthedRequest(t, http.MethodPost, path, user.AccessToken, acquireReq)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		var apiErr map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &apiErr))
+		assert.Equal(t, "STAMP_NOT_ACTIVE", apiErr["code"])
+	})
+
+	t.Run("Accept Acquisition Once Opened", func(t *testing.T) {
+		resp, _ := makeAdminRequest(t, http.MethodPut, fmt.Sprintf("/stamps/%d", stamp.ID), map[string]interface{}{
+			"name":           "Afternoon Stamp",
+			"available_from": time.Now().Add(-time.Minute),
+		})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, _ = makeAuthedRequest(t, http.MethodPost, path, user.AccessToken, acquireReq)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+}
+
+func TestE2E_EventScoping(t *testing.T) {
+	skipIfCI(t)
+	skipIfServerNotAvailable(t)
+
+	// Create an event with its own stamp and participant
+	startsAt := time.Now().Truncate(time.Second)
+	resp, body := makeAdminRequest(t, http.MethodPost, "/events", map[string]interface{}{
+		"name":      "E2E Event",
+		"starts_at": startsAt,
+		"ends_at":   startsAt.Add(8 * time.Hour),
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var event Event
+	require.NoError(t, json.Unmarshal(body, &event))
+	assert.NotZero(t, event.ID)
+
+	resp, body = makeAdminRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/stamps", event.ID), map[string]string{
+		"name": "E2E Event Stamp",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var stamp Stamp
+	require.NoError(t, json.Unmarshal(body, &stamp))
+	assert.Equal(t, event.ID, stamp.EventID)
+
+	resp, body = makeRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/users", event.ID), map[string]string{
+		"name": "E2E Event Participant",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var user User
+	require.NoError(t, json.Unmarshal(body, &user))
+	assert.Equal(t, event.ID, user.EventID)
+
+	t.Run("List Event Stamps", func(t *testing.T) {
+		resp, body := makeRequest(t, http.MethodGet, fmt.Sprintf("/events/%d/stamps", event.ID), nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			Stamps []Stamp `json:"stamps"`
+			Total  int64   `json:"total"`
+		}
+		require.NoError(t, json.Unmarshal(body, &result))
+		assert.Equal(t, int64(1), result.Total)
+		require.Len(t, result.Stamps, 1)
+		assert.Equal(t, stamp.ID, result.Stamps[0].ID)
+	})
+
+	t.Run("Legacy Routes Exclude Other Events", func(t *testing.T) {
+		resp, body := makeRequest(t, http.MethodGet, "/stamps?limit=1000", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			Stamps []Stamp `json:"stamps"`
+		}
+		require.NoError(t, json.Unmarshal(body, &result))
+		for _, s := range result.Stamps {
+			assert.NotEqual(t, stamp.ID, s.ID)
+		}
+
+		resp, body = makeRequest(t, http.MethodGet, "/users", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var users []User
+		require.NoError(t, json.Unmarshal(body, &users))
+		for _, u := range users {
+			assert.NotEqual(t, user.ID, u.ID)
+		}
+	})
+
+	t.Run("Reject Stamp From Another Event", func(t *testing.T) {
+		// A participant of the default event cannot collect this event's stamp
+		resp, body := makeRequest(t, http.MethodPost, "/users", map[string]string{
+			"name": "Default Event Participant",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var other User
+		require.NoError(t, json.Unmarshal(body, &other))
+
+		resp, _ = makeAuthedRequest(t, http.MethodPost, fmt.Sprintf("/users/%d/stamps", other.ID), other.AccessToken, map[string]interface{}{
+			"stamp_id": stamp.ID,
+			"token":    issueStampToken(t, stamp.ID),
+		})
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Unknown Event", func(t *testing.T) {
+		resp, _ := makeRequest(t, http.MethodGet, "/events/999999/stamps", nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestE2E_Rewards(t *testing.T) {
+	skipIfCI(t)
+	skipIfServerNotAvailable(t)
+
+	// A fresh event with two workshop stamps and a prize for collecting both
+	startsAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	resp, body := makeAdminRequest(t, http.MethodPost, "/events", map[string]interface{}{
+		"name":      "E2E Reward Event",
+		"starts_at": startsAt,
+		"ends_at":   startsAt.Add(8 * time.Hour),
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var event Event
+	require.NoError(t, json.Unmarshal(body, &event))
+
+	stamps := make([]Stamp, 2)
+	for i := range stamps {
+		resp, body := makeAdminRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/stamps", event.ID), map[string]string{
+			"name": fmt.Sprintf("Workshop %d", i+1),
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.NoError(t, json.Unmarshal(body, &stamps[i]))
+	}
+
+	resp, body = makeAdminRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/rewards", event.ID), map[string]interface{}{
+		"name":      "Workshop Prize",
+		"kind":      "stamps",
+		"stamp_ids": []int64{stamps[0].ID, stamps[1].ID},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var rule RewardRule
+	require.NoError(t, json.Unmarshal(body, &rule))
+	assert.Equal(t, event.ID, rule.EventID)
+	assert.ElementsMatch(t, []int64{stamps[0].ID, stamps[1].ID}, rule.StampIDs)
+
+	resp, body = makeRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/users", event.ID), map[string]string{
+		"name": "E2E Reward Participant",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var user User
+	require.NoError(t, json.Unmarshal(body, &user))
+
+	rewardsPath := fmt.Sprintf("/users/%d/rewards", user.ID)
+	redeemPath := fmt.Sprintf("/users/%d/rewards/%d/redeem", user.ID, rule.ID)
+	listRewards := func(t *testing.T) []UserReward {
+		resp, body := makeRequest(t, http.MethodGet, rewardsPath, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			Rewards []UserReward `json:"rewards"`
+		}
+		require.NoError(t, json.Unmarshal(body, &result))
+		return result.Rewards
+	}
+	acquire := func(t *testing.T, stamp Stamp) {
+		resp, _ := makeAuthedRequest(t, http.MethodPost, fmt.Sprintf("/users/%d/stamps", user.ID), user.AccessToken, map[string]interface{}{
+			"stamp_id": stamp.ID,
+			"token":    issueStampToken(t, stamp.ID),
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	t.Run("Not Earned With One Stamp", func(t *testing.T) {
+		acquire(t, stamps[0])
+		assert.Empty(t, listRewards(t))
+
+		resp, body := makeAdminRequest(t, http.MethodPost, redeemPath, nil)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		var apiErr map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &apiErr))
+		assert.Equal(t, "REWARD_NOT_EARNED", apiErr["code"])
+	})
+
+	t.Run("Earned With Both Stamps", func(t *testing.T) {
+		acquire(t, stamps[1])
+
+		rewards := listRewards(t)
+		require.Len(t, rewards, 1)
+		assert.Equal(t, rule.ID, rewards[0].RewardID)
+		assert.Equal(t, "Workshop Prize", rewards[0].Name)
+		assert.Nil(t, rewards[0].RedeemedAt)
+	})
+
+	t.Run("Redeem Requires Admin Key", func(t *testing.T) {
+		resp, _ := makeAuthedRequest(t, http.MethodPost, redeemPath, user.AccessToken, nil)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("Redeem Only Once", func(t *testing.T) {
+		resp, body := makeAdminRequest(t, http.MethodPost, redeemPath, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var reward UserReward
+		require.NoError(t, json.Unmarshal(body, &reward))
+		assert.NotNil(t, reward.RedeemedAt)
+
+		resp, body = makeAdminRequest(t, http.MethodPost, redeemPath, nil)
 		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		var apiErr map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &apiErr))
+		assert.Equal(t, "REWARD_ALREADY_REDEEMED", apiErr["code"])
+	})
+
+	t.Run("Grant A Reward Added Later", func(t *testing.T) {
+		resp, _ := makeAdminRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/rewards", event.ID), map[string]interface{}{
+			"name":           "Participation Prize",
+			"kind":           "count",
+			"required_count": 1,
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		// Listing does not grant it; the participant qualified before it existed
+		assert.Len(t, listRewards(t), 1)
+
+		grantPath := fmt.Sprintf("/events/%d/rewards/grant", event.ID)
+		resp, _ = makeRequest(t, http.MethodPost, grantPath, nil)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		resp, body := makeAdminRequest(t, http.MethodPost, grantPath, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			Granted int `json:"granted"`
+		}
+		require.NoError(t, json.Unmarshal(body, &result))
+		assert.Equal(t, 1, result.Granted)
+		assert.Len(t, listRewards(t), 2)
+
+		// Running it again grants nothing twice
+		resp, body = makeAdminRequest(t, http.MethodPost, grantPath, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.NoError(t, json.Unmarshal(body, &result))
+		assert.Equal(t, 0, result.Granted)
+	})
+}
+
+func TestE2E_Leaderboard(t *testing.T) {
+	skipIfCI(t)
+	skipIfServerNotAvailable(t)
+
+	// A fresh event keeps the standings independent of other tests
+	startsAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	resp, body := makeAdminRequest(t, http.MethodPost, "/events", map[string]interface{}{
+		"name":      "E2E Leaderboard Event",
+		"starts_at": startsAt,
+		"ends_at":   startsAt.Add(8 * time.Hour),
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var event Event
+	require.NoError(t, json.Unmarshal(body, &event))
+
+	stamps := make([]Stamp, 2)
+	for i := range stamps {
+		resp, body := makeAdminRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/stamps", event.ID), map[string]string{
+			"name": fmt.Sprintf("Leaderboard Stamp %d", i+1),
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.NoError(t, json.Unmarshal(body, &stamps[i]))
+	}
+
+	// first and second both collect every stamp, first finishes earlier; third collects none
+	users := make([]User, 3)
+	for i := range users {
+		resp, body := makeRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/users", event.ID), map[string]string{
+			"name": fmt.Sprintf("Leaderboard User %d", i+1),
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.NoError(t, json.Unmarshal(body, &users[i]))
+	}
+	for _, user := range users[:2] {
+		for _, stamp := range stamps {
+			resp, _ := makeAuthedRequest(t, http.MethodPost, fmt.Sprintf("/users/%d/stamps", user.ID), user.AccessToken, map[string]interface{}{
+				"stamp_id": stamp.ID,
+				"token":    issueStampToken(t, stamp.ID),
+			})
+			require.Equal(t, http.StatusCreated, resp.StatusCode)
+		}
+	}
+
+	getLeaderboard := func(t *testing.T, query string) ([]LeaderboardEntry, int64) {
+		resp, body := makeRequest(t, http.MethodGet, fmt.Sprintf("/events/%d/leaderboard%s", event.ID, query), nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			Entries []LeaderboardEntry `json:"entries"`
+			Total   int64              `json:"total"`
+		}
+		require.NoError(t, json.Unmarshal(body, &result))
+		return result.Entries, result.Total
+	}
+
+	t.Run("Ranked By Count And Completion Time", func(t *testing.T) {
+		entries, total := getLeaderboard(t, "")
+		assert.Equal(t, int64(3), total)
+		require.Len(t, entries, 3)
+
+		for i, user := range users {
+			assert.Equal(t, i+1, entries[i].Rank)
+			assert.Equal(t, user.ID, entries[i].UserID)
+		}
+		assert.Equal(t, int64(2), entries[0].StampCount)
+		assert.Equal(t, int64(0), entries[2].StampCount)
+		assert.Nil(t, entries[2].LastAcquiredAt)
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		entries, total := getLeaderboard(t, "?limit=1&offset=1")
+		assert.Equal(t, int64(3), total)
+		require.Len(t, entries, 1)
+		assert.Equal(t, 2, entries[0].Rank)
+		assert.Equal(t, users[1].ID, entries[0].UserID)
+	})
+
+	t.Run("Invalid Page", func(t *testing.T) {
+		for _, query := range []string{"limit=0", "limit=1001", "offset=-1"} {
+			resp, _ := makeRequest(t, http.MethodGet, fmt.Sprintf("/events/%d/leaderboard?%s", event.ID, query), nil)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode, query)
+		}
+	})
+
+	t.Run("Unknown Event", func(t *testing.T) {
+		resp, _ := makeRequest(t, http.MethodGet, "/events/999999/leaderboard", nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestE2E_GoFeatures(t *testing.T) {
+	skipIfCI(t)
+	skipIfServerNotAvailable(t)
+
+	startsAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	resp, body := makeAdminRequest(t, http.MethodPost, "/events", map[string]interface{}{
+		"name":      "E2E Go Feature Event",
+		"starts_at": startsAt,
+		"ends_at":   startsAt.Add(8 * time.Hour),
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var event Event
+	require.NoError(t, json.Unmarshal(body, &event))
+
+	type GoFeatureCount struct {
+		Code      string `json:"code"`
+		Label     string `json:"label"`
+		UserCount int64  `json:"user_count"`
+	}
+	getCounts := func(t *testing.T) (map[string]int64, int64) {
+		resp, body := makeRequest(t, http.MethodGet, fmt.Sprintf("/events/%d/go-features/counts", event.ID), nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			GoFeatures   []GoFeatureCount `json:"go_features"`
+			Participants int64            `json:"participants"`
+		}
+		require.NoError(t, json.Unmarshal(body, &result))
+		counts := make(map[string]int64, len(result.GoFeatures))
+		for _, feature := range result.GoFeatures {
+			counts[feature.Code] = feature.UserCount
+		}
+		return counts, result.Participants
+	}
+
+	t.Run("List Catalogue", func(t *testing.T) {
+		resp, body := makeRequest(t, http.MethodGet, "/go-features", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			GoFeatures []struct {
+				Code  string `json:"code"`
+				Label string `json:"label"`
+			} `json:"go_features"`
+		}
+		require.NoError(t, json.Unmarshal(body, &result))
+		require.NotEmpty(t, result.GoFeatures)
+		assert.Equal(t, "CONCURRENCY", result.GoFeatures[0].Code)
+	})
+
+	t.Run("Choose And Count", func(t *testing.T) {
+		var users []User
+		for i, features := range [][]string{{"TESTING", "CONCURRENCY"}, {"CONCURRENCY"}} {
+			resp, body := makeRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/users", event.ID), map[string]interface{}{
+				"name":        fmt.Sprintf("Go Feature User %d", i+1),
+				"go_features": features,
+			})
+			require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+			var user User
+			require.NoError(t, json.Unmarshal(body, &user))
+			users = append(users, user)
+		}
+		assert.Equal(t, []string{"CONCURRENCY", "TESTING"}, users[0].GoFeatures)
+
+		counts, participants := getCounts(t)
+		assert.Equal(t, int64(2), participants)
+		assert.Equal(t, int64(2), counts["CONCURRENCY"])
+		assert.Equal(t, int64(1), counts["TESTING"])
+		assert.Equal(t, int64(0), counts["INTERFACES"])
+
+		// Choosing again replaces the previous choice
+		resp, body := makeAuthedRequest(t, http.MethodPut, fmt.Sprintf("/users/%d", users[1].ID), users[1].AccessToken, map[string]interface{}{
+			"go_features": []string{"INTERFACES"},
+		})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var updated User
+		require.NoError(t, json.Unmarshal(body, &updated))
+		assert.Equal(t, []string{"INTERFACES"}, updated.GoFeatures)
+
+		counts, _ = getCounts(t)
+		assert.Equal(t, int64(1), counts["CONCURRENCY"])
+		assert.Equal(t, int64(1), counts["INTERFACES"])
+	})
+
+	t.Run("Reject Unknown Feature", func(t *testing.T) {
+		resp, body := makeRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/users", event.ID), map[string]interface{}{
+			"name":        "Go Feature User",
+			"go_features": []string{"GENERICS"},
+		})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Contains(t, string(body), "go_features")
+	})
+
+	t.Run("Unknown Event", func(t *testing.T) {
+		resp, _ := makeRequest(t, http.MethodGet, "/events/999999/go-features/counts", nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestE2E_AdminStats(t *testing.T) {
+	skipIfCI(t)
+	skipIfServerNotAvailable(t)
+
+	startsAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	resp, body := makeAdminRequest(t, http.MethodPost, "/events", map[string]interface{}{
+		"name":      "E2E Stats Event",
+		"starts_at": startsAt,
+		"ends_at":   startsAt.Add(8 * time.Hour),
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var event Event
+	require.NoError(t, json.Unmarshal(body, &event))
+
+	stamps := make([]Stamp, 2)
+	for i := range stamps {
+		resp, body := makeAdminRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/stamps", event.ID), map[string]string{
+			"name": fmt.Sprintf("Stats Stamp %d", i+1),
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.NoError(t, json.Unmarshal(body, &stamps[i]))
+	}
+
+	// The first user completes the rally, the second acquires one stamp and the third none
+	users := make([]User, 3)
+	for i := range users {
+		resp, body := makeRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/users", event.ID), map[string]string{
+			"name": fmt.Sprintf("Stats User %d", i+1),
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.NoError(t, json.Unmarshal(body, &users[i]))
+	}
+	for i, user := range users[:2] {
+		for _, stamp := range stamps[:2-i] {
+			resp, _ := makeAuthedRequest(t, http.MethodPost, fmt.Sprintf("/users/%d/stamps", user.ID), user.AccessToken, map[string]interface{}{
+				"stamp_id": stamp.ID,
+				"token":    issueStampToken(t, stamp.ID),
+			})
+			require.Equal(t, http.StatusCreated, resp.StatusCode)
+		}
+	}
+
+	statsPath := fmt.Sprintf("/admin/stats?event_id=%d", event.ID)
+
+	t.Run("Aggregates", func(t *testing.T) {
+		resp, body := makeAdminRequest(t, http.MethodGet, statsPath, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var stats struct {
+			Participants   int64   `json:"participants"`
+			Completed      int64   `json:"completed"`
+			CompletionRate float64 `json:"completion_rate"`
+			Stamps         []struct {
+				StampID      int64 `json:"stamp_id"`
+				Acquisitions int64 `json:"acquisitions"`
+			} `json:"stamps"`
+			HourlyAcquisitions []struct {
+				Hour         time.Time `json:"hour"`
+				Acquisitions int64     `json:"acquisitions"`
+			} `json:"hourly_acquisitions"`
+			MedianCompletionSeconds *int64 `json:"median_completion_seconds"`
+		}
+		require.NoError(t, json.Unmarshal(body, &stats))
+
+		assert.Equal(t, int64(3), stats.Participants)
+		assert.Equal(t, int64(1), stats.Completed)
+		assert.InDelta(t, 1.0/3, stats.CompletionRate, 1e-9)
+		require.Len(t, stats.Stamps, 2)
+		assert.Equal(t, stamps[0].ID, stats.Stamps[0].StampID)
+		assert.Equal(t, int64(2), stats.Stamps[0].Acquisitions)
+		assert.Equal(t, int64(1), stats.Stamps[1].Acquisitions)
+
+		var acquisitions int64
+		for _, hour := range stats.HourlyAcquisitions {
+			acquisitions += hour.Acquisitions
+		}
+		assert.Equal(t, int64(3), acquisitions)
+		assert.NotNil(t, stats.MedianCompletionSeconds)
+	})
+
+	t.Run("Requires Admin Key", func(t *testing.T) {
+		resp, _ := makeRequest(t, http.MethodGet, statsPath, nil)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("Unknown Event", func(t *testing.T) {
+		resp, _ := makeAdminRequest(t, http.MethodGet, "/admin/stats?event_id=999999", nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestE2E_EventStream(t *testing.T) {
+	skipIfCI(t)
+	skipIfServerNotAvailable(t)
+
+	startsAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	resp, body := makeAdminRequest(t, http.MethodPost, "/events", map[string]interface{}{
+		"name":      "E2E Stream Event",
+		"starts_at": startsAt,
+		"ends_at":   startsAt.Add(8 * time.Hour),
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var event Event
+	require.NoError(t, json.Unmarshal(body, &event))
+
+	resp, body = makeAdminRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/stamps", event.ID), map[string]string{
+		"name": "Stream Stamp",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var stamp Stamp
+	require.NoError(t, json.Unmarshal(body, &stamp))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/events/stream?event_id=%d", baseURL, event.ID), nil)
+	require.NoError(t, err)
+	stream, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer stream.Body.Close()
+	require.Equal(t, http.StatusOK, stream.StatusCode)
+	assert.True(t, strings.HasPrefix(stream.Header.Get("Content-Type"), "text/event-stream"))
+	reader := bufio.NewReader(stream.Body)
+
+	resp, body = makeRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/users", event.ID), map[string]string{
+		"name": "Stream User",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var user User
+	require.NoError(t, json.Unmarshal(body, &user))
+
+	name, data := readServerSentEvent(t, reader)
+	assert.Equal(t, "user_registered", name)
+	assert.Contains(t, data, `"name":"Stream User"`)
+
+	resp, _ = makeAuthedRequest(t, http.MethodPost, fmt.Sprintf("/users/%d/stamps", user.ID), user.AccessToken, map[string]interface{}{
+		"stamp_id": stamp.ID,
+		"token":    issueStampToken(t, stamp.ID),
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	name, data = readServerSentEvent(t, reader)
+	assert.Equal(t, "stamp_acquired", name)
+	assert.Contains(t, data, `"stamp_name":"Stream Stamp"`)
+
+	name, data = readServerSentEvent(t, reader)
+	assert.Equal(t, "leaderboard", name)
+	assert.Contains(t, data, `"stamp_count":1`)
+
+	t.Run("Unknown Event", func(t *testing.T) {
+		resp, _ := makeRequest(t, http.MethodGet, "/events/stream?event_id=999999", nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+// readServerSentEvent reads the next event from a text/event-stream, skipping keep-alive comments.
+func readServerSentEvent(t *testing.T, reader *bufio.Reader) (name, data string) {
+	t.Helper()
+
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimRight(line, "\n")
+		switch {
+		case line == "" && name != "":
+			return name, data
+		case strings.HasPrefix(line, "event:"):
+			name = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data += strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		}
+	}
+}
+
+func TestE2E_ListUsers(t *testing.T) {
+	skipIfCI(t)
+	skipIfServerNotAvailable(t)
+
+	// A fresh event keeps the participant list independent of other tests
+	startsAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	resp, body := makeAdminRequest(t, http.MethodPost, "/events", map[string]interface{}{
+		"name":      "E2E List Users Event",
+		"starts_at": startsAt,
+		"ends_at":   startsAt.Add(8 * time.Hour),
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var event Event
+	require.NoError(t, json.Unmarshal(body, &event))
+
+	resp, body = makeAdminRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/stamps", event.ID), map[string]string{
+		"name": "List Users Stamp",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var stamp Stamp
+	require.NoError(t, json.Unmarshal(body, &stamp))
+
+	// Registered in this order; only Carol has a stamp
+	names := []string{"Bob", "Alice", "Carol"}
+	twitterIDs := []string{"bob_go", "alice_gopher", "carol_go"}
+	users := make([]User, len(names))
+	for i := range users {
+		resp, body := makeRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/users", event.ID), map[string]string{
+			"name":       names[i],
+			"twitter_id": twitterIDs[i],
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.NoError(t, json.Unmarshal(body, &users[i]))
+	}
+	resp, _ = makeAuthedRequest(t, http.MethodPost, fmt.Sprintf("/users/%d/stamps", users[2].ID), users[2].AccessToken, map[string]interface{}{
+		"stamp_id": stamp.ID,
+		"token":    issueStampToken(t, stamp.ID),
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	listUsers := func(t *testing.T, query string) ([]User, int64) {
+		resp, body := makeRequest(t, http.MethodGet, fmt.Sprintf("/events/%d/users%s", event.ID, query), nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var users []User
+		require.NoError(t, json.Unmarshal(body, &users))
+		total, err := strconv.ParseInt(resp.Header.Get("X-Total-Count"), 10, 64)
+		require.NoError(t, err)
+		return users, total
+	}
+	namesOf := func(users []User) []string {
+		result := make([]string, len(users))
+		for i, u := range users {
+			result[i] = u.Name
+		}
+		return result
+	}
+
+	t.Run("Registration Order With Pagination", func(t *testing.T) {
+		page, total := listUsers(t, "?limit=2&offset=1")
+		assert.Equal(t, int64(3), total)
+		assert.Equal(t, []string{"Alice", "Carol"}, namesOf(page))
+	})
+
+	t.Run("Sort By Name", func(t *testing.T) {
+		page, _ := listUsers(t, "?sort=name&order=desc")
+		assert.Equal(t, []string{"Carol", "Bob", "Alice"}, namesOf(page))
+	})
+
+	t.Run("Sort By Stamp Count", func(t *testing.T) {
+		page, _ := listUsers(t, "?sort=stamp_count&limit=1")
+		assert.Equal(t, []string{"Carol"}, namesOf(page))
+	})
+
+	t.Run("Search By Name Or Twitter ID", func(t *testing.T) {
+		page, total := listUsers(t, "?q=%40alice")
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, []string{"Alice"}, namesOf(page))
+
+		page, total = listUsers(t, "?q=_go&sort=name")
+		assert.Equal(t, int64(2), total)
+		assert.Equal(t, []string{"Bob", "Carol"}, namesOf(page))
+	})
+
+	t.Run("Include Stamp Counts", func(t *testing.T) {
+		resp, body := makeRequest(t, http.MethodGet, fmt.Sprintf("/events/%d/users?q=carol&include_stamp_counts=true", event.ID), nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var users []struct {
+			ID       int64   `json:"id"`
+			StampIDs []int64 `json:"stamp_ids"`
+		}
+		require.NoError(t, json.Unmarshal(body, &users))
+		require.Len(t, users, 1)
+		assert.Equal(t, []int64{stamp.ID}, users[0].StampIDs)
+	})
+
+	t.Run("Invalid Sort", func(t *testing.T) {
+		resp, _ := makeRequest(t, http.MethodGet, fmt.Sprintf("/events/%d/users?sort=twitter_id", event.ID), nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Invalid Page", func(t *testing.T) {
+		for _, query := range []string{"limit=0", "limit=1001", "offset=-1"} {
+			resp, _ := makeRequest(t, http.MethodGet, fmt.Sprintf("/events/%d/users?%s", event.ID, query), nil)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode, query)
+		}
+	})
+}
+
+func TestE2E_UserIcon(t *testing.T) {
+	skipIfCI(t)
+	skipIfServerNotAvailable(t)
+
+	resp, body := makeRequest(t, http.MethodPost, "/users", map[string]string{"name": "Icon Test User"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var user User
+	require.NoError(t, json.Unmarshal(body, &user))
+
+	var img bytes.Buffer
+	require.NoError(t, png.Encode(&img, image.NewNRGBA(image.Rect(0, 0, 800, 600))))
+
+	var uploaded User
+	t.Run("Upload", func(t *testing.T) {
+		resp, body := uploadIcon(t, user.ID, user.AccessToken, img.Bytes())
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		require.NoError(t, json.Unmarshal(body, &uploaded))
+		require.NotNil(t, uploaded.Icon)
+		require.NotNil(t, uploaded.IconThumbnail)
+
+		for _, url := range []string{*uploaded.Icon, *uploaded.IconThumbnail} {
+			resp, err := http.Get(url)
+			require.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, http.StatusOK, resp.StatusCode, url)
+			assert.Equal(t, "image/png", resp.Header.Get("Content-Type"), url)
+		}
+	})
+
+	t.Run("Reject Non Image", func(t *testing.T) {
+		resp, body := uploadIcon(t, user.ID, user.AccessToken, []byte("<svg></svg>"))
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Contains(t, string(body), "INVALID_ICON")
+	})
+
+	t.Run("Inline Icon", func(t *testing.T) {
+		dataURL := "data:image/png;base64," + base64.StdEncoding.EncodeToString(img.Bytes())
+		resp, body := makeRequest(t, http.MethodPost, "/users", map[string]string{"name": "Inline Icon User", "icon": dataURL})
+		require.Equal(t, http.StatusCreated, resp.StatusCode, string(body))
+
+		var created User
+		require.NoError(t, json.Unmarshal(body, &created))
+		require.NotNil(t, created.Icon)
+		require.NotNil(t, created.IconThumbnail)
+		assert.NotContains(t, *created.Icon, "base64", "the stored icon must be a URL")
+
+		// Sending the current URL back keeps the icon
+		resp, body = makeAuthedRequest(t, http.MethodPut, fmt.Sprintf("/users/%d", created.ID), created.AccessToken, map[string]string{"name": "Renamed", "icon": *created.Icon})
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		var updated User
+		require.NoError(t, json.Unmarshal(body, &updated))
+		assert.Equal(t, created.Icon, updated.Icon)
+
+		for _, icon := range []string{"data:image/svg+xml;base64,PHN2Zy8+", "https://example.com/icon.png"} {
+			resp, body = makeAuthedRequest(t, http.MethodPut, fmt.Sprintf("/users/%d", created.ID), created.AccessToken, map[string]string{"icon": icon})
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode, icon)
+			assert.Contains(t, string(body), "INVALID_ICON", icon)
+		}
+	})
+
+	t.Run("Reject Other User", func(t *testing.T) {
+		resp, body := makeRequest(t, http.MethodPost, "/users", map[string]string{"name": "Icon Other User"})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var other User
+		require.NoError(t, json.Unmarshal(body, &other))
+
+		resp, _ = uploadIcon(t, user.ID, other.AccessToken, img.Bytes())
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("Deleted With User", func(t *testing.T) {
+		require.NotNil(t, uploaded.Icon)
+
+		resp, _ := makeAuthedRequest(t, http.MethodDelete, fmt.Sprintf("/users/%d", user.ID), user.AccessToken, nil)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp, err := http.Get(*uploaded.Icon)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestE2E_Webhooks(t *testing.T) {
+	skipIfCI(t)
+	skipIfServerNotAvailable(t)
+
+	resp, body := makeAdminRequest(t, http.MethodPost, "/events", map[string]string{"name": "E2E Webhook Event"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var event Event
+	require.NoError(t, json.Unmarshal(body, &event))
+	webhooksPath := fmt.Sprintf("/events/%d/webhooks", event.ID)
+
+	// Nothing listens on the port, so every delivery fails and is recorded with its error
+	resp, body = makeAdminRequest(t, http.MethodPost, webhooksPath, map[string]interface{}{
+		"url":         "http://127.0.0.1:1/hook",
+		"event_types": []string{"user.registered"},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var webhook Webhook
+	require.NoError(t, json.Unmarshal(body, &webhook))
+	assert.Equal(t, event.ID, webhook.EventID)
+	assert.Equal(t, []string{"user.registered"}, webhook.EventTypes)
+	require.NotNil(t, webhook.Secret, "the secret is returned on creation")
+	assert.Len(t, *webhook.Secret, 64)
+
+	t.Run("List Hides Secret", func(t *testing.T) {
+		resp, body := makeAdminRequest(t, http.MethodGet, webhooksPath, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var webhooks []Webhook
+		require.NoError(t, json.Unmarshal(body, &webhooks))
+		require.Len(t, webhooks, 1)
+		assert.Equal(t, webhook.ID, webhooks[0].ID)
+		assert.Nil(t, webhooks[0].Secret)
+	})
+
+	t.Run("Records Deliveries", func(t *testing.T) {
+		resp, _ := makeRequest(t, http.MethodPost, fmt.Sprintf("/events/%d/users", event.ID), map[string]string{"name": "Webhook User"})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		deliveriesPath := fmt.Sprintf("/webhooks/%d/deliveries", webhook.ID)
+		var deliveries []WebhookDelivery
+		require.Eventually(t, func() bool {
+			resp, body := makeAdminRequest(t, http.MethodGet, deliveriesPath, nil)
+			if resp.StatusCode != http.StatusOK {
+				return false
+			}
+			var result struct {
+				Deliveries []WebhookDelivery `json:"deliveries"`
+			}
+			if err := json.Unmarshal(body, &result); err != nil {
+				return false
+			}
+			deliveries = result.Deliveries
+			return len(deliveries) > 0
+		}, 10*time.Second, 100*time.Millisecond)
+
+		delivery := deliveries[0]
+		assert.Equal(t, "user.registered", delivery.EventType)
+		assert.Equal(t, 1, delivery.Attempt)
+		assert.False(t, delivery.Succeeded)
+		assert.Nil(t, delivery.StatusCode)
+		assert.Contains(t, delivery.Payload, `"user_name":"Webhook User"`)
+
+		resp, _ = makeAdminRequest(t, http.MethodGet, deliveriesPath+"?limit=1001", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Reject Invalid Webhook", func(t *testing.T) {
+		for _, req := range []map[string]interface{}{
+			{"url": "ftp://example.com/hook", "event_types": []string{"user.registered"}},
+			{"url": "https://example.com/hook", "event_types": []string{}},
+			{"url": "https://example.com/hook", "event_types": []string{"stamp.deleted"}},
+			{"url": "https://example.com/hook", "event_types": []string{"user.registered"}, "secret": "short"},
+		} {
+			resp, body := makeAdminRequest(t, http.MethodPost, webhooksPath, req)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode, req)
+			assert.Contains(t, string(body), "INVALID_REQUEST", req)
+		}
+	})
+
+	t.Run("Requires Admin Key", func(t *testing.T) {
+		resp, _ := makeRequest(t, http.MethodGet, webhooksPath, nil)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		path := fmt.Sprintf("/webhooks/%d", webhook.ID)
+		resp, _ := makeAdminRequest(t, http.MethodDelete, path, nil)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp, _ = makeAdminRequest(t, http.MethodDelete, path, nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp, _ = makeAdminRequest(t, http.MethodGet, path+"/deliveries", nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
 	})
 }
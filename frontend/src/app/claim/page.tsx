@@ -0,0 +1,77 @@
+"use client";
+
+import {Suspense, useEffect} from "react";
+import {useRouter, useSearchParams} from "next/navigation";
+import {useAtom} from "jotai";
+import {AppLayout} from "@/widgets/app-layout/ui/app-layout";
+import {Button} from "@/shared/ui/button";
+import {Card, CardContent, CardHeader, CardTitle} from "@/shared/ui/card";
+import {userProfileAtom} from "@/shared/store/atoms";
+
+// アクセストークンの導入前に登録した参加者が、運営スタッフから再発行を受けるページ。
+// スタッフは POST /users/{id}/token で発行したトークンを /claim?user_id={id}&token={token} のQRコードにして渡す
+function ClaimContent() {
+    const router = useRouter();
+    const searchParams = useSearchParams();
+    const [userProfile, setUserProfile] = useAtom(userProfileAtom);
+    const userId = searchParams.get("user_id");
+    const token = searchParams.get("token");
+    const isOwnToken = token !== null && userProfile !== null && userProfile.id === userId;
+
+    useEffect(() => {
+        if (!isOwnToken || !userProfile || token === null) return;
+
+        setUserProfile({...userProfile, accessToken: token});
+        router.replace("/stamps");
+    }, [isOwnToken, token, userProfile, setUserProfile, router]);
+
+    if (!userProfile) {
+        return (
+            <Card>
+                <CardHeader>
+                    <CardTitle>ユーザー情報が見つかりません</CardTitle>
+                </CardHeader>
+                <CardContent className="space-y-4">
+                    <p className="text-gray-600">先にユーザー登録を行ってください。</p>
+                    <Button onClick={() => router.push("/")} className="w-full">ホームへ戻る</Button>
+                </CardContent>
+            </Card>
+        );
+    }
+
+    if (isOwnToken) {
+        return <p className="text-center text-gray-600">登録情報を更新しています...</p>;
+    }
+
+    return (
+        <Card>
+            <CardHeader>
+                <CardTitle>受付でアクセストークンを受け取ってください</CardTitle>
+            </CardHeader>
+            <CardContent className="space-y-4">
+                {token !== null && (
+                    <p className="text-red-600 text-sm">読み取ったQRコードは別の参加者のものです。</p>
+                )}
+                <p className="text-gray-600">
+                    スタンプの取得やプロフィールの更新には、本人確認用のアクセストークンが必要です。
+                    受付のスタッフに次のユーザーIDを伝え、表示されたQRコードをこの端末で読み取ってください。
+                </p>
+                <p className="text-center text-3xl font-bold text-gray-800">{userProfile.id}</p>
+            </CardContent>
+        </Card>
+    );
+}
+
+export default function ClaimPage() {
+    return (
+        <AppLayout>
+            <div className="min-h-screen flex items-center justify-center p-4">
+                <div className="max-w-md w-full">
+                    <Suspense fallback={<p className="text-center text-gray-600">読み込み中...</p>}>
+                        <ClaimContent />
+                    </Suspense>
+                </div>
+            </div>
+        </AppLayout>
+    );
+}
@@ -34,6 +34,11 @@ export default function ProfilePage() {
             router.replace("/stamps");
             return;
         }
+        // アクセストークンがないとプロフィールを更新できないため再発行ページへ
+        if (!userProfile.accessToken) {
+            router.replace("/claim");
+            return;
+        }
 
         setNickname(userProfile.nickname);
         setTwitterId(userProfile.twitterId);
@@ -41,7 +46,7 @@ export default function ProfilePage() {
         setProfileImageUrl(imageUrl);
         setSelectedPoints(userProfile.favoriteGolangPoints);
         setImagePreview(imageUrl);
-        // 既存の画像がbase64（ファイルアップロード）の場合は、URL入力欄を非表示
+        // 既存の画像がbase64（ファイルアップロード）の場合は、アップロード済みとして表示
         setIsFileUploaded(imageUrl.startsWith("data:"));
     }, [userProfile, router]);
 
@@ -54,7 +59,7 @@ export default function ProfilePage() {
             const result = reader.result as string;
             setImagePreview(result);
             setProfileImageUrl(result);
-            setIsFileUploaded(true); // ファイルアップロード時はURL入力欄を非表示
+            setIsFileUploaded(true);
         };
         reader.readAsDataURL(file);
     }, []);
@@ -65,19 +70,6 @@ export default function ProfilePage() {
         );
     }, []);
 
-    const handleImageUrlChange = useCallback((e: React.ChangeEvent<HTMLInputElement>) => {
-        const url = e.target.value;
-        setProfileImageUrl(url);
-        // URLが空でない場合のみプレビューを更新
-        if (url) {
-            setImagePreview(url);
-        } else {
-            setImagePreview("");
-        }
-        // URLを入力した場合は、ファイルアップロード状態を解除
-        setIsFileUploaded(false);
-    }, []);
-
     const isValid = useMemo(() => {
         return nickname.trim().length > 0 && selectedPoints.length > 0;
     }, [nickname, selectedPoints]);
@@ -105,27 +97,17 @@ export default function ProfilePage() {
         setIsSubmitting(true);
 
         try {
-            // 画像の処理: URLの場合はそのまま保存、base64（ファイルアップロード）の場合はそのまま保存
-            let iconValue = "";
-            if (profileImageUrl && profileImageUrl.trim()) {
-                // http://またはhttps://で始まる場合はURLとしてそのまま保存
-                if (profileImageUrl.startsWith("http://") || profileImageUrl.startsWith("https://")) {
-                    iconValue = profileImageUrl.trim();
-                } else if (profileImageUrl.startsWith("data:")) {
-                    // base64（ファイルアップロード）の場合はそのまま保存
-                    iconValue = profileImageUrl;
-                } else {
-                    // その他の場合は既存の画像を保持
-                    iconValue = userProfile.profileImageUrl || "";
-                }
-            }
+            // 画像の処理: 新しく選択した画像はbase64で送信し、それ以外は既存の画像（サーバーのURL）を保持
+            const iconValue = profileImageUrl.startsWith("data:")
+                ? profileImageUrl
+                : userProfile.profileImageUrl || "";
 
             // TwitterID が空の場合は既存の値を維持（空文字で上書きしない）
             const sanitizedTwitter = twitterId.trim();
             const twitterToKeep = sanitizedTwitter === "" ? userProfile.twitterId : sanitizedTwitter;
 
             // LocalStorage用に保持している日本語ラベルはそのまま使い続ける
-            // LocalStorageにはURLまたはbase64画像を保存
+            // LocalStorageには画像のURLを保存（新しい画像は更新後にサーバーのURLに置き換える）
             const updated = {
                 ...userProfile,
                 nickname: nickname.trim(),
@@ -144,13 +126,15 @@ export default function ProfilePage() {
 
             const numericId = Number(userProfile.id);
             if (!Number.isNaN(numericId)) {
-                await updateUser(numericId, {
+                const apiUser = await updateUser(numericId, {
                     name: nickname.trim(),
                     // 空文字の場合は送信せず、既存の値を保持
                     twitter_id: sanitizedTwitter === "" ? undefined : sanitizedTwitter,
                     favorite_go_feature: favoriteGoFeature,
                     icon: iconValue || undefined,
                 });
+                // base64で送った画像はサーバーが保存したURLに置き換える
+                setUserProfile({...updated, profileImageUrl: apiUser.icon ?? ""});
             }
 
             setIsSubmitting(false);
@@ -249,18 +233,10 @@ export default function ProfilePage() {
                                     onChange={handleImageSelect}
                                 />
                             </div>
-                            {!isFileUploaded && (
-                                <Input
-                                    id="profileImageUrl"
-                                    placeholder="画像URL（オプション）"
-                                    value={profileImageUrl}
-                                    onChange={handleImageUrlChange}
-                                />
-                            )}
                             <p className="text-xs text-gray-500">
                                 {isFileUploaded
                                     ? "画像をアップロードしました。別の画像を選択する場合は、再度「画像を選択」ボタンをクリックしてください。"
-                                    : "ファイルを選択するか、画像のURLを入力してください"}
+                                    : "PNG・JPEG・WebP・GIF形式、5MBまでの画像を選択してください"}
                             </p>
                         </div>
 
@@ -28,6 +28,8 @@ export default function AcquireStampPage() {
   const searchParams = useSearchParams();
   const router = useRouter();
   const stampId = Number(params.id) || Number(searchParams.get("stamp_id"));
+  // QRコードに埋め込まれた署名付きトークン。スタンプ取得APIに渡す
+  const stampToken = searchParams.get("token") ?? undefined;
 
   const userProfile = useAtomValue(userProfileAtom);
   const addStamp = useSetAtom(addStampAtom);
@@ -401,6 +403,15 @@ export default function AcquireStampPage() {
       return;
     }
 
+    // アクセストークン導入前に登録した参加者は、再発行を受けるまでスタンプを取得できない
+    const accessToken = userProfile?.accessToken ?? (storedProfile ? JSON.parse(storedProfile).accessToken : undefined);
+    if (!accessToken) {
+      console.log('[ACQUIRE] No access token, redirecting to claim page');
+      hasExecuted.current = true;
+      router.replace("/claim");
+      return;
+    }
+
     // 実行済みフラグを立てる
     hasExecuted.current = true;
     console.log('[ACQUIRE] Starting acquisition process for stamp', stampId);
@@ -454,7 +465,7 @@ export default function AcquireStampPage() {
         const userIdNum = Number(userId);
         console.log(`[ACQUIRE] Calling API to acquire stamp ${stampId} for user ${userIdNum} (${userId})`);
 
-        await acquireStampApi(userIdNum, stampId);
+        await acquireStampApi(userIdNum, stampId, stampToken);
 
         // 4. ローカルストレージに追加
         console.log('[ACQUIRE] Adding to LocalStorage...');
@@ -506,7 +517,7 @@ export default function AcquireStampPage() {
       console.log('[ACQUIRE] Component unmounting, cleaning up...');
     };
     // eslint-disable-next-line react-hooks/exhaustive-deps
-  }, [stampId, isInitializing]); // stampIdとisInitializingを依存配列に含める
+  }, [stampId, stampToken, isInitializing]); // stampId・stampToken・isInitializingを依存配列に含める
 
   // 初期化中またはローディング中
   if (isInitializing || state === "loading" || state === "acquiring") {
@@ -1,12 +1,14 @@
 "use client";
 
 import { useEffect } from "react";
-import { useParams, useRouter } from "next/navigation";
+import { useParams, useRouter, useSearchParams } from "next/navigation";
 
 export default function ManageRedirectPage() {
   const params = useParams();
   const router = useRouter();
+  const searchParams = useSearchParams();
   const stampId = params.id;
+  const token = searchParams.get("token");
 
   useEffect(() => {
     if (stampId) {
@@ -15,10 +17,11 @@ export default function ManageRedirectPage() {
       if (typeof window !== 'undefined') {
         sessionStorage.setItem(`stamp_access_${stampId}`, 'true');
       }
-      // Redirect to acquire page without query parameters
-      router.replace(`/stamps/acquire/${stampId}`);
+      // Redirect to acquire page, keeping only the signed token from the QR code
+      const query = token ? `?token=${encodeURIComponent(token)}` : "";
+      router.replace(`/stamps/acquire/${stampId}${query}`);
     }
-  }, [stampId, router]);
+  }, [stampId, token, router]);
 
   return (
     <div className="min-h-screen flex items-center justify-center bg-gray-100">
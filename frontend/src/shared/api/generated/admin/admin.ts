@@ -0,0 +1,127 @@
+/**
+ * Generated by orval v7.17.0 🍺
+ * Do not edit manually.
+ * Gopher Stamp Rally User API
+ * API for managing users in the Gopher Stamp Rally application
+ * OpenAPI spec version: 1.0.0
+ */
+import {
+  useQuery
+} from '@tanstack/react-query';
+import type {
+  DataTag,
+  DefinedInitialDataOptions,
+  DefinedUseQueryResult,
+  QueryClient,
+  QueryFunction,
+  QueryKey,
+  UndefinedInitialDataOptions,
+  UseQueryOptions,
+  UseQueryResult
+} from '@tanstack/react-query';
+
+import type {
+  Error,
+  EventStats,
+  GetAdminStatsParams
+} from '../api.schemas';
+
+import { customInstance } from '../../mutator';
+
+
+
+
+/**
+ * 参加者数、スタンプごとの取得数、コンプリート率、1時間ごとのスタンプ取得数、コンプリートまでの時間の中央値を取得する
+ * @summary イベントの統計取得
+ */
+export const getAdminStats = (
+    params?: GetAdminStatsParams,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<EventStats>(
+      {url: `/admin/stats`, method: 'GET',
+        params, signal
+    },
+      );
+    }
+  
+
+
+
+export const getGetAdminStatsQueryKey = (params?: GetAdminStatsParams,) => {
+    return [
+    `/admin/stats`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getGetAdminStatsQueryOptions = <TData = Awaited<ReturnType<typeof getAdminStats>>, TError = Error>(params?: GetAdminStatsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getAdminStats>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getGetAdminStatsQueryKey(params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof getAdminStats>>> = ({ signal }) => getAdminStats(params, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof getAdminStats>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type GetAdminStatsQueryResult = NonNullable<Awaited<ReturnType<typeof getAdminStats>>>
+export type GetAdminStatsQueryError = Error
+
+
+export function useGetAdminStats<TData = Awaited<ReturnType<typeof getAdminStats>>, TError = Error>(
+ params: undefined |  GetAdminStatsParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof getAdminStats>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof getAdminStats>>,
+          TError,
+          Awaited<ReturnType<typeof getAdminStats>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useGetAdminStats<TData = Awaited<ReturnType<typeof getAdminStats>>, TError = Error>(
+ params?: GetAdminStatsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getAdminStats>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof getAdminStats>>,
+          TError,
+          Awaited<ReturnType<typeof getAdminStats>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useGetAdminStats<TData = Awaited<ReturnType<typeof getAdminStats>>, TError = Error>(
+ params?: GetAdminStatsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getAdminStats>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+/**
+ * @summary イベントの統計取得
+ */
+
+export function useGetAdminStats<TData = Awaited<ReturnType<typeof getAdminStats>>, TError = Error>(
+ params?: GetAdminStatsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getAdminStats>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getGetAdminStatsQueryOptions(params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
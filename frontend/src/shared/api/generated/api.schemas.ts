@@ -8,6 +8,8 @@
 export interface User {
   /** ユーザーID */
   id: number;
+  /** 参加しているイベントのID */
+  event_id: number;
   /**
    * ユーザー名
    * @maxLength 100
@@ -19,63 +21,76 @@ export interface User {
    */
   twitter_id?: string;
   /**
-   * 好きなGoの特徴
+   * 好きなGoの特徴（自由記述。選択肢から選んだ場合はそのコードのカンマ区切り）
    * @maxLength 500
    */
   favorite_go_feature?: string;
-  /**
-   * アイコン画像URL
-   * @maxLength 500
-   */
+  /** 好きなGoの特徴として選んだ選択肢のコード（表示順） */
+  go_features?: string[];
+  /** アイコン画像URL */
   icon?: string;
+  /** 一覧表示用のアイコン縮小画像URL */
+  icon_thumbnail?: string;
   /** 作成日時 */
   created_at?: string;
   /** 更新日時 */
   updated_at?: string;
 }
 
+export interface UserAccessToken {
+  /** ユーザー本人であることを示すBearerトークン。ユーザー更新・スタンプ取得時にAuthorizationヘッダーで送信する */
+  access_token: string;
+}
+
+export type UserCreateResponseAllOf = {
+  /** ユーザー本人であることを示すBearerトークン。ユーザー更新・スタンプ取得時にAuthorizationヘッダーで送信する */
+  access_token: string;
+};
+
+export type UserCreateResponse = User & UserCreateResponseAllOf;
+
 export interface UserCreateRequest {
   /**
-   * ユーザー名
+   * ユーザー名。前後の空白を除いて1〜100文字
+   * @minLength 1
    * @maxLength 100
    */
   name: string;
-  /**
-   * TwitterID
-   * @maxLength 50
-   */
+  /** TwitterID。英数字とアンダースコアの15文字まで。先頭の@は取り除いて保存する */
   twitter_id?: string;
   /**
-   * 好きなGoの特徴
+   * 好きなGoの特徴。500文字まで（改行などの制御文字は不可）。go_featuresを省略した場合は、含まれる選択肢のコード（カンマ区切り）を選択したものとして扱う
    * @maxLength 500
    */
   favorite_go_feature?: string;
+  /** 好きなGoの特徴として選ぶ選択肢のコード（GET /go-features）。選択肢にないコードは不正なリクエストとなる。favorite_go_featureを省略した場合は、コードのカンマ区切りがfavorite_go_featureにも設定される */
+  go_features?: string[];
   /**
-   * アイコン画像URL
-   * @maxLength 500
+   * アイコン画像（PNG・JPEG・WebP・GIF、5MBまで）のdata URLまたはbase64。
+   * 画像は検証後に正方形のアイコンとサムネイルに変換して保存され、ユーザーにはそのURLが設定される
    */
   icon?: string;
 }
 
 export interface UserUpdateRequest {
   /**
-   * ユーザー名
+   * ユーザー名。前後の空白を除いて1〜100文字
+   * @minLength 1
    * @maxLength 100
    */
   name?: string;
-  /**
-   * TwitterID
-   * @maxLength 50
-   */
+  /** TwitterID。英数字とアンダースコアの15文字まで。先頭の@は取り除いて保存する。空文字を送ると削除する */
   twitter_id?: string;
   /**
-   * 好きなGoの特徴
+   * 好きなGoの特徴。500文字まで（改行などの制御文字は不可）。空文字を送ると削除する。go_featuresを省略した場合は、含まれる選択肢のコード（カンマ区切り）を選択したものとして扱う
    * @maxLength 500
    */
   favorite_go_feature?: string;
+  /** 好きなGoの特徴として選ぶ選択肢のコード（GET /go-features）。選択肢にないコードは不正なリクエストとなる。空配列を送ると選択を解除する。favorite_go_featureを省略した場合は、コードのカンマ区切りがfavorite_go_featureにも設定される */
+  go_features?: string[];
   /**
-   * アイコン画像URL
-   * @maxLength 500
+   * アイコン画像（PNG・JPEG・WebP・GIF、5MBまで）のdata URLまたはbase64。
+   * 現在のiconのURLを送った場合は変更せず、空文字を送るとアイコンを削除する
    */
   icon?: string;
 }
@@ -90,27 +105,67 @@ export type UserDetail = User & UserDetailAllOf;
 export interface Stamp {
   /** スタンプID */
   id: number;
+  /** スタンプが属するイベントのID */
+  event_id: number;
   /**
    * スタンプ名
    * @maxLength 100
    */
   name: string;
+  /** この日時以降に取得可能（省略時は制限なし） */
+  available_from?: string;
+  /** この日時より前まで取得可能（省略時は制限なし） */
+  available_until?: string;
   /** 作成日時 */
   created_at?: string;
   /** 更新日時 */
   updated_at?: string;
 }
 
+export interface Event {
+  /** イベントID */
+  id: number;
+  /**
+   * イベント名
+   * @maxLength 100
+   */
+  name: string;
+  /** 開催開始日時。これより前はイベントのスタンプを取得できない（デフォルトイベントでは未設定） */
+  starts_at?: string;
+  /** 開催終了日時。これ以降はイベントのスタンプを取得できない（デフォルトイベントでは未設定） */
+  ends_at?: string;
+  /** 作成日時 */
+  created_at?: string;
+  /** 更新日時 */
+  updated_at?: string;
+}
+
+export interface EventCreateRequest {
+  /**
+   * イベント名
+   * @maxLength 100
+   */
+  name: string;
+  /** 開催開始日時 */
+  starts_at: string;
+  /** 開催終了日時（開始日時より後） */
+  ends_at: string;
+}
+
 export interface StampCreateRequest {
   /**
    * スタンプ名
    * @maxLength 100
    */
   name: string;
+  /** この日時以降に取得可能（省略時は制限なし） */
+  available_from?: string;
+  /** この日時より前まで取得可能（省略時は制限なし） */
+  available_until?: string;
 }
 
 /**
- * スタンプ更新リクエスト。
+ * スタンプ更新リクエスト。取得可能期間は指定した値で置き換えられる（省略すると制限なし）。
  */
 export interface StampUpdateRequest {
   /**
@@ -118,6 +173,10 @@ export interface StampUpdateRequest {
    * @maxLength 100
    */
   name?: string;
+  /** この日時以降に取得可能（省略時は制限なし） */
+  available_from?: string;
+  /** この日時より前まで取得可能（省略時は制限なし） */
+  available_until?: string;
 }
 
 export interface UserStamp {
@@ -129,11 +188,340 @@ export interface UserStamp {
   acquired_at?: string;
 }
 
+/**
+ * スタンプ取得リクエスト。tokenまたはcodeのいずれかが必要（codeが優先される）。
+ */
 export interface AcquireStampRequest {
   /** 取得するスタンプのID */
   stamp_id: number;
+  /** QRコードに埋め込まれたスタンプ取得トークン */
+  token?: string;
+  /** ブース画面に表示されたローテーションコード */
+  code?: string;
+}
+
+export interface StampCode {
+  /** スタンプID */
+  stamp_id: number;
+  /** 現在のローテーションコード */
+  code: string;
+  /** 次にコードが切り替わる日時 */
+  expires_at: string;
+}
+
+export interface StampToken {
+  /** スタンプID */
+  stamp_id: number;
+  /** スタンプ取得トークン */
+  token: string;
+  /** トークンの有効期限 */
+  expires_at: string;
+}
+
+export interface Leaderboard {
+  entries: LeaderboardEntry[];
+  /** イベントの参加者の総数 */
+  total: number;
+}
+
+export interface LeaderboardEntry {
+  /** 順位（1始まり） */
+  rank: number;
+  /** ユーザーID */
+  user_id: number;
+  /** ユーザー名 */
+  name: string;
+  /** 取得済みスタンプ数 */
+  stamp_count: number;
+  /** 最後にスタンプを取得した日時（未取得の場合は省略） */
+  last_acquired_at?: string;
+}
+
+export interface RewardGrantResult {
+  /** 新たに付与した景品の数 */
+  granted: number;
+}
+
+/**
+ * 景品の獲得条件
+ * - all: イベントの全スタンプ
+ * - count: 任意のrequired_count個のスタンプ
+ * - stamps: stamp_idsの全スタンプ
+ */
+export type RewardKind = typeof RewardKind[keyof typeof RewardKind];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const RewardKind = {
+  all: 'all',
+  count: 'count',
+  stamps: 'stamps',
+} as const;
+
+export interface RewardRule {
+  /** 景品ID */
+  id: number;
+  /** 景品が属するイベントのID */
+  event_id: number;
+  /**
+   * 景品名
+   * @maxLength 100
+   */
+  name: string;
+  kind: RewardKind;
+  /** 必要なスタンプ数（kindがcountの場合） */
+  required_count?: number;
+  /** 必要なスタンプのID（kindがstampsの場合） */
+  stamp_ids?: number[];
+  /** 作成日時 */
+  created_at?: string;
+  /** 更新日時 */
+  updated_at?: string;
+}
+
+export interface RewardRuleCreateRequest {
+  /**
+   * 景品名
+   * @maxLength 100
+   */
+  name: string;
+  kind: RewardKind;
+  /**
+   * 必要なスタンプ数（kindがcountの場合は必須、1以上）
+   * @minimum 1
+   */
+  required_count?: number;
+  /** 必要なスタンプのID（kindがstampsの場合は必須。同じイベントのスタンプに限る） */
+  stamp_ids?: number[];
+}
+
+export interface UserReward {
+  /** ユーザーID */
+  user_id: number;
+  /** 景品ID */
+  reward_id: number;
+  /** 景品名 */
+  name: string;
+  /** 景品の条件を満たした日時 */
+  earned_at: string;
+  /** 景品を受け渡した日時（未受け取りの場合は省略） */
+  redeemed_at?: string;
+}
+
+export interface GoFeature {
+  /** 選択肢のコード。ユーザーのgo_featuresで使用する */
+  code: string;
+  /** 表示名 */
+  label: string;
+}
+
+export interface GoFeatureCount {
+  /** 選択肢のコード */
+  code: string;
+  /** 表示名 */
+  label: string;
+  /** この特徴を選んだ参加者数 */
+  user_count: number;
+}
+
+export interface GoFeatureCounts {
+  /** 選んだ参加者の多い順（同数の場合は表示順）の全選択肢 */
+  go_features: GoFeatureCount[];
+  /** イベントの参加者の総数 */
+  participants: number;
+}
+
+export interface EventStats {
+  /** イベントID */
+  event_id: number;
+  /** 登録した参加者数 */
+  participants: number;
+  /** イベントの全スタンプを取得した参加者数 */
+  completed: number;
+  /** コンプリート率（completed / participants、参加者がいない場合は0） */
+  completion_rate: number;
+  /** 登録から最後のスタンプ取得までにかかった時間（秒）の、コンプリートした参加者での中央値。コンプリートした参加者がいない場合は省略 */
+  median_completion_seconds?: number;
+  /** スタンプごとの取得数（スタンプID順） */
+  stamps: StampStats[];
+  /** 1時間ごとのスタンプ取得数（時刻順、取得がなかった時間帯は省略） */
+  hourly_acquisitions: HourlyAcquisitions[];
+}
+
+export interface StampStats {
+  /** スタンプID */
+  stamp_id: number;
+  /** スタンプ名 */
+  name: string;
+  /** このスタンプを取得した参加者数 */
+  acquisitions: number;
+}
+
+export interface HourlyAcquisitions {
+  /** 集計した1時間の開始時刻 */
+  hour: string;
+  /** この1時間に取得されたスタンプ数 */
+  acquisitions: number;
+}
+
+export interface FeedUserRegistered {
+  /** ユーザーID */
+  user_id: number;
+  /** ユーザー名 */
+  name: string;
+  /** 一覧表示用のアイコン縮小画像URL */
+  icon_thumbnail?: string;
+  /** 登録日時 */
+  registered_at: string;
+}
+
+export interface FeedStampAcquired {
+  /** ユーザーID */
+  user_id: number;
+  /** ユーザー名 */
+  name: string;
+  /** 一覧表示用のアイコン縮小画像URL */
+  icon_thumbnail?: string;
+  /** スタンプID */
+  stamp_id: number;
+  /** スタンプ名 */
+  stamp_name: string;
+  /** スタンプ取得日時 */
+  acquired_at: string;
+}
+
+export interface FeedLeaderboardChange {
+  /** ユーザーID */
+  user_id: number;
+  /** ユーザー名 */
+  name: string;
+  /** 取得済みスタンプ数 */
+  stamp_count: number;
+  /** 最後にスタンプを取得した日時 */
+  last_acquired_at: string;
+}
+
+/**
+ * Webhookで通知する出来事の種別
+ * - user.registered: 参加者の登録
+ * - stamp.acquired: スタンプの取得
+ * - rally.completed: イベントの全スタンプの取得（stamp.acquiredに続いて送られる）
+ */
+export type WebhookEventType = typeof WebhookEventType[keyof typeof WebhookEventType];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const WebhookEventType = {
+  userregistered: 'user.registered',
+  stampacquired: 'stamp.acquired',
+  rallycompleted: 'rally.completed',
+} as const;
+
+export interface Webhook {
+  /** WebhookのID */
+  id: number;
+  /** 通知するイベントのID */
+  event_id: number;
+  /** 通知先のURL */
+  url: string;
+  /** 通知する出来事の種別 */
+  event_types: WebhookEventType[];
+  /** 署名用のシークレット（登録時の応答にのみ含まれる） */
+  secret?: string;
+  /** 登録日時 */
+  created_at: string;
+}
+
+export interface WebhookCreateRequest {
+  /**
+   * 通知先のURL（httpまたはhttps）
+   * @maxLength 2048
+   */
+  url: string;
+  /**
+   * 通知する出来事の種別（1つ以上）
+   * @minItems 1
+   */
+  event_types: WebhookEventType[];
+  /**
+   * 署名用のシークレット（16〜128文字。省略時は生成される）
+   * @minLength 16
+   * @maxLength 128
+   */
+  secret?: string;
 }
 
+export interface WebhookDelivery {
+  /** 配信履歴のID */
+  id: number;
+  /** WebhookのID */
+  webhook_id: number;
+  event_type: WebhookEventType;
+  /** 送信した本文（WebhookPayloadのJSON） */
+  payload: string;
+  /** 何回目の送信か（1から始まる） */
+  attempt: number;
+  /** 応答のHTTPステータスコード（応答がなかった場合は省略） */
+  status_code?: number;
+  /** 失敗の理由（成功した場合は省略） */
+  error?: string;
+  /** 2xxの応答を受け取ったか */
+  succeeded: boolean;
+  /** 送信日時 */
+  attempted_at: string;
+}
+
+/**
+ * Webhookに送られる本文。再送や複数のWebhookでもidは同じになる
+ */
+export interface WebhookPayload {
+  /** 通知のID（重複した通知の除外に使う） */
+  id: string;
+  type: WebhookEventType;
+  /** イベントID */
+  event_id: number;
+  /** ユーザーID */
+  user_id: number;
+  /** ユーザー名 */
+  user_name: string;
+  /** 取得したスタンプのID（stamp.acquired, rally.completed） */
+  stamp_id?: number;
+  /** 取得したスタンプ名（stamp.acquired, rally.completed） */
+  stamp_name?: string;
+  /** 取得済みスタンプ数（stamp.acquired, rally.completed） */
+  stamp_count?: number;
+  /** 出来事の日時 */
+  occurred_at: string;
+}
+
+/**
+ * ユーザー一覧の並び順のキー
+ * - created_at: 登録日時
+ * - name: 名前
+ * - stamp_count: 取得スタンプ数
+ */
+export type UserSort = typeof UserSort[keyof typeof UserSort];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const UserSort = {
+  created_at: 'created_at',
+  name: 'name',
+  stamp_count: 'stamp_count',
+} as const;
+
+/**
+ * 昇順（asc）または降順（desc）
+ */
+export type SortOrder = typeof SortOrder[keyof typeof SortOrder];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const SortOrder = {
+  asc: 'asc',
+  desc: 'desc',
+} as const;
+
 export interface Error {
   /** エラーコード */
   code: string;
@@ -143,6 +531,48 @@ export interface Error {
   details?: string;
 }
 
+export type ListUsersParams = {
+/**
+ * 取得する件数の上限
+ * @minimum 1
+ * @maximum 1000
+ */
+limit?: number;
+/**
+ * スキップする件数
+ * @minimum 0
+ */
+offset?: number;
+/**
+ * 並び順のキー。同じ値の場合はユーザーID順
+ */
+sort?: UserSort;
+/**
+ * 昇順・降順。省略時はstamp_countのみ降順、それ以外は昇順
+ */
+order?: SortOrder;
+/**
+ * 名前またはTwitter IDの部分一致で絞り込む（先頭の@は無視する）
+ */
+q?: string;
+/**
+ * trueの場合、各ユーザーの取得済みスタンプIDをstamp_idsに含める
+ */
+include_stamp_counts?: boolean;
+};
+
+export type ListUsers200ItemAllOf = {
+  /** 取得済みスタンプのID（include_stamp_counts=trueの場合のみ） */
+  stamp_ids?: number[];
+};
+
+export type ListUsers200Item = User & ListUsers200ItemAllOf;
+
+export type UploadUserIconBody = {
+  /** アイコン画像 */
+  icon: Blob;
+};
+
 export type ListStampsParams = {
 /**
  * 取得する件数の上限
@@ -167,3 +597,123 @@ export type ListUserStamps200 = {
   stamps?: UserStamp[];
 };
 
+export type ListUserRewards200 = {
+  rewards?: UserReward[];
+};
+
+export type GetLeaderboardParams = {
+/**
+ * 取得する件数の上限
+ * @minimum 1
+ * @maximum 1000
+ */
+limit?: number;
+/**
+ * スキップする件数
+ * @minimum 0
+ */
+offset?: number;
+};
+
+export type ListGoFeatures200 = {
+  go_features: GoFeature[];
+};
+
+export type StreamEventsParams = {
+/**
+ * 配信するイベントのID（省略時はデフォルトイベント）
+ */
+event_id?: number;
+};
+
+export type GetEventLeaderboardParams = {
+/**
+ * 取得する件数の上限
+ * @minimum 1
+ * @maximum 1000
+ */
+limit?: number;
+/**
+ * スキップする件数
+ * @minimum 0
+ */
+offset?: number;
+};
+
+export type ListEventStampsParams = {
+/**
+ * 取得する件数の上限
+ * @minimum 1
+ * @maximum 1000
+ */
+limit?: number;
+/**
+ * スキップする件数
+ * @minimum 0
+ */
+offset?: number;
+};
+
+export type ListEventStamps200 = {
+  stamps?: Stamp[];
+  /** 総件数 */
+  total?: number;
+};
+
+export type ListEventUsersParams = {
+/**
+ * 取得する件数の上限
+ * @minimum 1
+ * @maximum 1000
+ */
+limit?: number;
+/**
+ * スキップする件数
+ * @minimum 0
+ */
+offset?: number;
+/**
+ * 並び順のキー。同じ値の場合はユーザーID順
+ */
+sort?: UserSort;
+/**
+ * 昇順・降順。省略時はstamp_countのみ降順、それ以外は昇順
+ */
+order?: SortOrder;
+/**
+ * 名前またはTwitter IDの部分一致で絞り込む（先頭の@は無視する）
+ */
+q?: string;
+/**
+ * trueの場合、各ユーザーの取得済みスタンプIDをstamp_idsに含める
+ */
+include_stamp_counts?: boolean;
+};
+
+export type ListEventUsers200ItemAllOf = {
+  /** 取得済みスタンプのID（include_stamp_counts=trueの場合のみ） */
+  stamp_ids?: number[];
+};
+
+export type ListEventUsers200Item = User & ListEventUsers200ItemAllOf;
+
+export type GetAdminStatsParams = {
+/**
+ * 集計するイベントのID（省略時はデフォルトイベント）
+ */
+event_id?: number;
+};
+
+export type ListWebhookDeliveriesParams = {
+/**
+ * 取得する件数の上限
+ * @minimum 1
+ * @maximum 1000
+ */
+limit?: number;
+};
+
+export type ListWebhookDeliveries200 = {
+  deliveries: WebhookDelivery[];
+};
+
@@ -0,0 +1,132 @@
+/**
+ * Generated by orval v7.17.0 🍺
+ * Do not edit manually.
+ * Gopher Stamp Rally User API
+ * API for managing users in the Gopher Stamp Rally application
+ * OpenAPI spec version: 1.0.0
+ */
+import {
+  useQuery
+} from '@tanstack/react-query';
+import type {
+  DataTag,
+  DefinedInitialDataOptions,
+  DefinedUseQueryResult,
+  QueryClient,
+  QueryFunction,
+  QueryKey,
+  UndefinedInitialDataOptions,
+  UseQueryOptions,
+  UseQueryResult
+} from '@tanstack/react-query';
+
+import type {
+  Error,
+  StreamEventsParams
+} from '../api.schemas';
+
+import { customInstance } from '../../mutator';
+
+
+
+
+/**
+ * イベントで起きたことをServer-Sent Eventsで配信する。会場の画面や参加者一覧はポーリングせずに更新できる。
+ * - user_registered: 参加者が登録した（dataはFeedUserRegistered）
+ * - stamp_acquired: 参加者がスタンプを取得した（dataはFeedStampAcquired）
+ * - leaderboard: スタンプ取得で参加者の取得スタンプ数が変わった（dataはFeedLeaderboardChange）
+ * - resync: 配信に追いつけなかったため接続を終了する。クライアントは表示中のデータを取得し直してから再接続する
+ * 
+ * 接続を保つため、一定間隔でコメント行（": keep-alive"）を送信する
+ * @summary スタンプラリーのリアルタイム配信
+ */
+export const streamEvents = (
+    params?: StreamEventsParams,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<string>(
+      {url: `/events/stream`, method: 'GET',
+        params, signal
+    },
+      );
+    }
+  
+
+
+
+export const getStreamEventsQueryKey = (params?: StreamEventsParams,) => {
+    return [
+    `/events/stream`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getStreamEventsQueryOptions = <TData = Awaited<ReturnType<typeof streamEvents>>, TError = Error>(params?: StreamEventsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof streamEvents>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getStreamEventsQueryKey(params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof streamEvents>>> = ({ signal }) => streamEvents(params, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof streamEvents>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type StreamEventsQueryResult = NonNullable<Awaited<ReturnType<typeof streamEvents>>>
+export type StreamEventsQueryError = Error
+
+
+export function useStreamEvents<TData = Awaited<ReturnType<typeof streamEvents>>, TError = Error>(
+ params: undefined |  StreamEventsParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof streamEvents>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof streamEvents>>,
+          TError,
+          Awaited<ReturnType<typeof streamEvents>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useStreamEvents<TData = Awaited<ReturnType<typeof streamEvents>>, TError = Error>(
+ params?: StreamEventsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof streamEvents>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof streamEvents>>,
+          TError,
+          Awaited<ReturnType<typeof streamEvents>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useStreamEvents<TData = Awaited<ReturnType<typeof streamEvents>>, TError = Error>(
+ params?: StreamEventsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof streamEvents>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+/**
+ * @summary スタンプラリーのリアルタイム配信
+ */
+
+export function useStreamEvents<TData = Awaited<ReturnType<typeof streamEvents>>, TError = Error>(
+ params?: StreamEventsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof streamEvents>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getStreamEventsQueryOptions(params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
@@ -0,0 +1,312 @@
+/**
+ * Generated by orval v7.17.0 🍺
+ * Do not edit manually.
+ * Gopher Stamp Rally User API
+ * API for managing users in the Gopher Stamp Rally application
+ * OpenAPI spec version: 1.0.0
+ */
+import {
+  useQuery
+} from '@tanstack/react-query';
+import type {
+  DataTag,
+  DefinedInitialDataOptions,
+  DefinedUseQueryResult,
+  QueryClient,
+  QueryFunction,
+  QueryKey,
+  UndefinedInitialDataOptions,
+  UseQueryOptions,
+  UseQueryResult
+} from '@tanstack/react-query';
+
+import type {
+  Error,
+  GoFeatureCounts,
+  ListGoFeatures200
+} from '../api.schemas';
+
+import { customInstance } from '../../mutator';
+
+
+
+
+/**
+ * ユーザー作成・更新時にgo_featuresで選択できるGoの特徴を表示順に取得する
+ * @summary 好きなGoの特徴の選択肢一覧取得
+ */
+export const listGoFeatures = (
+    
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<ListGoFeatures200>(
+      {url: `/go-features`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getListGoFeaturesQueryKey = () => {
+    return [
+    `/go-features`
+    ] as const;
+    }
+
+    
+export const getListGoFeaturesQueryOptions = <TData = Awaited<ReturnType<typeof listGoFeatures>>, TError = Error>( options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listGoFeatures>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getListGoFeaturesQueryKey();
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof listGoFeatures>>> = ({ signal }) => listGoFeatures(signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof listGoFeatures>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type ListGoFeaturesQueryResult = NonNullable<Awaited<ReturnType<typeof listGoFeatures>>>
+export type ListGoFeaturesQueryError = Error
+
+
+export function useListGoFeatures<TData = Awaited<ReturnType<typeof listGoFeatures>>, TError = Error>(
+  options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof listGoFeatures>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof listGoFeatures>>,
+          TError,
+          Awaited<ReturnType<typeof listGoFeatures>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useListGoFeatures<TData = Awaited<ReturnType<typeof listGoFeatures>>, TError = Error>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listGoFeatures>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof listGoFeatures>>,
+          TError,
+          Awaited<ReturnType<typeof listGoFeatures>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useListGoFeatures<TData = Awaited<ReturnType<typeof listGoFeatures>>, TError = Error>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listGoFeatures>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+/**
+ * @summary 好きなGoの特徴の選択肢一覧取得
+ */
+
+export function useListGoFeatures<TData = Awaited<ReturnType<typeof listGoFeatures>>, TError = Error>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listGoFeatures>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getListGoFeaturesQueryOptions(options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+/**
+ * デフォルトイベント（ID 1）で各Goの特徴を選んだ参加者数を、多い順に取得する。他のイベントは /events/{event_id}/go-features/counts を使用する
+ * @summary 好きなGoの特徴の集計取得
+ */
+export const getGoFeatureCounts = (
+    
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<GoFeatureCounts>(
+      {url: `/go-features/counts`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getGetGoFeatureCountsQueryKey = () => {
+    return [
+    `/go-features/counts`
+    ] as const;
+    }
+
+    
+export const getGetGoFeatureCountsQueryOptions = <TData = Awaited<ReturnType<typeof getGoFeatureCounts>>, TError = Error>( options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getGoFeatureCounts>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getGetGoFeatureCountsQueryKey();
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof getGoFeatureCounts>>> = ({ signal }) => getGoFeatureCounts(signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof getGoFeatureCounts>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type GetGoFeatureCountsQueryResult = NonNullable<Awaited<ReturnType<typeof getGoFeatureCounts>>>
+export type GetGoFeatureCountsQueryError = Error
+
+
+export function useGetGoFeatureCounts<TData = Awaited<ReturnType<typeof getGoFeatureCounts>>, TError = Error>(
+  options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof getGoFeatureCounts>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof getGoFeatureCounts>>,
+          TError,
+          Awaited<ReturnType<typeof getGoFeatureCounts>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useGetGoFeatureCounts<TData = Awaited<ReturnType<typeof getGoFeatureCounts>>, TError = Error>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getGoFeatureCounts>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof getGoFeatureCounts>>,
+          TError,
+          Awaited<ReturnType<typeof getGoFeatureCounts>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useGetGoFeatureCounts<TData = Awaited<ReturnType<typeof getGoFeatureCounts>>, TError = Error>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getGoFeatureCounts>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+/**
+ * @summary 好きなGoの特徴の集計取得
+ */
+
+export function useGetGoFeatureCounts<TData = Awaited<ReturnType<typeof getGoFeatureCounts>>, TError = Error>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getGoFeatureCounts>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getGetGoFeatureCountsQueryOptions(options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+/**
+ * 指定されたイベントで各Goの特徴を選んだ参加者数を、多い順に取得する。誰も選んでいない特徴も0件として含む
+ * @summary イベントの好きなGoの特徴の集計取得
+ */
+export const getEventGoFeatureCounts = (
+    eventId: number,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<GoFeatureCounts>(
+      {url: `/events/${eventId}/go-features/counts`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getGetEventGoFeatureCountsQueryKey = (eventId?: number,) => {
+    return [
+    `/events/${eventId}/go-features/counts`
+    ] as const;
+    }
+
+    
+export const getGetEventGoFeatureCountsQueryOptions = <TData = Awaited<ReturnType<typeof getEventGoFeatureCounts>>, TError = Error>(eventId: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getEventGoFeatureCounts>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getGetEventGoFeatureCountsQueryKey(eventId);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof getEventGoFeatureCounts>>> = ({ signal }) => getEventGoFeatureCounts(eventId, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(eventId), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof getEventGoFeatureCounts>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type GetEventGoFeatureCountsQueryResult = NonNullable<Awaited<ReturnType<typeof getEventGoFeatureCounts>>>
+export type GetEventGoFeatureCountsQueryError = Error
+
+
+export function useGetEventGoFeatureCounts<TData = Awaited<ReturnType<typeof getEventGoFeatureCounts>>, TError = Error>(
+ eventId: number, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof getEventGoFeatureCounts>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof getEventGoFeatureCounts>>,
+          TError,
+          Awaited<ReturnType<typeof getEventGoFeatureCounts>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useGetEventGoFeatureCounts<TData = Awaited<ReturnType<typeof getEventGoFeatureCounts>>, TError = Error>(
+ eventId: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getEventGoFeatureCounts>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof getEventGoFeatureCounts>>,
+          TError,
+          Awaited<ReturnType<typeof getEventGoFeatureCounts>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useGetEventGoFeatureCounts<TData = Awaited<ReturnType<typeof getEventGoFeatureCounts>>, TError = Error>(
+ eventId: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getEventGoFeatureCounts>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+/**
+ * @summary イベントの好きなGoの特徴の集計取得
+ */
+
+export function useGetEventGoFeatureCounts<TData = Awaited<ReturnType<typeof getEventGoFeatureCounts>>, TError = Error>(
+ eventId: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getEventGoFeatureCounts>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getGetEventGoFeatureCountsQueryOptions(eventId,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
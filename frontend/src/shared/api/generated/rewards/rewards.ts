@@ -0,0 +1,420 @@
+/**
+ * Generated by orval v7.17.0 🍺
+ * Do not edit manually.
+ * Gopher Stamp Rally User API
+ * API for managing users in the Gopher Stamp Rally application
+ * OpenAPI spec version: 1.0.0
+ */
+import {
+  useMutation,
+  useQuery
+} from '@tanstack/react-query';
+import type {
+  DataTag,
+  DefinedInitialDataOptions,
+  DefinedUseQueryResult,
+  MutationFunction,
+  QueryClient,
+  QueryFunction,
+  QueryKey,
+  UndefinedInitialDataOptions,
+  UseMutationOptions,
+  UseMutationResult,
+  UseQueryOptions,
+  UseQueryResult
+} from '@tanstack/react-query';
+
+import type {
+  Error,
+  ListUserRewards200,
+  RewardGrantResult,
+  RewardRule,
+  RewardRuleCreateRequest,
+  UserReward
+} from '../api.schemas';
+
+import { customInstance } from '../../mutator';
+
+
+
+
+/**
+ * 指定されたユーザーが獲得した景品と受け取り状況を取得する。景品の条件はスタンプ取得のたびに判定され、後から追加した景品は POST /events/{event_id}/rewards/grant で付与する
+ * @summary ユーザーの獲得済み景品一覧取得
+ */
+export const listUserRewards = (
+    id: number,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<ListUserRewards200>(
+      {url: `/users/${id}/rewards`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getListUserRewardsQueryKey = (id?: number,) => {
+    return [
+    `/users/${id}/rewards`
+    ] as const;
+    }
+
+    
+export const getListUserRewardsQueryOptions = <TData = Awaited<ReturnType<typeof listUserRewards>>, TError = Error>(id: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listUserRewards>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getListUserRewardsQueryKey(id);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof listUserRewards>>> = ({ signal }) => listUserRewards(id, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(id), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof listUserRewards>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type ListUserRewardsQueryResult = NonNullable<Awaited<ReturnType<typeof listUserRewards>>>
+export type ListUserRewardsQueryError = Error
+
+
+export function useListUserRewards<TData = Awaited<ReturnType<typeof listUserRewards>>, TError = Error>(
+ id: number, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof listUserRewards>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof listUserRewards>>,
+          TError,
+          Awaited<ReturnType<typeof listUserRewards>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useListUserRewards<TData = Awaited<ReturnType<typeof listUserRewards>>, TError = Error>(
+ id: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listUserRewards>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof listUserRewards>>,
+          TError,
+          Awaited<ReturnType<typeof listUserRewards>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useListUserRewards<TData = Awaited<ReturnType<typeof listUserRewards>>, TError = Error>(
+ id: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listUserRewards>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+/**
+ * @summary ユーザーの獲得済み景品一覧取得
+ */
+
+export function useListUserRewards<TData = Awaited<ReturnType<typeof listUserRewards>>, TError = Error>(
+ id: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listUserRewards>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getListUserRewardsQueryOptions(id,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+/**
+ * 運営スタッフが景品を渡したことを記録する。1つの景品は1回だけ受け渡しできる
+ * @summary 景品の受け渡し
+ */
+export const redeemUserReward = (
+    id: number,
+    rewardId: number,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<UserReward>(
+      {url: `/users/${id}/rewards/${rewardId}/redeem`, method: 'POST', signal
+    },
+      );
+    }
+  
+
+
+export const getRedeemUserRewardMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof redeemUserReward>>, TError,{id: number;rewardId: number}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof redeemUserReward>>, TError,{id: number;rewardId: number}, TContext> => {
+
+const mutationKey = ['redeemUserReward'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof redeemUserReward>>, {id: number;rewardId: number}> = (props) => {
+          const {id,rewardId} = props ?? {};
+
+          return  redeemUserReward(id,rewardId,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type RedeemUserRewardMutationResult = NonNullable<Awaited<ReturnType<typeof redeemUserReward>>>
+    
+    export type RedeemUserRewardMutationError = Error
+
+    /**
+ * @summary 景品の受け渡し
+ */
+export const useRedeemUserReward = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof redeemUserReward>>, TError,{id: number;rewardId: number}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof redeemUserReward>>,
+        TError,
+        {id: number;rewardId: number},
+        TContext
+      > => {
+
+      const mutationOptions = getRedeemUserRewardMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * 指定されたイベントの景品と獲得条件を取得する
+ * @summary イベントの景品一覧取得
+ */
+export const listEventRewards = (
+    eventId: number,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<RewardRule[]>(
+      {url: `/events/${eventId}/rewards`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getListEventRewardsQueryKey = (eventId?: number,) => {
+    return [
+    `/events/${eventId}/rewards`
+    ] as const;
+    }
+
+    
+export const getListEventRewardsQueryOptions = <TData = Awaited<ReturnType<typeof listEventRewards>>, TError = Error>(eventId: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventRewards>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getListEventRewardsQueryKey(eventId);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof listEventRewards>>> = ({ signal }) => listEventRewards(eventId, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(eventId), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof listEventRewards>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type ListEventRewardsQueryResult = NonNullable<Awaited<ReturnType<typeof listEventRewards>>>
+export type ListEventRewardsQueryError = Error
+
+
+export function useListEventRewards<TData = Awaited<ReturnType<typeof listEventRewards>>, TError = Error>(
+ eventId: number, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventRewards>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof listEventRewards>>,
+          TError,
+          Awaited<ReturnType<typeof listEventRewards>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useListEventRewards<TData = Awaited<ReturnType<typeof listEventRewards>>, TError = Error>(
+ eventId: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventRewards>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof listEventRewards>>,
+          TError,
+          Awaited<ReturnType<typeof listEventRewards>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useListEventRewards<TData = Awaited<ReturnType<typeof listEventRewards>>, TError = Error>(
+ eventId: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventRewards>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+/**
+ * @summary イベントの景品一覧取得
+ */
+
+export function useListEventRewards<TData = Awaited<ReturnType<typeof listEventRewards>>, TError = Error>(
+ eventId: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventRewards>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getListEventRewardsQueryOptions(eventId,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+/**
+ * 指定されたイベントに景品と獲得条件を作成する
+ * @summary イベントの景品作成
+ */
+export const createEventReward = (
+    eventId: number,
+    rewardRuleCreateRequest: RewardRuleCreateRequest,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<RewardRule>(
+      {url: `/events/${eventId}/rewards`, method: 'POST',
+      headers: {'Content-Type': 'application/json', },
+      data: rewardRuleCreateRequest, signal
+    },
+      );
+    }
+  
+
+
+export const getCreateEventRewardMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof createEventReward>>, TError,{eventId: number;data: RewardRuleCreateRequest}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof createEventReward>>, TError,{eventId: number;data: RewardRuleCreateRequest}, TContext> => {
+
+const mutationKey = ['createEventReward'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof createEventReward>>, {eventId: number;data: RewardRuleCreateRequest}> = (props) => {
+          const {eventId,data} = props ?? {};
+
+          return  createEventReward(eventId,data,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type CreateEventRewardMutationResult = NonNullable<Awaited<ReturnType<typeof createEventReward>>>
+    export type CreateEventRewardMutationBody = RewardRuleCreateRequest
+    export type CreateEventRewardMutationError = Error
+
+    /**
+ * @summary イベントの景品作成
+ */
+export const useCreateEventReward = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof createEventReward>>, TError,{eventId: number;data: RewardRuleCreateRequest}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof createEventReward>>,
+        TError,
+        {eventId: number;data: RewardRuleCreateRequest},
+        TContext
+      > => {
+
+      const mutationOptions = getCreateEventRewardMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * 条件を満たしているのに景品を獲得していない参加者に景品を付与する。景品を追加する前に条件を満たしていた参加者は、景品の追加後にこの操作を行うまで景品を獲得しない。何度実行しても同じ景品が重複して付与されることはない
+ * @summary イベントの景品の一括付与
+ */
+export const grantEventRewards = (
+    eventId: number,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<RewardGrantResult>(
+      {url: `/events/${eventId}/rewards/grant`, method: 'POST', signal
+    },
+      );
+    }
+  
+
+
+export const getGrantEventRewardsMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof grantEventRewards>>, TError,{eventId: number}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof grantEventRewards>>, TError,{eventId: number}, TContext> => {
+
+const mutationKey = ['grantEventRewards'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof grantEventRewards>>, {eventId: number}> = (props) => {
+          const {eventId} = props ?? {};
+
+          return  grantEventRewards(eventId,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type GrantEventRewardsMutationResult = NonNullable<Awaited<ReturnType<typeof grantEventRewards>>>
+    
+    export type GrantEventRewardsMutationError = Error
+
+    /**
+ * @summary イベントの景品の一括付与
+ */
+export const useGrantEventRewards = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof grantEventRewards>>, TError,{eventId: number}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof grantEventRewards>>,
+        TError,
+        {eventId: number},
+        TContext
+      > => {
+
+      const mutationOptions = getGrantEventRewardsMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    
\ No newline at end of file
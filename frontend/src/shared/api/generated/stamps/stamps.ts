@@ -26,10 +26,14 @@ import type {
 
 import type {
   Error,
+  ListEventStamps200,
+  ListEventStampsParams,
   ListStamps200,
   ListStampsParams,
   Stamp,
+  StampCode,
   StampCreateRequest,
+  StampToken,
   StampUpdateRequest
 } from '../api.schemas';
 
@@ -39,7 +43,7 @@ import { customInstance } from '../../mutator';
 
 
 /**
- * 全てのスタンプマスターデータを取得する
+ * デフォルトイベント（ID 1）の全てのスタンプマスターデータを取得する。他のイベントは /events/{event_id}/stamps を使用する
  * @summary スタンプ一覧取得
  */
 export const listStamps = (
@@ -133,7 +137,7 @@ export function useListStamps<TData = Awaited<ReturnType<typeof listStamps>>, TE
 
 
 /**
- * 新しいスタンプマスターデータを作成する
+ * デフォルトイベント（ID 1）に新しいスタンプマスターデータを作成する。他のイベントは /events/{event_id}/stamps を使用する
  * @summary スタンプ作成
  */
 export const createStamp = (
@@ -417,4 +421,359 @@ export const useDeleteStamp = <TError = Error,
 
       return useMutation(mutationOptions, queryClient);
     }
+    /**
+ * ブースのスタッフ画面に表示する、一定時間ごとに切り替わるスタンプ取得コードを取得する。
+ * スタンプ取得時は現在および直前のコードのみ受け付ける。
+ * @summary スタンプのローテーションコード取得
+ */
+export const getStampCode = (
+    id: number,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<StampCode>(
+      {url: `/stamps/${id}/code`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getGetStampCodeQueryKey = (id?: number,) => {
+    return [
+    `/stamps/${id}/code`
+    ] as const;
+    }
+
+    
+export const getGetStampCodeQueryOptions = <TData = Awaited<ReturnType<typeof getStampCode>>, TError = Error>(id: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getStampCode>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getGetStampCodeQueryKey(id);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof getStampCode>>> = ({ signal }) => getStampCode(id, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(id), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof getStampCode>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type GetStampCodeQueryResult = NonNullable<Awaited<ReturnType<typeof getStampCode>>>
+export type GetStampCodeQueryError = Error
+
+
+export function useGetStampCode<TData = Awaited<ReturnType<typeof getStampCode>>, TError = Error>(
+ id: number, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof getStampCode>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof getStampCode>>,
+          TError,
+          Awaited<ReturnType<typeof getStampCode>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useGetStampCode<TData = Awaited<ReturnType<typeof getStampCode>>, TError = Error>(
+ id: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getStampCode>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof getStampCode>>,
+          TError,
+          Awaited<ReturnType<typeof getStampCode>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useGetStampCode<TData = Awaited<ReturnType<typeof getStampCode>>, TError = Error>(
+ id: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getStampCode>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+/**
+ * @summary スタンプのローテーションコード取得
+ */
+
+export function useGetStampCode<TData = Awaited<ReturnType<typeof getStampCode>>, TError = Error>(
+ id: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getStampCode>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getGetStampCodeQueryOptions(id,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+/**
+ * スタンプのQRコードに埋め込む署名付きトークンを発行する。
+ * トークンは有効期限付きで、スタンプ取得時に必須となる。
+ * @summary スタンプ取得トークン発行
+ */
+export const issueStampToken = (
+    id: number,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<StampToken>(
+      {url: `/stamps/${id}/token`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getIssueStampTokenQueryKey = (id?: number,) => {
+    return [
+    `/stamps/${id}/token`
+    ] as const;
+    }
+
+    
+export const getIssueStampTokenQueryOptions = <TData = Awaited<ReturnType<typeof issueStampToken>>, TError = Error>(id: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof issueStampToken>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getIssueStampTokenQueryKey(id);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof issueStampToken>>> = ({ signal }) => issueStampToken(id, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(id), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof issueStampToken>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type IssueStampTokenQueryResult = NonNullable<Awaited<ReturnType<typeof issueStampToken>>>
+export type IssueStampTokenQueryError = Error
+
+
+export function useIssueStampToken<TData = Awaited<ReturnType<typeof issueStampToken>>, TError = Error>(
+ id: number, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof issueStampToken>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof issueStampToken>>,
+          TError,
+          Awaited<ReturnType<typeof issueStampToken>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useIssueStampToken<TData = Awaited<ReturnType<typeof issueStampToken>>, TError = Error>(
+ id: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof issueStampToken>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof issueStampToken>>,
+          TError,
+          Awaited<ReturnType<typeof issueStampToken>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useIssueStampToken<TData = Awaited<ReturnType<typeof issueStampToken>>, TError = Error>(
+ id: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof issueStampToken>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+/**
+ * @summary スタンプ取得トークン発行
+ */
+
+export function useIssueStampToken<TData = Awaited<ReturnType<typeof issueStampToken>>, TError = Error>(
+ id: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof issueStampToken>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getIssueStampTokenQueryOptions(id,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+/**
+ * 指定されたイベントのスタンプマスターデータを取得する
+ * @summary イベントのスタンプ一覧取得
+ */
+export const listEventStamps = (
+    eventId: number,
+    params?: ListEventStampsParams,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<ListEventStamps200>(
+      {url: `/events/${eventId}/stamps`, method: 'GET',
+        params, signal
+    },
+      );
+    }
+  
+
+
+
+export const getListEventStampsQueryKey = (eventId?: number,
+    params?: ListEventStampsParams,) => {
+    return [
+    `/events/${eventId}/stamps`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getListEventStampsQueryOptions = <TData = Awaited<ReturnType<typeof listEventStamps>>, TError = Error>(eventId: number,
+    params?: ListEventStampsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventStamps>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getListEventStampsQueryKey(eventId,params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof listEventStamps>>> = ({ signal }) => listEventStamps(eventId, params, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(eventId), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof listEventStamps>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type ListEventStampsQueryResult = NonNullable<Awaited<ReturnType<typeof listEventStamps>>>
+export type ListEventStampsQueryError = Error
+
+
+export function useListEventStamps<TData = Awaited<ReturnType<typeof listEventStamps>>, TError = Error>(
+ eventId: number,
+    params: undefined |  ListEventStampsParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventStamps>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof listEventStamps>>,
+          TError,
+          Awaited<ReturnType<typeof listEventStamps>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useListEventStamps<TData = Awaited<ReturnType<typeof listEventStamps>>, TError = Error>(
+ eventId: number,
+    params?: ListEventStampsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventStamps>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof listEventStamps>>,
+          TError,
+          Awaited<ReturnType<typeof listEventStamps>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useListEventStamps<TData = Awaited<ReturnType<typeof listEventStamps>>, TError = Error>(
+ eventId: number,
+    params?: ListEventStampsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventStamps>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+/**
+ * @summary イベントのスタンプ一覧取得
+ */
+
+export function useListEventStamps<TData = Awaited<ReturnType<typeof listEventStamps>>, TError = Error>(
+ eventId: number,
+    params?: ListEventStampsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventStamps>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getListEventStampsQueryOptions(eventId,params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+/**
+ * 指定されたイベントに新しいスタンプマスターデータを作成する
+ * @summary イベントのスタンプ作成
+ */
+export const createEventStamp = (
+    eventId: number,
+    stampCreateRequest: StampCreateRequest,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<Stamp>(
+      {url: `/events/${eventId}/stamps`, method: 'POST',
+      headers: {'Content-Type': 'application/json', },
+      data: stampCreateRequest, signal
+    },
+      );
+    }
+  
+
+
+export const getCreateEventStampMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof createEventStamp>>, TError,{eventId: number;data: StampCreateRequest}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof createEventStamp>>, TError,{eventId: number;data: StampCreateRequest}, TContext> => {
+
+const mutationKey = ['createEventStamp'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof createEventStamp>>, {eventId: number;data: StampCreateRequest}> = (props) => {
+          const {eventId,data} = props ?? {};
+
+          return  createEventStamp(eventId,data,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type CreateEventStampMutationResult = NonNullable<Awaited<ReturnType<typeof createEventStamp>>>
+    export type CreateEventStampMutationBody = StampCreateRequest
+    export type CreateEventStampMutationError = Error
+
+    /**
+ * @summary イベントのスタンプ作成
+ */
+export const useCreateEventStamp = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof createEventStamp>>, TError,{eventId: number;data: StampCreateRequest}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof createEventStamp>>,
+        TError,
+        {eventId: number;data: StampCreateRequest},
+        TContext
+      > => {
+
+      const mutationOptions = getCreateEventStampMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
     
\ No newline at end of file
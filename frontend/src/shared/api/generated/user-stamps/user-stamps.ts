@@ -27,6 +27,9 @@ import type {
 import type {
   AcquireStampRequest,
   Error,
+  GetEventLeaderboardParams,
+  GetLeaderboardParams,
+  Leaderboard,
   ListUserStamps200,
   UserStamp
 } from '../api.schemas';
@@ -195,4 +198,198 @@ export const useAcquireStamp = <TError = Error,
 
       return useMutation(mutationOptions, queryClient);
     }
-    
\ No newline at end of file
+    /**
+ * デフォルトイベント（ID 1）の参加者を取得スタンプ数の多い順に並べる。同数の場合は最後のスタンプを早く取得した参加者が上位になる。他のイベントは /events/{event_id}/leaderboard を使用する
+ * @summary スタンプ取得ランキング取得
+ */
+export const getLeaderboard = (
+    params?: GetLeaderboardParams,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<Leaderboard>(
+      {url: `/leaderboard`, method: 'GET',
+        params, signal
+    },
+      );
+    }
+  
+
+
+
+export const getGetLeaderboardQueryKey = (params?: GetLeaderboardParams,) => {
+    return [
+    `/leaderboard`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getGetLeaderboardQueryOptions = <TData = Awaited<ReturnType<typeof getLeaderboard>>, TError = Error>(params?: GetLeaderboardParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getLeaderboard>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getGetLeaderboardQueryKey(params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof getLeaderboard>>> = ({ signal }) => getLeaderboard(params, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof getLeaderboard>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type GetLeaderboardQueryResult = NonNullable<Awaited<ReturnType<typeof getLeaderboard>>>
+export type GetLeaderboardQueryError = Error
+
+
+export function useGetLeaderboard<TData = Awaited<ReturnType<typeof getLeaderboard>>, TError = Error>(
+ params: undefined |  GetLeaderboardParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof getLeaderboard>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof getLeaderboard>>,
+          TError,
+          Awaited<ReturnType<typeof getLeaderboard>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useGetLeaderboard<TData = Awaited<ReturnType<typeof getLeaderboard>>, TError = Error>(
+ params?: GetLeaderboardParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getLeaderboard>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof getLeaderboard>>,
+          TError,
+          Awaited<ReturnType<typeof getLeaderboard>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useGetLeaderboard<TData = Awaited<ReturnType<typeof getLeaderboard>>, TError = Error>(
+ params?: GetLeaderboardParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getLeaderboard>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+/**
+ * @summary スタンプ取得ランキング取得
+ */
+
+export function useGetLeaderboard<TData = Awaited<ReturnType<typeof getLeaderboard>>, TError = Error>(
+ params?: GetLeaderboardParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getLeaderboard>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getGetLeaderboardQueryOptions(params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+/**
+ * 指定されたイベントの参加者を取得スタンプ数の多い順に並べる。同数の場合は最後のスタンプを早く取得した参加者が上位になる
+ * @summary イベントのスタンプ取得ランキング取得
+ */
+export const getEventLeaderboard = (
+    eventId: number,
+    params?: GetEventLeaderboardParams,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<Leaderboard>(
+      {url: `/events/${eventId}/leaderboard`, method: 'GET',
+        params, signal
+    },
+      );
+    }
+  
+
+
+
+export const getGetEventLeaderboardQueryKey = (eventId?: number,
+    params?: GetEventLeaderboardParams,) => {
+    return [
+    `/events/${eventId}/leaderboard`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getGetEventLeaderboardQueryOptions = <TData = Awaited<ReturnType<typeof getEventLeaderboard>>, TError = Error>(eventId: number,
+    params?: GetEventLeaderboardParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getEventLeaderboard>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getGetEventLeaderboardQueryKey(eventId,params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof getEventLeaderboard>>> = ({ signal }) => getEventLeaderboard(eventId, params, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(eventId), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof getEventLeaderboard>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type GetEventLeaderboardQueryResult = NonNullable<Awaited<ReturnType<typeof getEventLeaderboard>>>
+export type GetEventLeaderboardQueryError = Error
+
+
+export function useGetEventLeaderboard<TData = Awaited<ReturnType<typeof getEventLeaderboard>>, TError = Error>(
+ eventId: number,
+    params: undefined |  GetEventLeaderboardParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof getEventLeaderboard>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof getEventLeaderboard>>,
+          TError,
+          Awaited<ReturnType<typeof getEventLeaderboard>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useGetEventLeaderboard<TData = Awaited<ReturnType<typeof getEventLeaderboard>>, TError = Error>(
+ eventId: number,
+    params?: GetEventLeaderboardParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getEventLeaderboard>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof getEventLeaderboard>>,
+          TError,
+          Awaited<ReturnType<typeof getEventLeaderboard>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useGetEventLeaderboard<TData = Awaited<ReturnType<typeof getEventLeaderboard>>, TError = Error>(
+ eventId: number,
+    params?: GetEventLeaderboardParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getEventLeaderboard>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+/**
+ * @summary イベントのスタンプ取得ランキング取得
+ */
+
+export function useGetEventLeaderboard<TData = Awaited<ReturnType<typeof getEventLeaderboard>>, TError = Error>(
+ eventId: number,
+    params?: GetEventLeaderboardParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof getEventLeaderboard>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getGetEventLeaderboardQueryOptions(eventId,params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
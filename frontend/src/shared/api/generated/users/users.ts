@@ -26,8 +26,15 @@ import type {
 
 import type {
   Error,
+  ListEventUsers200Item,
+  ListEventUsersParams,
+  ListUsers200Item,
+  ListUsersParams,
+  UploadUserIconBody,
   User,
+  UserAccessToken,
   UserCreateRequest,
+  UserCreateResponse,
   UserDetail,
   UserUpdateRequest
 } from '../api.schemas';
@@ -38,17 +45,18 @@ import { customInstance } from '../../mutator';
 
 
 /**
- * 全てのユーザーを取得する
+ * デフォルトイベント（ID 1）のユーザーを取得する。他のイベントは /events/{event_id}/users を使用する
  * @summary ユーザー一覧取得
  */
 export const listUsers = (
-    
+    params?: ListUsersParams,
  signal?: AbortSignal
 ) => {
       
       
-      return customInstance<User[]>(
-      {url: `/users`, method: 'GET', signal
+      return customInstance<ListUsers200Item[]>(
+      {url: `/users`, method: 'GET',
+        params, signal
     },
       );
     }
@@ -56,23 +64,23 @@ export const listUsers = (
 
 
 
-export const getListUsersQueryKey = () => {
+export const getListUsersQueryKey = (params?: ListUsersParams,) => {
     return [
-    `/users`
+    `/users`, ...(params ? [params]: [])
     ] as const;
     }
 
     
-export const getListUsersQueryOptions = <TData = Awaited<ReturnType<typeof listUsers>>, TError = Error>( options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listUsers>>, TError, TData>>, }
+export const getListUsersQueryOptions = <TData = Awaited<ReturnType<typeof listUsers>>, TError = Error>(params?: ListUsersParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listUsers>>, TError, TData>>, }
 ) => {
 
 const {query: queryOptions} = options ?? {};
 
-  const queryKey =  queryOptions?.queryKey ?? getListUsersQueryKey();
+  const queryKey =  queryOptions?.queryKey ?? getListUsersQueryKey(params);
 
   
 
-    const queryFn: QueryFunction<Awaited<ReturnType<typeof listUsers>>> = ({ signal }) => listUsers(signal);
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof listUsers>>> = ({ signal }) => listUsers(params, signal);
 
       
 
@@ -86,7 +94,7 @@ export type ListUsersQueryError = Error
 
 
 export function useListUsers<TData = Awaited<ReturnType<typeof listUsers>>, TError = Error>(
-  options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof listUsers>>, TError, TData>> & Pick<
+ params: undefined |  ListUsersParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof listUsers>>, TError, TData>> & Pick<
         DefinedInitialDataOptions<
           Awaited<ReturnType<typeof listUsers>>,
           TError,
@@ -96,7 +104,7 @@ export function useListUsers<TData = Awaited<ReturnType<typeof listUsers>>, TErr
  , queryClient?: QueryClient
   ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
 export function useListUsers<TData = Awaited<ReturnType<typeof listUsers>>, TError = Error>(
-  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listUsers>>, TError, TData>> & Pick<
+ params?: ListUsersParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listUsers>>, TError, TData>> & Pick<
         UndefinedInitialDataOptions<
           Awaited<ReturnType<typeof listUsers>>,
           TError,
@@ -106,7 +114,7 @@ export function useListUsers<TData = Awaited<ReturnType<typeof listUsers>>, TErr
  , queryClient?: QueryClient
   ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
 export function useListUsers<TData = Awaited<ReturnType<typeof listUsers>>, TError = Error>(
-  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listUsers>>, TError, TData>>, }
+ params?: ListUsersParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listUsers>>, TError, TData>>, }
  , queryClient?: QueryClient
   ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
 /**
@@ -114,11 +122,11 @@ export function useListUsers<TData = Awaited<ReturnType<typeof listUsers>>, TErr
  */
 
 export function useListUsers<TData = Awaited<ReturnType<typeof listUsers>>, TError = Error>(
-  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listUsers>>, TError, TData>>, }
+ params?: ListUsersParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listUsers>>, TError, TData>>, }
  , queryClient?: QueryClient 
  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
 
-  const queryOptions = getListUsersQueryOptions(options)
+  const queryOptions = getListUsersQueryOptions(params,options)
 
   const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
 
@@ -131,7 +139,7 @@ export function useListUsers<TData = Awaited<ReturnType<typeof listUsers>>, TErr
 
 
 /**
- * 新しいユーザーを作成する
+ * デフォルトイベント（ID 1）に新しいユーザーを作成する。他のイベントは /events/{event_id}/users を使用する
  * @summary ユーザー作成
  */
 export const createUser = (
@@ -140,7 +148,7 @@ export const createUser = (
 ) => {
       
       
-      return customInstance<User>(
+      return customInstance<UserCreateResponse>(
       {url: `/users`, method: 'POST',
       headers: {'Content-Type': 'application/json', },
       data: userCreateRequest, signal
@@ -353,4 +361,367 @@ export const useUpdateUser = <TError = Error,
 
       return useMutation(mutationOptions, queryClient);
     }
+    /**
+ * 指定されたIDのユーザーを削除する。
+ * 取得済みスタンプ、アイコン、アクセストークンを含むユーザーの全データが消去され、復元はできない。
+ * @summary ユーザー削除
+ */
+export const deleteUser = (
+    id: number,
+ ) => {
+      
+      
+      return customInstance<void>(
+      {url: `/users/${id}`, method: 'DELETE'
+    },
+      );
+    }
+  
+
+
+export const getDeleteUserMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof deleteUser>>, TError,{id: number}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof deleteUser>>, TError,{id: number}, TContext> => {
+
+const mutationKey = ['deleteUser'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof deleteUser>>, {id: number}> = (props) => {
+          const {id} = props ?? {};
+
+          return  deleteUser(id,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type DeleteUserMutationResult = NonNullable<Awaited<ReturnType<typeof deleteUser>>>
+    
+    export type DeleteUserMutationError = Error
+
+    /**
+ * @summary ユーザー削除
+ */
+export const useDeleteUser = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof deleteUser>>, TError,{id: number}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof deleteUser>>,
+        TError,
+        {id: number},
+        TContext
+      > => {
+
+      const mutationOptions = getDeleteUserMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * アイコン画像（PNG・JPEG・WebP・GIF、5MBまで）をアップロードする。
+ * 画像は中央を正方形に切り抜いたアイコン（最大512px）とサムネイル（最大128px）に変換して保存され、ユーザーにはそのURLが設定される。
+ * @summary ユーザーアイコンのアップロード
+ */
+export const uploadUserIcon = (
+    id: number,
+    uploadUserIconBody: UploadUserIconBody,
+ ) => {
+      
+      const formData = new FormData();
+formData.append(`icon`, uploadUserIconBody.icon)
+
+      return customInstance<User>(
+      {url: `/users/${id}/icon`, method: 'PUT',
+      headers: {'Content-Type': 'multipart/form-data', },
+       data: formData
+    },
+      );
+    }
+  
+
+
+export const getUploadUserIconMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof uploadUserIcon>>, TError,{id: number;data: UploadUserIconBody}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof uploadUserIcon>>, TError,{id: number;data: UploadUserIconBody}, TContext> => {
+
+const mutationKey = ['uploadUserIcon'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof uploadUserIcon>>, {id: number;data: UploadUserIconBody}> = (props) => {
+          const {id,data} = props ?? {};
+
+          return  uploadUserIcon(id,data,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type UploadUserIconMutationResult = NonNullable<Awaited<ReturnType<typeof uploadUserIcon>>>
+    export type UploadUserIconMutationBody = UploadUserIconBody
+    export type UploadUserIconMutationError = Error
+
+    /**
+ * @summary ユーザーアイコンのアップロード
+ */
+export const useUploadUserIcon = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof uploadUserIcon>>, TError,{id: number;data: UploadUserIconBody}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof uploadUserIcon>>,
+        TError,
+        {id: number;data: UploadUserIconBody},
+        TContext
+      > => {
+
+      const mutationOptions = getUploadUserIconMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * 運営スタッフが参加者に新しいアクセストークンを発行する。
+ * アクセストークンの導入前に登録した参加者や、トークンを失った参加者はこれを受け取るまでユーザー更新・スタンプ取得ができない。
+ * 発行済みのトークンは引き続き有効。
+ * @summary ユーザーのアクセストークン再発行
+ */
+export const issueUserToken = (
+    id: number,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<UserAccessToken>(
+      {url: `/users/${id}/token`, method: 'POST', signal
+    },
+      );
+    }
+  
+
+
+export const getIssueUserTokenMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof issueUserToken>>, TError,{id: number}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof issueUserToken>>, TError,{id: number}, TContext> => {
+
+const mutationKey = ['issueUserToken'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof issueUserToken>>, {id: number}> = (props) => {
+          const {id} = props ?? {};
+
+          return  issueUserToken(id,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type IssueUserTokenMutationResult = NonNullable<Awaited<ReturnType<typeof issueUserToken>>>
+    
+    export type IssueUserTokenMutationError = Error
+
+    /**
+ * @summary ユーザーのアクセストークン再発行
+ */
+export const useIssueUserToken = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof issueUserToken>>, TError,{id: number}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof issueUserToken>>,
+        TError,
+        {id: number},
+        TContext
+      > => {
+
+      const mutationOptions = getIssueUserTokenMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * 指定されたイベントの参加者を取得する
+ * @summary イベントのユーザー一覧取得
+ */
+export const listEventUsers = (
+    eventId: number,
+    params?: ListEventUsersParams,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<ListEventUsers200Item[]>(
+      {url: `/events/${eventId}/users`, method: 'GET',
+        params, signal
+    },
+      );
+    }
+  
+
+
+
+export const getListEventUsersQueryKey = (eventId?: number,
+    params?: ListEventUsersParams,) => {
+    return [
+    `/events/${eventId}/users`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getListEventUsersQueryOptions = <TData = Awaited<ReturnType<typeof listEventUsers>>, TError = Error>(eventId: number,
+    params?: ListEventUsersParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventUsers>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getListEventUsersQueryKey(eventId,params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof listEventUsers>>> = ({ signal }) => listEventUsers(eventId, params, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(eventId), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof listEventUsers>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type ListEventUsersQueryResult = NonNullable<Awaited<ReturnType<typeof listEventUsers>>>
+export type ListEventUsersQueryError = Error
+
+
+export function useListEventUsers<TData = Awaited<ReturnType<typeof listEventUsers>>, TError = Error>(
+ eventId: number,
+    params: undefined |  ListEventUsersParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventUsers>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof listEventUsers>>,
+          TError,
+          Awaited<ReturnType<typeof listEventUsers>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useListEventUsers<TData = Awaited<ReturnType<typeof listEventUsers>>, TError = Error>(
+ eventId: number,
+    params?: ListEventUsersParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventUsers>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof listEventUsers>>,
+          TError,
+          Awaited<ReturnType<typeof listEventUsers>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useListEventUsers<TData = Awaited<ReturnType<typeof listEventUsers>>, TError = Error>(
+ eventId: number,
+    params?: ListEventUsersParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventUsers>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+/**
+ * @summary イベントのユーザー一覧取得
+ */
+
+export function useListEventUsers<TData = Awaited<ReturnType<typeof listEventUsers>>, TError = Error>(
+ eventId: number,
+    params?: ListEventUsersParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventUsers>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getListEventUsersQueryOptions(eventId,params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+/**
+ * 指定されたイベントに参加者を登録する
+ * @summary イベントのユーザー作成
+ */
+export const createEventUser = (
+    eventId: number,
+    userCreateRequest: UserCreateRequest,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<UserCreateResponse>(
+      {url: `/events/${eventId}/users`, method: 'POST',
+      headers: {'Content-Type': 'application/json', },
+      data: userCreateRequest, signal
+    },
+      );
+    }
+  
+
+
+export const getCreateEventUserMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof createEventUser>>, TError,{eventId: number;data: UserCreateRequest}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof createEventUser>>, TError,{eventId: number;data: UserCreateRequest}, TContext> => {
+
+const mutationKey = ['createEventUser'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof createEventUser>>, {eventId: number;data: UserCreateRequest}> = (props) => {
+          const {eventId,data} = props ?? {};
+
+          return  createEventUser(eventId,data,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type CreateEventUserMutationResult = NonNullable<Awaited<ReturnType<typeof createEventUser>>>
+    export type CreateEventUserMutationBody = UserCreateRequest
+    export type CreateEventUserMutationError = Error
+
+    /**
+ * @summary イベントのユーザー作成
+ */
+export const useCreateEventUser = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof createEventUser>>, TError,{eventId: number;data: UserCreateRequest}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof createEventUser>>,
+        TError,
+        {eventId: number;data: UserCreateRequest},
+        TContext
+      > => {
+
+      const mutationOptions = getCreateEventUserMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
     
\ No newline at end of file
@@ -0,0 +1,366 @@
+/**
+ * Generated by orval v7.17.0 🍺
+ * Do not edit manually.
+ * Gopher Stamp Rally User API
+ * API for managing users in the Gopher Stamp Rally application
+ * OpenAPI spec version: 1.0.0
+ */
+import {
+  useMutation,
+  useQuery
+} from '@tanstack/react-query';
+import type {
+  DataTag,
+  DefinedInitialDataOptions,
+  DefinedUseQueryResult,
+  MutationFunction,
+  QueryClient,
+  QueryFunction,
+  QueryKey,
+  UndefinedInitialDataOptions,
+  UseMutationOptions,
+  UseMutationResult,
+  UseQueryOptions,
+  UseQueryResult
+} from '@tanstack/react-query';
+
+import type {
+  Error,
+  ListWebhookDeliveries200,
+  ListWebhookDeliveriesParams,
+  Webhook,
+  WebhookCreateRequest,
+  WebhookPayload
+} from '../api.schemas';
+
+import { customInstance } from '../../mutator';
+
+
+
+
+/**
+ * 指定されたイベントに登録されたWebhookを取得する。署名用のシークレットは含まれない
+ * @summary イベントのWebhook一覧取得
+ */
+export const listEventWebhooks = (
+    eventId: number,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<Webhook[]>(
+      {url: `/events/${eventId}/webhooks`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getListEventWebhooksQueryKey = (eventId?: number,) => {
+    return [
+    `/events/${eventId}/webhooks`
+    ] as const;
+    }
+
+    
+export const getListEventWebhooksQueryOptions = <TData = Awaited<ReturnType<typeof listEventWebhooks>>, TError = Error>(eventId: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventWebhooks>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getListEventWebhooksQueryKey(eventId);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof listEventWebhooks>>> = ({ signal }) => listEventWebhooks(eventId, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(eventId), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof listEventWebhooks>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type ListEventWebhooksQueryResult = NonNullable<Awaited<ReturnType<typeof listEventWebhooks>>>
+export type ListEventWebhooksQueryError = Error
+
+
+export function useListEventWebhooks<TData = Awaited<ReturnType<typeof listEventWebhooks>>, TError = Error>(
+ eventId: number, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventWebhooks>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof listEventWebhooks>>,
+          TError,
+          Awaited<ReturnType<typeof listEventWebhooks>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useListEventWebhooks<TData = Awaited<ReturnType<typeof listEventWebhooks>>, TError = Error>(
+ eventId: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventWebhooks>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof listEventWebhooks>>,
+          TError,
+          Awaited<ReturnType<typeof listEventWebhooks>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useListEventWebhooks<TData = Awaited<ReturnType<typeof listEventWebhooks>>, TError = Error>(
+ eventId: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventWebhooks>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+/**
+ * @summary イベントのWebhook一覧取得
+ */
+
+export function useListEventWebhooks<TData = Awaited<ReturnType<typeof listEventWebhooks>>, TError = Error>(
+ eventId: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listEventWebhooks>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getListEventWebhooksQueryOptions(eventId,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+/**
+ * 指定されたイベントの出来事を通知するWebhookを登録する。
+ * 通知はJSON（WebhookPayload）のPOSTで非同期に送られ、`X-Webhook-Signature`ヘッダーに
+ * `<X-Webhook-Timestamp>.<本文>`をシークレットで署名したHMAC-SHA256（`sha256=<16進数>`）が付く。
+ * 2xx以外の応答（408, 429, 5xx）や接続エラーの場合は間隔を倍にしながら最大5回まで送り直す。
+ * シークレットは登録時の応答にのみ含まれる
+ * @summary イベントのWebhook登録
+ */
+export const createEventWebhook = (
+    eventId: number,
+    webhookCreateRequest: WebhookCreateRequest,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<Webhook>(
+      {url: `/events/${eventId}/webhooks`, method: 'POST',
+      headers: {'Content-Type': 'application/json', },
+      data: webhookCreateRequest, signal
+    },
+      );
+    }
+  
+
+
+export const getCreateEventWebhookMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof createEventWebhook>>, TError,{eventId: number;data: WebhookCreateRequest}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof createEventWebhook>>, TError,{eventId: number;data: WebhookCreateRequest}, TContext> => {
+
+const mutationKey = ['createEventWebhook'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof createEventWebhook>>, {eventId: number;data: WebhookCreateRequest}> = (props) => {
+          const {eventId,data} = props ?? {};
+
+          return  createEventWebhook(eventId,data,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type CreateEventWebhookMutationResult = NonNullable<Awaited<ReturnType<typeof createEventWebhook>>>
+    export type CreateEventWebhookMutationBody = WebhookCreateRequest
+    export type CreateEventWebhookMutationError = Error
+
+    /**
+ * @summary イベントのWebhook登録
+ */
+export const useCreateEventWebhook = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof createEventWebhook>>, TError,{eventId: number;data: WebhookCreateRequest}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof createEventWebhook>>,
+        TError,
+        {eventId: number;data: WebhookCreateRequest},
+        TContext
+      > => {
+
+      const mutationOptions = getCreateEventWebhookMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * 指定されたWebhookを配信履歴とともに削除する。送信待ちの再送も行われなくなる
+ * @summary Webhook削除
+ */
+export const deleteWebhook = (
+    webhookId: number,
+ ) => {
+      
+      
+      return customInstance<void>(
+      {url: `/webhooks/${webhookId}`, method: 'DELETE'
+    },
+      );
+    }
+  
+
+
+export const getDeleteWebhookMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof deleteWebhook>>, TError,{webhookId: number}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof deleteWebhook>>, TError,{webhookId: number}, TContext> => {
+
+const mutationKey = ['deleteWebhook'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof deleteWebhook>>, {webhookId: number}> = (props) => {
+          const {webhookId} = props ?? {};
+
+          return  deleteWebhook(webhookId,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type DeleteWebhookMutationResult = NonNullable<Awaited<ReturnType<typeof deleteWebhook>>>
+    
+    export type DeleteWebhookMutationError = Error
+
+    /**
+ * @summary Webhook削除
+ */
+export const useDeleteWebhook = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof deleteWebhook>>, TError,{webhookId: number}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof deleteWebhook>>,
+        TError,
+        {webhookId: number},
+        TContext
+      > => {
+
+      const mutationOptions = getDeleteWebhookMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * 指定されたWebhookへの送信（再送を含む）の結果を新しい順に取得する
+ * @summary Webhookの配信履歴取得
+ */
+export const listWebhookDeliveries = (
+    webhookId: number,
+    params?: ListWebhookDeliveriesParams,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<ListWebhookDeliveries200>(
+      {url: `/webhooks/${webhookId}/deliveries`, method: 'GET',
+        params, signal
+    },
+      );
+    }
+  
+
+
+
+export const getListWebhookDeliveriesQueryKey = (webhookId?: number,
+    params?: ListWebhookDeliveriesParams,) => {
+    return [
+    `/webhooks/${webhookId}/deliveries`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getListWebhookDeliveriesQueryOptions = <TData = Awaited<ReturnType<typeof listWebhookDeliveries>>, TError = Error>(webhookId: number,
+    params?: ListWebhookDeliveriesParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listWebhookDeliveries>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getListWebhookDeliveriesQueryKey(webhookId,params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof listWebhookDeliveries>>> = ({ signal }) => listWebhookDeliveries(webhookId, params, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(webhookId), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof listWebhookDeliveries>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type ListWebhookDeliveriesQueryResult = NonNullable<Awaited<ReturnType<typeof listWebhookDeliveries>>>
+export type ListWebhookDeliveriesQueryError = Error
+
+
+export function useListWebhookDeliveries<TData = Awaited<ReturnType<typeof listWebhookDeliveries>>, TError = Error>(
+ webhookId: number,
+    params: undefined |  ListWebhookDeliveriesParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof listWebhookDeliveries>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof listWebhookDeliveries>>,
+          TError,
+          Awaited<ReturnType<typeof listWebhookDeliveries>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useListWebhookDeliveries<TData = Awaited<ReturnType<typeof listWebhookDeliveries>>, TError = Error>(
+ webhookId: number,
+    params?: ListWebhookDeliveriesParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listWebhookDeliveries>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof listWebhookDeliveries>>,
+          TError,
+          Awaited<ReturnType<typeof listWebhookDeliveries>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useListWebhookDeliveries<TData = Awaited<ReturnType<typeof listWebhookDeliveries>>, TError = Error>(
+ webhookId: number,
+    params?: ListWebhookDeliveriesParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listWebhookDeliveries>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+/**
+ * @summary Webhookの配信履歴取得
+ */
+
+export function useListWebhookDeliveries<TData = Awaited<ReturnType<typeof listWebhookDeliveries>>, TError = Error>(
+ webhookId: number,
+    params?: ListWebhookDeliveriesParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof listWebhookDeliveries>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getListWebhookDeliveriesQueryOptions(webhookId,params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
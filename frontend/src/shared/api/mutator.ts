@@ -1,3 +1,5 @@
+import {getUserProfile} from "../lib/storage";
+
 const API_BASE_URL = process.env.NEXT_PUBLIC_API_BASE_URL || "http://localhost:8080";
 
 export const customInstance = async <T>(config: {
@@ -25,17 +27,26 @@ export const customInstance = async <T>(config: {
     }
   }
 
+  // FormDataはブラウザがboundary付きのContent-Typeを設定するため指定しない
+  const isFormData = typeof FormData !== "undefined" && data instanceof FormData;
+  const requestHeaders: Record<string, string> = isFormData
+    ? Object.fromEntries(Object.entries(headers).filter(([key]) => key.toLowerCase() !== "content-type"))
+    : {"Content-Type": "application/json", ...headers};
+
+  // 登録時に発行されたアクセストークンで本人であることを示す
+  const accessToken = getUserProfile()?.accessToken;
+  if (accessToken && !Object.keys(requestHeaders).some((key) => key.toLowerCase() === "authorization")) {
+    requestHeaders.Authorization = `Bearer ${accessToken}`;
+  }
+
   const requestOptions: RequestInit = {
     method,
-    headers: {
-      "Content-Type": "application/json",
-      ...headers,
-    },
+    headers: requestHeaders,
     signal,
   };
 
   if (data && (method === "POST" || method === "PUT" || method === "PATCH")) {
-    requestOptions.body = JSON.stringify(data);
+    requestOptions.body = isFormData ? data : JSON.stringify(data);
   }
 
   const response = await fetch(requestUrl, requestOptions);
@@ -21,13 +21,16 @@ export async function fetchStampDetail(stampId: number): Promise<{ id: number; n
 /**
  * スタンプ取得API
  * POST /users/{user_id}/stamps
+ * tokenにはQRコードに埋め込まれた署名付きトークンを渡す
  */
 export async function acquireStampApi(
   userId: number,
-  stampId: number
+  stampId: number,
+  token?: string
 ): Promise<UserStamp> {
   const request: AcquireStampRequest = {
     stamp_id: stampId,
+    token,
   };
   return await acquireStamp(userId, request);
 }
@@ -69,7 +72,7 @@ export class InvalidRequestError extends StampApiError {
 export function handleStampApiError(error: unknown): {
   message: string;
   details?: string;
-  type: "not_found" | "already_acquired" | "invalid_request" | "unknown";
+  type: "not_found" | "already_acquired" | "invalid_request" | "too_many_requests" | "unknown";
 } {
   if (error instanceof StampNotFoundError) {
     return {
@@ -105,6 +108,13 @@ export function handleStampApiError(error: unknown): {
         type: "already_acquired",
       };
     }
+    if (errorMessage.includes("429") || errorMessage.includes("too many")) {
+      return {
+        message: "コードの入力に失敗した回数が多すぎます。しばらくしてから再度お試しください",
+        details: error.message,
+        type: "too_many_requests",
+      };
+    }
     if (errorMessage.includes("404") || errorMessage.includes("not found")) {
       return {
         message: "スタンプが見つかりません",
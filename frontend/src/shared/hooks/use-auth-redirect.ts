@@ -1,21 +1,24 @@
 import { useEffect } from "react";
 import { useRouter } from "next/navigation";
 import { useAtomValue } from "jotai";
-import { hasUserProfileAtom } from "@/shared/store/atoms";
+import { hasUserProfileAtom, needsAccessTokenAtom } from "@/shared/store/atoms";
 
 /**
- * ユーザープロフィールの有無をチェックし、未登録の場合はメインページにリダイレクト
+ * ユーザープロフィールの有無をチェックし、未登録の場合はメインページにリダイレクト。
+ * アクセストークンを持たない場合（トークン導入前に登録した参加者など）は再発行ページにリダイレクト
  */
 export function useAuthRedirect() {
   const router = useRouter();
   const hasUserProfile = useAtomValue(hasUserProfileAtom);
+  const needsAccessToken = useAtomValue(needsAccessTokenAtom);
 
   useEffect(() => {
     if (!hasUserProfile) {
       router.replace("/");
+    } else if (needsAccessToken) {
+      router.replace("/claim");
     }
-  }, [hasUserProfile, router]);
+  }, [hasUserProfile, needsAccessToken, router]);
 
   return hasUserProfile;
 }
-
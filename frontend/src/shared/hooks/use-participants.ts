@@ -32,6 +32,29 @@ type UserWithStamps = {
   stamp_ids?: number[];
 };
 
+// 参加者一覧APIの1ページの上限件数
+const USERS_PAGE_LIMIT = 1000;
+
+/**
+ * 参加者一覧を最後のページまで順に取得する
+ * 件数の少ないページが返ったら、それが最後のページ
+ */
+async function fetchAllUsersWithStamps(signal: AbortSignal): Promise<UserWithStamps[]> {
+  const users: UserWithStamps[] = [];
+  for (let offset = 0; ; offset += USERS_PAGE_LIMIT) {
+    const page = await customInstance<UserWithStamps[]>({
+      url: "/users",
+      method: "GET",
+      params: { include_stamp_counts: "true", limit: USERS_PAGE_LIMIT, offset },
+      signal,
+    });
+    users.push(...page);
+    if (page.length < USERS_PAGE_LIMIT) {
+      return users;
+    }
+  }
+}
+
 export function useParticipants() {
   const router = useRouter();
   const participants = useAtomValue(participantsAtom);
@@ -75,12 +98,7 @@ export function useParticipants() {
         // スタンプとユーザーを並列で取得
         const [stampsResponse, usersWithStamps] = await Promise.all([
           listStamps({ limit: 100, offset: 0 }),
-          customInstance<UserWithStamps[]>({
-            url: "/users",
-            method: "GET",
-            params: { include_stamp_counts: "true" },
-            signal: abortController.signal,
-          }),
+          fetchAllUsersWithStamps(abortController.signal),
         ]);
 
         // リクエストがキャンセルされた場合は処理を中断
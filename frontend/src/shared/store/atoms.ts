@@ -133,6 +133,12 @@ export const hasUserProfileAtom = atom<boolean>((get) => {
     return get(userProfileAtom) !== null;
 });
 
+// 登録済みだがアクセストークンを持たない（トークン導入前に登録した）かどうかを確認するatom
+export const needsAccessTokenAtom = atom<boolean>((get) => {
+    const profile = get(userProfileAtom);
+    return profile !== null && !profile.accessToken;
+});
+
 // スタンプ追加アクション（書き込み専用atom）
 export const addStampAtom = atom(
     null,
@@ -6,6 +6,8 @@ export interface UserProfile {
     favoriteGolangPoints: string[];
     completedCount: number;
     totalCount: number;
+    // 登録時に発行されたアクセストークン。本人のみ可能な操作で送信する
+    accessToken?: string;
 }
 
 export const GOLANG_POINTS = [
@@ -38,7 +38,7 @@ export function UserRegistrationDialog({open, onComplete}: UserRegistrationDialo
                 const result = reader.result as string;
                 setImagePreview(result);
                 setProfileImageUrl(result);
-                setIsFileUploaded(true); // ファイルアップロード時はURL入力欄を非表示
+                setIsFileUploaded(true);
             };
             reader.readAsDataURL(file);
         }
@@ -68,20 +68,8 @@ export function UserRegistrationDialog({open, onComplete}: UserRegistrationDialo
         setIsSubmitting(true);
 
         try {
-            // 画像の処理: URLの場合はそのまま保存、base64（ファイルアップロード）の場合はそのまま保存
-            let iconValue = "";
-            if (profileImageUrl && profileImageUrl.trim()) {
-                // http://またはhttps://で始まる場合はURLとしてそのまま保存
-                if (profileImageUrl.startsWith("http://") || profileImageUrl.startsWith("https://")) {
-                    iconValue = profileImageUrl.trim();
-                } else if (profileImageUrl.startsWith("data:")) {
-                    // base64（ファイルアップロード）の場合はそのまま保存
-                    iconValue = profileImageUrl;
-                } else {
-                    // その他の場合は空文字列
-                    iconValue = "";
-                }
-            }
+            // 画像はbase64（ファイルアップロード）で送信し、サーバーで検証・変換されたURLを受け取る
+            const iconValue = profileImageUrl.startsWith("data:") ? profileImageUrl : "";
 
             // バックエンドに保存する値は「コード」（英数字）に変換する
             const favoriteGoFeatureCodes = selectedPoints.map((label) => GOLANG_POINT_CODE_MAP[label as keyof typeof GOLANG_POINT_CODE_MAP] ?? label);
@@ -96,15 +84,16 @@ export function UserRegistrationDialog({open, onComplete}: UserRegistrationDialo
             );
 
             // バックエンドのユーザーIDをフロントのプロフィールに反映
-            // LocalStorageにはURLまたはbase64画像を保存
+            // LocalStorageにはサーバーが保存した画像のURLを保存
             const profile: UserProfile = {
                 id: String(apiUser.id),
                 nickname: apiUser.name,
                 twitterId: apiUser.twitter_id ?? twitterId.trim(),
-                profileImageUrl: iconValue || "",
+                profileImageUrl: apiUser.icon ?? "",
                 favoriteGolangPoints: selectedPoints,
                 completedCount: 0,
                 totalCount: 0, // APIから取得した総数で後で更新される
+                accessToken: apiUser.access_token,
             };
 
             setUserProfile(profile);
@@ -187,30 +176,10 @@ export function UserRegistrationDialog({open, onComplete}: UserRegistrationDialo
                                 onChange={handleImageSelect}
                             />
                         </div>
-                        {!isFileUploaded && (
-                            <Input
-                                id="profileImageUrl"
-                                placeholder="画像URL（オプション）"
-                                value={profileImageUrl}
-                                onChange={(e) => {
-                                    const url = e.target.value;
-                                    setProfileImageUrl(url);
-                                    // URLが空でない場合のみプレビューを更新
-                                    if (url) {
-                                        setImagePreview(url);
-                                    } else {
-                                        setImagePreview("");
-                                    }
-                                    // URLを入力した場合は、ファイルアップロード状態を解除
-                                    setIsFileUploaded(false);
-                                }}
-                                className="bg-white text-gray-900 border-gray-300"
-                            />
-                        )}
                         <p className="text-xs text-gray-500">
                             {isFileUploaded
                                 ? "画像をアップロードしました。別の画像を選択する場合は、再度「画像を選択」ボタンをクリックしてください。"
-                                : "ファイルを選択するか、画像のURLを入力してください"}
+                                : "PNG・JPEG・WebP・GIF形式、5MBまでの画像を選択してください（オプション）"}
                         </p>
                     </div>
 